@@ -0,0 +1,130 @@
+package jrp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// naAdjectivesEndingWithI is a variable that contains the na-adjectives ending with "い" that are not i-adjectives.
+	naAdjectivesEndingWithI = []string{
+		"きれい",
+		"キレイ",
+		"嫌い",
+		"きらい",
+		"幸い",
+		"さいわい",
+	}
+	// uRowKanas is a variable that contains the kanas of the u-row that the dictionary forms of the verbs end with.
+	uRowKanas = "うくぐすずつぬふぶぷむゆる"
+	// attributiveEndings is a variable that contains the endings of the adjectives already in the attributive form.
+	attributiveEndings = []string{
+		"な",
+		"の",
+		"た",
+		"る",
+	}
+)
+
+// toAttributive returns the attributive form (連体形) of the given lemma of the adjective or the verb.
+func toAttributive(lemma string, pos string) string {
+	if lemma == "" {
+		return lemma
+	}
+
+	switch pos {
+	case "a":
+		return adjectiveToAttributive(lemma)
+	case "v":
+		return verbToAttributive(lemma)
+	default:
+		return lemma
+	}
+}
+
+// adjectiveToAttributive returns the attributive form of the given lemma of the adjective.
+func adjectiveToAttributive(lemma string) string {
+	for _, na := range naAdjectivesEndingWithI {
+		if lemma == na {
+			return lemma + "な"
+		}
+	}
+	if strings.HasSuffix(lemma, "い") {
+		// i-adjectives are already in the attributive form.
+		return lemma
+	}
+	if strings.HasSuffix(lemma, "だ") {
+		return strings.TrimSuffix(lemma, "だ") + "な"
+	}
+	for _, ending := range attributiveEndings {
+		if strings.HasSuffix(lemma, ending) {
+			return lemma
+		}
+	}
+	if !hasJapaneseEnding(lemma) {
+		return lemma
+	}
+
+	// the rest are the stems of the na-adjectives.
+	return lemma + "な"
+}
+
+// verbToAttributive returns the attributive form of the given lemma of the verb.
+func verbToAttributive(lemma string) string {
+	last, _ := utf8.DecodeLastRuneInString(lemma)
+	if strings.ContainsRune(uRowKanas, last) || strings.HasSuffix(lemma, "い") {
+		// the dictionary forms of the verbs are already in the attributive form.
+		return lemma
+	}
+	for _, ending := range attributiveEndings {
+		if strings.HasSuffix(lemma, ending) {
+			return lemma
+		}
+	}
+	if strings.HasSuffix(lemma, "だ") {
+		// the past forms of the verbs (e.g. : 読んだ) are already in the attributive form.
+		return lemma
+	}
+	if !hasSuruStemEnding(lemma) {
+		return lemma
+	}
+
+	// the rest are the stems of the suru-verbs.
+	return lemma + "する"
+}
+
+// hasJapaneseEnding returns whether the given lemma ends with a kana or a kanji.
+func hasJapaneseEnding(lemma string) bool {
+	last, _ := utf8.DecodeLastRuneInString(lemma)
+	switch {
+	case last >= 0x3040 && last <= 0x30ff:
+		// hiragana and katakana
+		return true
+	case last >= 0x4e00 && last <= 0x9fff:
+		// cjk unified ideographs
+		return true
+	case last == 0x3005:
+		// ideographic iteration mark
+		return true
+	default:
+		return false
+	}
+}
+
+// hasSuruStemEnding returns whether the given lemma ends with a kanji or a katakana like the stems of the suru-verbs.
+func hasSuruStemEnding(lemma string) bool {
+	last, _ := utf8.DecodeLastRuneInString(lemma)
+	switch {
+	case last >= 0x30a0 && last <= 0x30ff:
+		// katakana
+		return true
+	case last >= 0x4e00 && last <= 0x9fff:
+		// cjk unified ideographs
+		return true
+	case last == 0x3005:
+		// ideographic iteration mark
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,110 @@
+package jrp
+
+import (
+	"testing"
+)
+
+func Test_toAttributive(t *testing.T) {
+	type args struct {
+		lemma string
+		pos   string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (lemma is empty)",
+			args: args{lemma: "", pos: "a"},
+			want: "",
+		},
+		{
+			name: "positive testing (i-adjective)",
+			args: args{lemma: "美しい", pos: "a"},
+			want: "美しい",
+		},
+		{
+			name: "positive testing (na-adjective)",
+			args: args{lemma: "静か", pos: "a"},
+			want: "静かな",
+		},
+		{
+			name: "positive testing (na-adjective ending with い)",
+			args: args{lemma: "きれい", pos: "a"},
+			want: "きれいな",
+		},
+		{
+			name: "positive testing (na-adjective ending with だ)",
+			args: args{lemma: "静かだ", pos: "a"},
+			want: "静かな",
+		},
+		{
+			name: "positive testing (adjective already in the attributive form)",
+			args: args{lemma: "大きな", pos: "a"},
+			want: "大きな",
+		},
+		{
+			name: "positive testing (adjective not in japanese)",
+			args: args{lemma: "test", pos: "a"},
+			want: "test",
+		},
+		{
+			name: "positive testing (verb in the dictionary form)",
+			args: args{lemma: "走る", pos: "v"},
+			want: "走る",
+		},
+		{
+			name: "positive testing (stem of the suru-verb)",
+			args: args{lemma: "散歩", pos: "v"},
+			want: "散歩する",
+		},
+		{
+			name: "positive testing (stem of the suru-verb in katakana)",
+			args: args{lemma: "コピー", pos: "v"},
+			want: "コピーする",
+		},
+		{
+			name: "positive testing (verb ending with い)",
+			args: args{lemma: "用い", pos: "v"},
+			want: "用い",
+		},
+		{
+			name: "positive testing (verb already in the attributive form)",
+			args: args{lemma: "大きな", pos: "v"},
+			want: "大きな",
+		},
+		{
+			name: "positive testing (verb in the past form ending with た)",
+			args: args{lemma: "食べた", pos: "v"},
+			want: "食べた",
+		},
+		{
+			name: "positive testing (verb in the past form ending with だ)",
+			args: args{lemma: "読んだ", pos: "v"},
+			want: "読んだ",
+		},
+		{
+			name: "positive testing (verb ending with the other hiragana)",
+			args: args{lemma: "ごろごろ", pos: "v"},
+			want: "ごろごろ",
+		},
+		{
+			name: "positive testing (verb not in japanese)",
+			args: args{lemma: "test", pos: "v"},
+			want: "test",
+		},
+		{
+			name: "positive testing (noun)",
+			args: args{lemma: "猫", pos: "n"},
+			want: "猫",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toAttributive(tt.args.lemma, tt.args.pos); got != tt.want {
+				t.Errorf("toAttributive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
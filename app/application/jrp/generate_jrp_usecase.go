@@ -1,18 +1,30 @@
 package jrp
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"time"
 
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
 	"github.com/yanosea/jrp/v2/pkg/proxy"
 	"github.com/yanosea/jrp/v2/pkg/utility"
 )
 
 // generateJrpUseCase is a struct that contains the use case of the generation jrp.
-type generateJrpUseCase struct{}
+type generateJrpUseCase struct {
+	conjugate   bool
+	ru          utility.RandUtil
+	historyRepo historyDomain.HistoryRepository
+}
 
 // NewGenerateJrpUseCase returns a new instance of the GenerateJrpUseCase struct.
-func NewGenerateJrpUseCase() *generateJrpUseCase {
-	return &generateJrpUseCase{}
+// if conjugate is true, the adjectives and the verbs are conjugated into the attributive form before joining.
+func NewGenerateJrpUseCase(conjugate bool) *generateJrpUseCase {
+	return &generateJrpUseCase{
+		conjugate: conjugate,
+	}
 }
 
 // GenerateJrpUseCaseInputDto is a DTO struct that contains the input data of the GenerateJrpUseCase.
@@ -28,122 +40,315 @@ type GenerateJrpUseCaseInputDto struct {
 type GenerateJrpUseCaseOutputDto struct {
 	ID          int
 	Phrase      string
+	Reading     string
+	Romaji      string
+	WordIDs     []int
 	Prefix      string
 	Suffix      string
 	IsFavorited int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// PrefixWord is the word the prefix of the phrase is chosen from. It is nil if the prefix is given or the phrase is generated with the template.
+	PrefixWord *GenerateJrpUseCaseInputDto
+	// SuffixWord is the word the suffix of the phrase is chosen from. It is nil if the suffix is given or the phrase is generated with the template.
+	SuffixWord *GenerateJrpUseCaseInputDto
 }
 
+const (
+	// uniqueBatchSize is the largest number of the generated jrps checked against the histories at once.
+	uniqueBatchSize = 500
+	// uniqueMaxMisses is the number of the generations in a row without a new phrase to give up generating unique jrps.
+	uniqueMaxMisses = 1000
+)
+
 var (
 	// ru is a variable that contains the RandUtil struct for injecting dependencies in testing.
 	ru = utility.NewRandUtil(proxy.NewRand())
 )
 
-// RunWithPrefix generates a jrp with the given prefix.
+// WithSeed makes the use case generate the same jrps for the same seed and the same input.
+func (uc *generateJrpUseCase) WithSeed(seed int64) *generateJrpUseCase {
+	uc.ru = utility.NewRandUtil(proxy.NewRandWithSeed(seed))
+	return uc
+}
+
+// WithHistoryRepository makes RunUnique exclude the phrases already in the histories of the repository.
+func (uc *generateJrpUseCase) WithHistoryRepository(historyRepo historyDomain.HistoryRepository) *generateJrpUseCase {
+	uc.historyRepo = historyRepo
+	return uc
+}
+
+// RunUnique generates the number of jrps by the generate function without the duplicated phrases
+// and the phrases already in the histories if the history repository is given.
+// it returns an error if the words can not generate enough unique jrps.
+func (uc *generateJrpUseCase) RunUnique(
+	ctx context.Context,
+	number int,
+	generate func() *GenerateJrpUseCaseOutputDto,
+) ([]*GenerateJrpUseCaseOutputDto, error) {
+	jrps := make([]*GenerateJrpUseCaseOutputDto, 0, number)
+	seen := make(map[string]bool, number)
+	misses := 0
+	for len(jrps) < number && misses < uniqueMaxMisses {
+		var candidates []*GenerateJrpUseCaseOutputDto
+		for len(candidates) < min(number-len(jrps), uniqueBatchSize) && misses < uniqueMaxMisses {
+			jrp := generate()
+			if jrp == nil || seen[jrp.Phrase] {
+				misses++
+				continue
+			}
+			misses = 0
+			seen[jrp.Phrase] = true
+			candidates = append(candidates, jrp)
+		}
+
+		existing, err := uc.existingPhrases(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+		for _, jrp := range candidates {
+			if !existing[jrp.Phrase] {
+				jrps = append(jrps, jrp)
+			}
+		}
+	}
+
+	if len(jrps) < number {
+		return nil, errors.New("not enough unique phrases")
+	}
+
+	return jrps, nil
+}
+
+// existingPhrases returns the phrases of the jrps already in the histories.
+func (uc *generateJrpUseCase) existingPhrases(ctx context.Context, jrps []*GenerateJrpUseCaseOutputDto) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if uc.historyRepo == nil || len(jrps) == 0 {
+		return existing, nil
+	}
+
+	phrases := make([]string, 0, len(jrps))
+	for _, jrp := range jrps {
+		phrases = append(phrases, jrp.Phrase)
+	}
+	histories, err := uc.historyRepo.FindByPhraseIn(ctx, phrases)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range histories {
+		existing[h.Phrase] = true
+	}
+
+	return existing, nil
+}
+
+// RunWithPrefix generates a jrp with the given prefix and a random noun of the pool.
 func (uc *generateJrpUseCase) RunWithPrefix(
-	dtos []*GenerateJrpUseCaseInputDto,
+	pool *WordPool,
 	prefix string,
 ) *GenerateJrpUseCaseOutputDto {
-	if len(dtos) == 0 {
+	randomSuffix := uc.randomNoun(pool)
+	if randomSuffix == nil {
 		return nil
 	}
 
 	now := time.Now()
-	maxAttempts := len(dtos)
-
-	var jrp *GenerateJrpUseCaseOutputDto = nil
-	for i := 0; i < maxAttempts; i++ {
-		randomSuffix := dtos[ru.GenerateRandomNumber(len(dtos))]
-		if randomSuffix.Pos != "n" {
-			continue
-		}
+	reading := toHiragana(prefix) + readingOf(randomSuffix)
 
-		jrp = &GenerateJrpUseCaseOutputDto{
-			ID:          0,
-			Phrase:      prefix + randomSuffix.Lemma,
-			Prefix:      prefix,
-			Suffix:      "",
-			IsFavorited: 0,
-			CreatedAt:   now,
-			UpdatedAt:   now,
-		}
-		break
+	return &GenerateJrpUseCaseOutputDto{
+		ID:          0,
+		Phrase:      prefix + randomSuffix.Lemma,
+		Reading:     reading,
+		Romaji:      toRomaji(reading),
+		WordIDs:     []int{randomSuffix.WordID},
+		Prefix:      prefix,
+		Suffix:      "",
+		IsFavorited: 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		SuffixWord:  randomSuffix,
 	}
-
-	return jrp
 }
 
-// RunWithSuffix generates a jrp with the given suffix.
+// RunWithSuffix generates a jrp with a random adjective or verb of the pool and the given suffix.
 func (uc *generateJrpUseCase) RunWithSuffix(
-	dtos []*GenerateJrpUseCaseInputDto,
+	pool *WordPool,
 	suffix string,
 ) *GenerateJrpUseCaseOutputDto {
-	if len(dtos) == 0 {
+	randomPrefix := uc.randomModifier(pool)
+	if randomPrefix == nil {
 		return nil
 	}
 
 	now := time.Now()
-	maxAttempts := len(dtos)
+	lemma, reading := uc.attributive(randomPrefix)
+	reading += toHiragana(suffix)
 
-	var jrp *GenerateJrpUseCaseOutputDto = nil
-	for i := 0; i < maxAttempts; i++ {
-		randomPrefix := dtos[ru.GenerateRandomNumber(len(dtos))]
-		if randomPrefix.Pos != "a" && randomPrefix.Pos != "v" {
-			continue
-		}
+	return &GenerateJrpUseCaseOutputDto{
+		ID:          0,
+		Phrase:      lemma + suffix,
+		Reading:     reading,
+		Romaji:      toRomaji(reading),
+		WordIDs:     []int{randomPrefix.WordID},
+		Prefix:      "",
+		Suffix:      suffix,
+		IsFavorited: 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		PrefixWord:  randomPrefix,
+	}
+}
+
+// RunWithRandom generates a jrp with a random adjective or verb and a random noun of the pool.
+func (uc *generateJrpUseCase) RunWithRandom(
+	pool *WordPool,
+) *GenerateJrpUseCaseOutputDto {
+	return uc.RunWithWords(pool, nil, nil)
+}
 
-		jrp = &GenerateJrpUseCaseOutputDto{
-			ID:          0,
-			Phrase:      randomPrefix.Lemma + suffix,
-			Prefix:      "",
-			Suffix:      suffix,
-			IsFavorited: 0,
-			CreatedAt:   now,
-			UpdatedAt:   now,
+// RunWithWords generates a jrp joining the given prefix word and suffix word.
+// the side given as nil is filled with a random word of the pool, an adjective or a verb for the prefix and a noun for the suffix.
+func (uc *generateJrpUseCase) RunWithWords(
+	pool *WordPool,
+	prefixWord *GenerateJrpUseCaseInputDto,
+	suffixWord *GenerateJrpUseCaseInputDto,
+) *GenerateJrpUseCaseOutputDto {
+	if prefixWord == nil {
+		if prefixWord = uc.randomModifier(pool); prefixWord == nil {
+			return nil
+		}
+	}
+	if suffixWord == nil {
+		if suffixWord = uc.randomNoun(pool); suffixWord == nil {
+			return nil
 		}
-		break
 	}
 
-	return jrp
+	now := time.Now()
+	lemma, reading := uc.attributive(prefixWord)
+	reading += readingOf(suffixWord)
+
+	return &GenerateJrpUseCaseOutputDto{
+		ID:          0,
+		Phrase:      lemma + suffixWord.Lemma,
+		Reading:     reading,
+		Romaji:      toRomaji(reading),
+		WordIDs:     []int{prefixWord.WordID, suffixWord.WordID},
+		Prefix:      "",
+		Suffix:      "",
+		IsFavorited: 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		PrefixWord:  prefixWord,
+		SuffixWord:  suffixWord,
+	}
 }
 
-// RunWithRandom generates a jrp with random prefix and suffix.
-func (uc *generateJrpUseCase) RunWithRandom(
-	dtos []*GenerateJrpUseCaseInputDto,
+// RunWithTemplate generates a jrp by filling every slot of the given template with a random word of the matching part of speech.
+func (uc *generateJrpUseCase) RunWithTemplate(
+	pool *WordPool,
+	template *JrpTemplate,
 ) *GenerateJrpUseCaseOutputDto {
-	if len(dtos) == 0 {
+	if pool == nil || len(pool.Words) == 0 || template == nil {
 		return nil
 	}
 
 	now := time.Now()
-	maxAttempts := len(dtos)
-
-	var jrp *GenerateJrpUseCaseOutputDto = nil
-	for i := 0; i < maxAttempts; i++ {
-		indexForPrefix := ru.GenerateRandomNumber(len(dtos))
-		indexForSuffix := ru.GenerateRandomNumber(len(dtos))
 
-		randomPrefix := dtos[indexForPrefix]
-		if randomPrefix.Pos != "a" && randomPrefix.Pos != "v" {
-			continue
-		}
-		randomSuffix := dtos[indexForSuffix]
-		if randomSuffix.Pos != "n" {
+	var phrase string
+	var reading string
+	var wordIDs []int
+	for i, token := range template.tokens {
+		if token.pos == "" {
+			phrase += token.literal
+			reading += toHiragana(token.literal)
 			continue
 		}
 
-		jrp = &GenerateJrpUseCaseOutputDto{
-			ID:          0,
-			Phrase:      randomPrefix.Lemma + randomSuffix.Lemma,
-			Prefix:      "",
-			Suffix:      "",
-			IsFavorited: 0,
-			CreatedAt:   now,
-			UpdatedAt:   now,
+		words := pool.Pos(token.pos)
+		if len(words) == 0 {
+			return nil
 		}
-		break
+		word := words[uc.randUtil().GenerateRandomNumber(len(words))]
+		wordIDs = append(wordIDs, word.WordID)
+		if i+1 < len(template.tokens) && template.tokens[i+1].pos == "n" {
+			// only the words modifying the following noun are conjugated.
+			lemma, r := uc.attributive(word)
+			phrase += lemma
+			reading += r
+		} else {
+			phrase += word.Lemma
+			reading += readingOf(word)
+		}
+	}
+
+	return &GenerateJrpUseCaseOutputDto{
+		ID:          0,
+		Phrase:      phrase,
+		Reading:     reading,
+		Romaji:      toRomaji(reading),
+		WordIDs:     wordIDs,
+		Prefix:      "",
+		Suffix:      "",
+		IsFavorited: 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// attributive returns the lemma and the reading of the given word in the attributive form if the conjugation is enabled.
+func (uc *generateJrpUseCase) attributive(dto *GenerateJrpUseCaseInputDto) (string, string) {
+	lemma, reading := dto.Lemma, readingOf(dto)
+	if !uc.conjugate {
+		return lemma, reading
+	}
+
+	conjugated := toAttributive(lemma, dto.Pos)
+	if strings.HasPrefix(conjugated, lemma) {
+		return conjugated, reading + strings.TrimPrefix(conjugated, lemma)
+	}
+
+	// the ending of the lemma is replaced, so is the ending of the reading.
+	return conjugated, toAttributive(reading, dto.Pos)
+}
+
+// randomModifier returns a random adjective or verb of the pool, or nil if the pool has none.
+func (uc *generateJrpUseCase) randomModifier(pool *WordPool) *GenerateJrpUseCaseInputDto {
+	if pool == nil {
+		return nil
+	}
+
+	adjectives, verbs := pool.Pos("a"), pool.Pos("v")
+	if len(adjectives)+len(verbs) == 0 {
+		return nil
+	}
+	i := uc.randUtil().GenerateRandomNumber(len(adjectives) + len(verbs))
+	if i < len(adjectives) {
+		return adjectives[i]
+	}
+
+	return verbs[i-len(adjectives)]
+}
+
+// randomNoun returns a random noun of the pool, or nil if the pool has none.
+func (uc *generateJrpUseCase) randomNoun(pool *WordPool) *GenerateJrpUseCaseInputDto {
+	if pool == nil {
+		return nil
+	}
+
+	nouns := pool.Pos("n")
+	if len(nouns) == 0 {
+		return nil
+	}
+
+	return nouns[uc.randUtil().GenerateRandomNumber(len(nouns))]
+}
+
+// randUtil returns the RandUtil seeded by WithSeed, or the default one if the seed is not given.
+func (uc *generateJrpUseCase) randUtil() utility.RandUtil {
+	if uc.ru != nil {
+		return uc.ru
 	}
 
-	return jrp
+	return ru
 }
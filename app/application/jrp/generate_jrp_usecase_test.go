@@ -1,33 +1,232 @@
 package jrp
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"strconv"
 	"testing"
 
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
 	"github.com/yanosea/jrp/v2/pkg/utility"
 
 	"go.uber.org/mock/gomock"
 )
 
 func TestNewGenerateJrpUseCase(t *testing.T) {
+	type args struct {
+		conjugate bool
+	}
 	tests := []struct {
 		name string
+		args args
 		want *generateJrpUseCase
 	}{
 		{
-			name: "positive testing",
-			want: &generateJrpUseCase{},
+			name: "positive testing (conjugate is true)",
+			args: args{
+				conjugate: true,
+			},
+			want: &generateJrpUseCase{
+				conjugate: true,
+			},
+		},
+		{
+			name: "positive testing (conjugate is false)",
+			args: args{
+				conjugate: false,
+			},
+			want: &generateJrpUseCase{
+				conjugate: false,
+			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewGenerateJrpUseCase(); !reflect.DeepEqual(got, tt.want) {
+			if got := NewGenerateJrpUseCase(tt.args.conjugate); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewGenerateJrpUseCase() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func Test_generateJrpUseCase_WithSeed(t *testing.T) {
+	dtos := []*GenerateJrpUseCaseInputDto{}
+	for i := 0; i < 100; i++ {
+		pos := "n"
+		if i%2 == 0 {
+			pos = "a"
+		}
+		dtos = append(dtos, &GenerateJrpUseCaseInputDto{
+			WordID: i,
+			Lang:   "jpn",
+			Lemma:  "test" + strconv.Itoa(i),
+			Pron:   "test",
+			Pos:    pos,
+		})
+	}
+
+	type args struct {
+		seed1 int64
+		seed2 int64
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "positive testing (same seeds)",
+			args: args{
+				seed1: 42,
+				seed2: 42,
+			},
+			want: true,
+		},
+		{
+			name: "positive testing (different seeds)",
+			args: args{
+				seed1: 42,
+				seed2: 43,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := NewWordPool(dtos)
+			uc1 := NewGenerateJrpUseCase(false).WithSeed(tt.args.seed1)
+			uc2 := NewGenerateJrpUseCase(false).WithSeed(tt.args.seed2)
+			var phrases1, phrases2 []string
+			for i := 0; i < 10; i++ {
+				phrases1 = append(phrases1, uc1.RunWithRandom(pool).Phrase)
+				phrases2 = append(phrases2, uc2.RunWithRandom(pool).Phrase)
+			}
+			if got := reflect.DeepEqual(phrases1, phrases2); got != tt.want {
+				t.Errorf("generateJrpUseCase.WithSeed() = %v, %v, want same : %v", phrases1, phrases2, tt.want)
+			}
+		})
+	}
+}
+
+func Test_generateJrpUseCase_RunUnique(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		number  int
+		phrases []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (without the history repository)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				number:  3,
+				phrases: []string{"a", "", "a", "b", "a", "c"},
+			},
+			want:    []string{"a", "b", "c"},
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name: "positive testing (with the history repository)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				number:  2,
+				phrases: []string{"a", "b", "c"},
+			},
+			want:    []string{"b", "c"},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindByPhraseIn(gomock.Any(), []string{"a", "b"}).Return([]*historyDomain.History{{ID: 1, Phrase: "a"}}, nil)
+				mockHistoryRepo.EXPECT().FindByPhraseIn(gomock.Any(), []string{"c"}).Return(nil, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (not enough unique phrases)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				number:  3,
+				phrases: []string{"a", "b"},
+			},
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name: "negative testing (historyRepo.FindByPhraseIn(ctx, phrases) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				number:  1,
+				phrases: []string{"a"},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindByPhraseIn(gomock.Any(), []string{"a"}).Return(nil, errors.New("HistoryRepository.FindByPhraseIn() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := NewGenerateJrpUseCase(false)
+			if tt.fields.historyRepo != nil {
+				uc = uc.WithHistoryRepository(tt.fields.historyRepo)
+			}
+			// the generate function returns the phrases in order, then nil after they run out.
+			i := 0
+			generate := func() *GenerateJrpUseCaseOutputDto {
+				if i >= len(tt.args.phrases) {
+					return nil
+				}
+				phrase := tt.args.phrases[i]
+				i++
+				if phrase == "" {
+					return nil
+				}
+				return &GenerateJrpUseCaseOutputDto{Phrase: phrase}
+			}
+			got, err := uc.RunUnique(context.Background(), tt.args.number, generate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("generateJrpUseCase.RunUnique() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var phrases []string
+			for _, jrp := range got {
+				phrases = append(phrases, jrp.Phrase)
+			}
+			if !reflect.DeepEqual(phrases, tt.want) {
+				t.Errorf("generateJrpUseCase.RunUnique() = %v, want %v", phrases, tt.want)
+			}
+		})
+	}
+}
+
 func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 	origRu := ru
 
@@ -44,7 +243,7 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 		cleanup func()
 	}{
 		{
-			name: "positive testing (len(dtos) == 0)",
+			name: "positive testing (no words in the pool)",
 			args: args{
 				dtos:   nil,
 				prefix: "prefix",
@@ -53,6 +252,24 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 			setup:   nil,
 			cleanup: nil,
 		},
+		{
+			name: "positive testing (no nouns in the pool)",
+			args: args{
+				dtos: []*GenerateJrpUseCaseInputDto{
+					{
+						WordID: 1,
+						Lang:   "jpn",
+						Lemma:  "testa",
+						Pron:   "test",
+						Pos:    "a",
+					},
+				},
+				prefix: "prefix",
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
 		{
 			name: "positive testing",
 			args: args{
@@ -84,15 +301,14 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 			want: &GenerateJrpUseCaseOutputDto{
 				ID:          0,
 				Phrase:      "prefixtestn",
+				WordIDs:     []int{3},
 				Prefix:      "prefix",
 				Suffix:      "",
 				IsFavorited: 0,
 			},
 			setup: func(mockCtrl *gomock.Controller) {
 				mockRu := utility.NewMockRandUtil(mockCtrl)
-				mockRu.EXPECT().GenerateRandomNumber(3).Return(0)
-				mockRu.EXPECT().GenerateRandomNumber(3).Return(1)
-				mockRu.EXPECT().GenerateRandomNumber(3).Return(2)
+				mockRu.EXPECT().GenerateRandomNumber(1).Return(0)
 				ru = mockRu
 			},
 			cleanup: func() {
@@ -113,7 +329,7 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 				}
 			}()
 			uc := &generateJrpUseCase{}
-			got := uc.RunWithPrefix(tt.args.dtos, tt.args.prefix)
+			got := uc.RunWithPrefix(NewWordPool(tt.args.dtos), tt.args.prefix)
 			if (got == nil) != (tt.want == nil) {
 				t.Errorf("generateJrpUseCase.RunWithPrefix() nil check failed: got %v, want %v", got, tt.want)
 				return
@@ -125,6 +341,9 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 				if got.Phrase != tt.want.Phrase {
 					t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want %v", got.Phrase, tt.want.Phrase)
 				}
+				if !reflect.DeepEqual(got.WordIDs, tt.want.WordIDs) {
+					t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want %v", got.WordIDs, tt.want.WordIDs)
+				}
 				if got.Prefix != tt.want.Prefix {
 					t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want %v", got.Prefix, tt.want.Prefix)
 				}
@@ -134,6 +353,9 @@ func Test_generateJrpUseCase_RunWithPrefix(t *testing.T) {
 				if got.IsFavorited != tt.want.IsFavorited {
 					t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want %v", got.IsFavorited, tt.want.IsFavorited)
 				}
+				if got.PrefixWord != nil || got.SuffixWord == nil || got.SuffixWord.WordID != tt.want.WordIDs[0] {
+					t.Errorf("generateJrpUseCase.RunWithPrefix() = %v %v, want nil and the word %v", got.PrefixWord, got.SuffixWord, tt.want.WordIDs[0])
+				}
 			}
 		})
 	}
@@ -155,7 +377,7 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 		cleanup func()
 	}{
 		{
-			name: "positive testing (len(dtos) == 0)",
+			name: "positive testing (no words in the pool)",
 			args: args{
 				dtos:   nil,
 				suffix: "suffix",
@@ -164,6 +386,24 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 			setup:   nil,
 			cleanup: nil,
 		},
+		{
+			name: "positive testing (no adjectives or verbs in the pool)",
+			args: args{
+				dtos: []*GenerateJrpUseCaseInputDto{
+					{
+						WordID: 1,
+						Lang:   "jpn",
+						Lemma:  "testn",
+						Pron:   "test",
+						Pos:    "n",
+					},
+				},
+				suffix: "suffix",
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
 		{
 			name: "positive testing",
 			args: args{
@@ -188,14 +428,14 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 			want: &GenerateJrpUseCaseOutputDto{
 				ID:          0,
 				Phrase:      "testasuffix",
+				WordIDs:     []int{2},
 				Prefix:      "",
 				Suffix:      "suffix",
 				IsFavorited: 0,
 			},
 			setup: func(mockCtrl *gomock.Controller) {
 				mockRu := utility.NewMockRandUtil(mockCtrl)
-				mockRu.EXPECT().GenerateRandomNumber(2).Return(0)
-				mockRu.EXPECT().GenerateRandomNumber(2).Return(1)
+				mockRu.EXPECT().GenerateRandomNumber(1).Return(0)
 				ru = mockRu
 			},
 			cleanup: func() {
@@ -216,7 +456,7 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 				}
 			}()
 			uc := &generateJrpUseCase{}
-			got := uc.RunWithSuffix(tt.args.dtos, tt.args.suffix)
+			got := uc.RunWithSuffix(NewWordPool(tt.args.dtos), tt.args.suffix)
 			if (got == nil) != (tt.want == nil) {
 				t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want not nil", got)
 			}
@@ -227,6 +467,9 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 				if got.Phrase != tt.want.Phrase {
 					t.Errorf("generateJrpUseCase.RunWithSuffix() = %v, want %v", got.Phrase, tt.want.Phrase)
 				}
+				if !reflect.DeepEqual(got.WordIDs, tt.want.WordIDs) {
+					t.Errorf("generateJrpUseCase.RunWithSuffix() = %v, want %v", got.WordIDs, tt.want.WordIDs)
+				}
 				if got.Suffix != tt.want.Suffix {
 					t.Errorf("generateJrpUseCase.RunWithSuffix() = %v, want %v", got.Suffix, tt.want.Suffix)
 				}
@@ -236,6 +479,9 @@ func Test_generateJrpUseCase_RunWithSuffix(t *testing.T) {
 				if got.IsFavorited != tt.want.IsFavorited {
 					t.Errorf("generateJrpUseCase.RunWithSuffix() = %v, want %v", got.IsFavorited, tt.want.IsFavorited)
 				}
+				if got.PrefixWord == nil || got.PrefixWord.WordID != tt.want.WordIDs[0] || got.SuffixWord != nil {
+					t.Errorf("generateJrpUseCase.RunWithSuffix() = %v %v, want the word %v and nil", got.PrefixWord, got.SuffixWord, tt.want.WordIDs[0])
+				}
 			}
 		})
 	}
@@ -256,7 +502,7 @@ func Test_generateJrpUseCase_RunWithRandom(t *testing.T) {
 		cleanup func()
 	}{
 		{
-			name: "positive testing (len(dtos) == 0)",
+			name: "positive testing (no words in the pool)",
 			args: args{
 				dtos: nil,
 			},
@@ -315,18 +561,15 @@ func Test_generateJrpUseCase_RunWithRandom(t *testing.T) {
 			want: &GenerateJrpUseCaseOutputDto{
 				ID:          0,
 				Phrase:      "testatestn",
+				WordIDs:     []int{5, 6},
 				Prefix:      "",
 				Suffix:      "",
 				IsFavorited: 0,
 			},
 			setup: func(mockCtrl *gomock.Controller) {
 				mockRu := utility.NewMockRandUtil(mockCtrl)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(0)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(1)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(2)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(3)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(4)
-				mockRu.EXPECT().GenerateRandomNumber(6).Return(5)
+				mockRu.EXPECT().GenerateRandomNumber(4).Return(2)
+				mockRu.EXPECT().GenerateRandomNumber(2).Return(1)
 				ru = mockRu
 			},
 			cleanup: func() {
@@ -347,7 +590,7 @@ func Test_generateJrpUseCase_RunWithRandom(t *testing.T) {
 				}
 			}()
 			uc := &generateJrpUseCase{}
-			got := uc.RunWithRandom(tt.args.dtos)
+			got := uc.RunWithRandom(NewWordPool(tt.args.dtos))
 			if (got == nil) != (tt.want == nil) {
 				t.Errorf("generateJrpUseCase.RunWithPrefix() = %v, want not nil", got)
 			}
@@ -358,6 +601,9 @@ func Test_generateJrpUseCase_RunWithRandom(t *testing.T) {
 				if got.Phrase != tt.want.Phrase {
 					t.Errorf("generateJrpUseCase.RunWithRandom() = %v, want %v", got.Phrase, tt.want.Phrase)
 				}
+				if !reflect.DeepEqual(got.WordIDs, tt.want.WordIDs) {
+					t.Errorf("generateJrpUseCase.RunWithRandom() = %v, want %v", got.WordIDs, tt.want.WordIDs)
+				}
 				if got.Prefix != tt.want.Prefix {
 					t.Errorf("generateJrpUseCase.RunWithRandom() = %v, want %v", got.Prefix, tt.want.Prefix)
 				}
@@ -367,6 +613,429 @@ func Test_generateJrpUseCase_RunWithRandom(t *testing.T) {
 				if got.IsFavorited != tt.want.IsFavorited {
 					t.Errorf("generateJrpUseCase.RunWithRandom() = %v, want %v", got.IsFavorited, tt.want.IsFavorited)
 				}
+				if got.PrefixWord == nil || got.PrefixWord.WordID != tt.want.WordIDs[0] || got.SuffixWord == nil || got.SuffixWord.WordID != tt.want.WordIDs[1] {
+					t.Errorf("generateJrpUseCase.RunWithRandom() = %v %v, want the words %v", got.PrefixWord, got.SuffixWord, tt.want.WordIDs)
+				}
+			}
+		})
+	}
+}
+
+func Test_generateJrpUseCase_RunWithWords(t *testing.T) {
+	origRu := ru
+
+	dtos := []*GenerateJrpUseCaseInputDto{
+		{
+			WordID: 1,
+			Lang:   "jpn",
+			Lemma:  "testn",
+			Pron:   "test",
+			Pos:    "n",
+		},
+		{
+			WordID: 2,
+			Lang:   "jpn",
+			Lemma:  "testa",
+			Pron:   "test",
+			Pos:    "a",
+		},
+		{
+			WordID: 3,
+			Lang:   "jpn",
+			Lemma:  "testv",
+			Pron:   "test",
+			Pos:    "v",
+		},
+		{
+			WordID: 4,
+			Lang:   "jpn",
+			Lemma:  "testm",
+			Pron:   "test",
+			Pos:    "n",
+		},
+	}
+
+	type args struct {
+		dtos       []*GenerateJrpUseCaseInputDto
+		prefixWord *GenerateJrpUseCaseInputDto
+		suffixWord *GenerateJrpUseCaseInputDto
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *GenerateJrpUseCaseOutputDto
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (both words are given)",
+			args: args{
+				dtos:       nil,
+				prefixWord: dtos[1],
+				suffixWord: dtos[0],
+			},
+			want: &GenerateJrpUseCaseOutputDto{
+				ID:          0,
+				Phrase:      "testatestn",
+				WordIDs:     []int{2, 1},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (the prefix word is random)",
+			args: args{
+				dtos:       dtos,
+				prefixWord: nil,
+				suffixWord: dtos[0],
+			},
+			want: &GenerateJrpUseCaseOutputDto{
+				ID:          0,
+				Phrase:      "testvtestn",
+				WordIDs:     []int{3, 1},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockRu := utility.NewMockRandUtil(mockCtrl)
+				mockRu.EXPECT().GenerateRandomNumber(2).Return(1)
+				ru = mockRu
+			},
+			cleanup: func() {
+				ru = origRu
+			},
+		},
+		{
+			name: "positive testing (the suffix word is random)",
+			args: args{
+				dtos:       dtos,
+				prefixWord: dtos[1],
+				suffixWord: nil,
+			},
+			want: &GenerateJrpUseCaseOutputDto{
+				ID:          0,
+				Phrase:      "testatestm",
+				WordIDs:     []int{2, 4},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockRu := utility.NewMockRandUtil(mockCtrl)
+				mockRu.EXPECT().GenerateRandomNumber(2).Return(1)
+				ru = mockRu
+			},
+			cleanup: func() {
+				ru = origRu
+			},
+		},
+		{
+			name: "positive testing (no adjectives and verbs)",
+			args: args{
+				dtos:       []*GenerateJrpUseCaseInputDto{dtos[0]},
+				prefixWord: nil,
+				suffixWord: dtos[0],
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (no nouns)",
+			args: args{
+				dtos:       []*GenerateJrpUseCaseInputDto{dtos[1]},
+				prefixWord: dtos[1],
+				suffixWord: nil,
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			uc := &generateJrpUseCase{}
+			got := uc.RunWithWords(NewWordPool(tt.args.dtos), tt.args.prefixWord, tt.args.suffixWord)
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("generateJrpUseCase.RunWithWords() = %v, want %v", got, tt.want)
+			}
+			if got != nil && tt.want != nil {
+				if got.Phrase != tt.want.Phrase {
+					t.Errorf("generateJrpUseCase.RunWithWords() = %v, want %v", got.Phrase, tt.want.Phrase)
+				}
+				if !reflect.DeepEqual(got.WordIDs, tt.want.WordIDs) {
+					t.Errorf("generateJrpUseCase.RunWithWords() = %v, want %v", got.WordIDs, tt.want.WordIDs)
+				}
+				if got.Prefix != tt.want.Prefix || got.Suffix != tt.want.Suffix || got.IsFavorited != tt.want.IsFavorited {
+					t.Errorf("generateJrpUseCase.RunWithWords() = %v, want %v", got, tt.want)
+				}
+				if got.PrefixWord == nil || got.PrefixWord.WordID != tt.want.WordIDs[0] || got.SuffixWord == nil || got.SuffixWord.WordID != tt.want.WordIDs[1] {
+					t.Errorf("generateJrpUseCase.RunWithWords() = %v %v, want the words %v", got.PrefixWord, got.SuffixWord, tt.want.WordIDs)
+				}
+			}
+		})
+	}
+}
+
+func Test_generateJrpUseCase_RunWithTemplate(t *testing.T) {
+	origRu := ru
+
+	type args struct {
+		dtos      []*GenerateJrpUseCaseInputDto
+		template  string
+		conjugate bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *GenerateJrpUseCaseOutputDto
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (no words in the pool)",
+			args: args{
+				dtos:     nil,
+				template: "{a}{n}",
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing",
+			args: args{
+				dtos: []*GenerateJrpUseCaseInputDto{
+					{
+						WordID: 1,
+						Lang:   "jpn",
+						Lemma:  "testa",
+						Pron:   "test",
+						Pos:    "a",
+					},
+					{
+						WordID: 2,
+						Lang:   "jpn",
+						Lemma:  "testn1",
+						Pron:   "test",
+						Pos:    "n",
+					},
+					{
+						WordID: 3,
+						Lang:   "jpn",
+						Lemma:  "testn2",
+						Pron:   "test",
+						Pos:    "n",
+					},
+				},
+				template: "{a}{n}の{n}",
+			},
+			want: &GenerateJrpUseCaseOutputDto{
+				ID:          0,
+				Phrase:      "testatestn2のtestn1",
+				Reading:     "testtestのtest",
+				Romaji:      "testtestnotest",
+				WordIDs:     []int{1, 3, 2},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockRu := utility.NewMockRandUtil(mockCtrl)
+				mockRu.EXPECT().GenerateRandomNumber(1).Return(0)
+				mockRu.EXPECT().GenerateRandomNumber(2).Return(1)
+				mockRu.EXPECT().GenerateRandomNumber(2).Return(0)
+				ru = mockRu
+			},
+			cleanup: func() {
+				ru = origRu
+			},
+		},
+		{
+			name: "positive testing (conjugate is true)",
+			args: args{
+				dtos: []*GenerateJrpUseCaseInputDto{
+					{
+						WordID: 1,
+						Lang:   "jpn",
+						Lemma:  "静か",
+						Pron:   "しずか",
+						Pos:    "a",
+					},
+					{
+						WordID: 2,
+						Lang:   "jpn",
+						Lemma:  "散歩",
+						Pron:   "さんぽ",
+						Pos:    "v",
+					},
+					{
+						WordID: 3,
+						Lang:   "jpn",
+						Lemma:  "猫",
+						Pron:   "ねこ",
+						Pos:    "n",
+					},
+				},
+				template:  "{a}{n}は{v}",
+				conjugate: true,
+			},
+			want: &GenerateJrpUseCaseOutputDto{
+				ID:          0,
+				Phrase:      "静かな猫は散歩",
+				Reading:     "しずかなねこはさんぽ",
+				Romaji:      "shizukananekohasanpo",
+				WordIDs:     []int{1, 3, 2},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockRu := utility.NewMockRandUtil(mockCtrl)
+				mockRu.EXPECT().GenerateRandomNumber(1).Return(0).Times(3)
+				ru = mockRu
+			},
+			cleanup: func() {
+				ru = origRu
+			},
+		},
+		{
+			name: "negative testing (no words for the slot)",
+			args: args{
+				dtos: []*GenerateJrpUseCaseInputDto{
+					{
+						WordID: 1,
+						Lang:   "jpn",
+						Lemma:  "testn",
+						Pron:   "test",
+						Pos:    "n",
+					},
+				},
+				template: "{v}{n}",
+			},
+			want:    nil,
+			setup:   nil,
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			template, err := ParseJrpTemplate(tt.args.template)
+			if err != nil {
+				t.Fatalf("ParseJrpTemplate() error = %v", err)
+			}
+			uc := &generateJrpUseCase{conjugate: tt.args.conjugate}
+			got := uc.RunWithTemplate(NewWordPool(tt.args.dtos), template)
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got, tt.want)
+			}
+			if got != nil && tt.want != nil {
+				if got.Phrase != tt.want.Phrase {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.Phrase, tt.want.Phrase)
+				}
+				if !reflect.DeepEqual(got.WordIDs, tt.want.WordIDs) {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.WordIDs, tt.want.WordIDs)
+				}
+				if got.Reading != tt.want.Reading {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.Reading, tt.want.Reading)
+				}
+				if got.Romaji != tt.want.Romaji {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.Romaji, tt.want.Romaji)
+				}
+				if got.Prefix != tt.want.Prefix {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.Prefix, tt.want.Prefix)
+				}
+				if got.Suffix != tt.want.Suffix {
+					t.Errorf("generateJrpUseCase.RunWithTemplate() = %v, want %v", got.Suffix, tt.want.Suffix)
+				}
+			}
+		})
+	}
+}
+
+func Test_generateJrpUseCase_attributive(t *testing.T) {
+	type args struct {
+		dto *GenerateJrpUseCaseInputDto
+	}
+	tests := []struct {
+		name        string
+		uc          *generateJrpUseCase
+		args        args
+		wantLemma   string
+		wantReading string
+	}{
+		{
+			name: "positive testing (conjugate is true)",
+			uc:   &generateJrpUseCase{conjugate: true},
+			args: args{
+				dto: &GenerateJrpUseCaseInputDto{
+					Lemma: "静か",
+					Pron:  "シズカ",
+					Pos:   "a",
+				},
+			},
+			wantLemma:   "静かな",
+			wantReading: "しずかな",
+		},
+		{
+			name: "positive testing (conjugate is true, the ending of the lemma is replaced)",
+			uc:   &generateJrpUseCase{conjugate: true},
+			args: args{
+				dto: &GenerateJrpUseCaseInputDto{
+					Lemma: "静かだ",
+					Pron:  "シズカダ",
+					Pos:   "a",
+				},
+			},
+			wantLemma:   "静かな",
+			wantReading: "しずかな",
+		},
+		{
+			name: "positive testing (conjugate is false)",
+			uc:   &generateJrpUseCase{conjugate: false},
+			args: args{
+				dto: &GenerateJrpUseCaseInputDto{
+					Lemma: "静か",
+					Pron:  "シズカ",
+					Pos:   "a",
+				},
+			},
+			wantLemma:   "静か",
+			wantReading: "しずか",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLemma, gotReading := tt.uc.attributive(tt.args.dto)
+			if gotLemma != tt.wantLemma {
+				t.Errorf("generateJrpUseCase.attributive() gotLemma = %v, want %v", gotLemma, tt.wantLemma)
+			}
+			if gotReading != tt.wantReading {
+				t.Errorf("generateJrpUseCase.attributive() gotReading = %v, want %v", gotReading, tt.wantReading)
 			}
 		})
 	}
@@ -2,6 +2,7 @@ package jrp
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
@@ -27,6 +28,12 @@ type GetHistoryUseCaseOutputDto struct {
 	ID int
 	// Phrase is the generated phrase.
 	Phrase string
+	// Reading is the reading of the generated phrase in the hiragana.
+	Reading string
+	// Romaji is the reading of the generated phrase in the romaji.
+	Romaji string
+	// WordIDs is the IDs of the words in the WordNet Japan the phrase is generated from.
+	WordIDs []int
 	// Prefix is the prefix when the phrase is generated.
 	Prefix string
 	// Suffix is the suffix when the phrase is generated.
@@ -39,19 +46,43 @@ type GetHistoryUseCaseOutputDto struct {
 	UpdatedAt time.Time
 }
 
+// GetHistoryUseCaseInputDto is a DTO struct that contains the input data of the GetHistoryUseCase.
+type GetHistoryUseCaseInputDto struct {
+	// All is the flag to get all the histories.
+	All bool
+	// Favorited is the flag to get only the favorited histories.
+	Favorited bool
+	// Number is the number of the most recent histories to get. It is ignored if All is true.
+	Number int
+	// Offset is the number of the most recent histories to skip. It is ignored if All is true.
+	Offset int
+	// Cursor is the ID the histories to get are older than. No cursor is used if it is zero or less.
+	Cursor int
+	// Tag is the tag the histories are tagged with. All the histories are got if it is empty.
+	Tag string
+	// Since is the inclusive lower bound of the timestamp when the phrase is created. It is unbounded if it is zero.
+	Since time.Time
+	// Until is the exclusive upper bound of the timestamp when the phrase is created. It is unbounded if it is zero.
+	Until time.Time
+	// Sort is the key to sort the histories by. "id", "created" and "phrase" are available. The histories are sorted by the ID if it is empty.
+	Sort string
+	// Desc is the flag to sort the histories in descending order.
+	Desc bool
+	// Trashed is the flag to get the histories in the trash instead of the others.
+	Trashed bool
+}
+
 // Run returns the output of the GetHistoryUseCase.
-func (uc *getHistoryUseCase) Run(ctx context.Context, all bool, favorited bool, number int) ([]*GetHistoryUseCaseOutputDto, error) {
-	var histories []*historyDomain.History
-	var err error
-	if all && favorited {
-		histories, err = uc.historyRepo.FindByIsFavoritedIs(ctx, 1)
-	} else if all && !favorited {
-		histories, err = uc.historyRepo.FindAll(ctx)
-	} else if !all && favorited {
-		histories, err = uc.historyRepo.FindTopNByIsFavoritedIsAndByOrderByIdAsc(ctx, number, 1)
-	} else {
-		histories, err = uc.historyRepo.FindTopNByOrderByIdAsc(ctx, number)
+func (uc *getHistoryUseCase) Run(ctx context.Context, ghiDto *GetHistoryUseCaseInputDto) ([]*GetHistoryUseCaseOutputDto, error) {
+	if !ghiDto.All && ghiDto.Number <= 0 {
+		return nil, nil
 	}
+	spec, err := newHistorySpec(nil, false, ghiDto.All, ghiDto.Favorited, ghiDto.Number, ghiDto.Offset, ghiDto.Cursor, ghiDto.Tag, ghiDto.Since, ghiDto.Until, ghiDto.Sort, ghiDto.Desc)
+	if err != nil {
+		return nil, err
+	}
+	spec.Trashed = ghiDto.Trashed
+	histories, err := uc.historyRepo.FindBySpec(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +92,9 @@ func (uc *getHistoryUseCase) Run(ctx context.Context, all bool, favorited bool,
 		ucDtos = append(ucDtos, &GetHistoryUseCaseOutputDto{
 			ID:          history.ID,
 			Phrase:      history.Phrase,
+			Reading:     history.Reading.String,
+			Romaji:      history.Romaji.String,
+			WordIDs:     history.GetWordIDs(),
 			Prefix:      history.Prefix.String,
 			Suffix:      history.Suffix.String,
 			IsFavorited: history.IsFavorited,
@@ -71,3 +105,76 @@ func (uc *getHistoryUseCase) Run(ctx context.Context, all bool, favorited bool,
 
 	return ucDtos, nil
 }
+
+// RunCount returns the number of the histories matching the input of the GetHistoryUseCase.
+// the number, the offset and the cursor of the input are ignored.
+func (uc *getHistoryUseCase) RunCount(ctx context.Context, ghiDto *GetHistoryUseCaseInputDto) (int, error) {
+	spec, err := newHistorySpec(nil, false, true, ghiDto.Favorited, 0, 0, 0, ghiDto.Tag, ghiDto.Since, ghiDto.Until, ghiDto.Sort, ghiDto.Desc)
+	if err != nil {
+		return 0, err
+	}
+	spec.Trashed = ghiDto.Trashed
+
+	return uc.historyRepo.CountBySpec(ctx, spec)
+}
+
+// RunById returns the output of the GetHistoryUseCase by the ID.
+// it returns nil if the history of the ID does not exist.
+func (uc *getHistoryUseCase) RunById(ctx context.Context, id int) (*GetHistoryUseCaseOutputDto, error) {
+	history, err := uc.historyRepo.FindByIdIs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		return nil, nil
+	}
+
+	return &GetHistoryUseCaseOutputDto{
+		ID:          history.ID,
+		Phrase:      history.Phrase,
+		Reading:     history.Reading.String,
+		Romaji:      history.Romaji.String,
+		WordIDs:     history.GetWordIDs(),
+		Prefix:      history.Prefix.String,
+		Suffix:      history.Suffix.String,
+		IsFavorited: history.IsFavorited,
+		CreatedAt:   history.CreatedAt,
+		UpdatedAt:   history.UpdatedAt,
+	}, nil
+}
+
+// newHistorySpec returns the spec of the histories to find from the input of the use cases.
+func newHistorySpec(
+	keywords []string,
+	and bool,
+	all bool,
+	favorited bool,
+	number int,
+	offset int,
+	cursor int,
+	tag string,
+	since time.Time,
+	until time.Time,
+	sort string,
+	desc bool,
+) (*historyDomain.HistorySpec, error) {
+	sortBy, ok := historyDomain.ParseSortKey(sort)
+	if !ok {
+		return nil, errors.New("invalid sort key")
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return nil, errors.New("since is not before until")
+	}
+	if offset < 0 {
+		return nil, errors.New("offset is negative")
+	}
+	if cursor > 0 && sortBy != historyDomain.SortById {
+		return nil, errors.New("cursor is not supported for the sort key")
+	}
+	if all {
+		number = 0
+		offset = 0
+	}
+
+	return historyDomain.NewHistorySpec(keywords, and, favorited, tag, since, until, number, offset, cursor, sortBy, desc), nil
+}
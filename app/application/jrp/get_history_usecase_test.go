@@ -6,6 +6,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
 
@@ -52,14 +53,15 @@ func TestNewGetHistoryUseCase(t *testing.T) {
 }
 
 func Test_getHistoryUseCase_Run(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
 	type fields struct {
 		historyRepo historyDomain.HistoryRepository
 	}
 	type args struct {
-		ctx       context.Context
-		all       bool
-		favorited bool
-		number    int
+		ctx    context.Context
+		ghiDto *GetHistoryUseCaseInputDto
 	}
 	tests := []struct {
 		name    string
@@ -75,184 +77,285 @@ func Test_getHistoryUseCase_Run(t *testing.T) {
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				all:       true,
-				favorited: true,
-				number:    0,
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					All:       true,
+					Favorited: true,
+					Number:    10,
+				},
 			},
 			want: []*GetHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     1,
+					Phrase: "test",
 				},
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     2,
+					Phrase: "test2",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindByIsFavoritedIs(gomock.Any(), 1).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, true, "", time.Time{}, time.Time{}, 0, 0, 0, historyDomain.SortById, false)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     1,
+						Phrase: "test",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     2,
+						Phrase: "test2",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (all and not favorited)",
+			name: "positive testing (not all, number 1)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				all:       true,
-				favorited: false,
-				number:    0,
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 1,
+				},
 			},
 			want: []*GetHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     2,
+					Phrase: "test2",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 1, 0, 0, historyDomain.SortById, false)).Return([]*historyDomain.History{
+					{
+						ID:     2,
+						Phrase: "test2",
+					},
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (tag, since, until, sort by phrase desc)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 10,
+					Tag:    "tag",
+					Since:  since,
+					Until:  until,
+					Sort:   "phrase",
+					Desc:   true,
 				},
+			},
+			want: []*GetHistoryUseCaseOutputDto{
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     2,
+					Phrase: "test2",
+				},
+				{
+					ID:     1,
+					Phrase: "test",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindAll(gomock.Any()).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "tag", since, until, 10, 0, 0, historyDomain.SortByPhrase, true)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     2,
+						Phrase: "test2",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     1,
+						Phrase: "test",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (not all and favorited)",
+			name: "positive testing (number 0)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 0,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid sort key)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 10,
+					Sort:   "test",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (since is not before until)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 10,
+					Since:  until,
+					Until:  since,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (FindBySpec(ctx, spec) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 10,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), gomock.Any()).Return(nil, errors.New("HistoryRepository.FindBySpec() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (offset and cursor)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				all:       false,
-				favorited: true,
-				number:    1,
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 1,
+					Offset: 1,
+					Cursor: 3,
+				},
 			},
 			want: []*GetHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     1,
+					Phrase: "test",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindTopNByIsFavoritedIsAndByOrderByIdAsc(gomock.Any(), 1, 1).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 1, 1, 3, historyDomain.SortById, false)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     1,
+						Phrase: "test",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (not all and not favorited)",
+			name: "positive testing (trashed)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				all:       false,
-				favorited: false,
-				number:    1,
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					All:     true,
+					Trashed: true,
+				},
 			},
 			want: []*GetHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     2,
+					Phrase: "test2",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				spec := historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 0, 0, 0, historyDomain.SortById, false)
+				spec.Trashed = true
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindTopNByOrderByIdAsc(gomock.Any(), 1).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), spec).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     2,
+						Phrase: "test2",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "negative testing (err != nil)",
+			name: "negative testing (cursor with the sort key phrase)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 1,
+					Cursor: 3,
+					Sort:   "phrase",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (offset is negative)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				all:       true,
-				favorited: true,
-				number:    0,
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Number: 1,
+					Offset: -1,
+				},
 			},
 			want:    nil,
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindByIsFavoritedIs(gomock.Any(), 1).Return(nil, errors.New("HistoryRepository.FindByIsFavoritedIs() failed"))
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
@@ -267,30 +370,224 @@ func Test_getHistoryUseCase_Run(t *testing.T) {
 			uc := &getHistoryUseCase{
 				historyRepo: tt.fields.historyRepo,
 			}
-			got, err := uc.Run(tt.args.ctx, tt.args.all, tt.args.favorited, tt.args.number)
+			got, err := uc.Run(tt.args.ctx, tt.args.ghiDto)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("getHistoryUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != nil && tt.want != nil {
-				for i := range got {
-					if got[i].ID != tt.want[i].ID {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].ID, tt.want[i].ID)
-					}
-					if got[i].Phrase != tt.want[i].Phrase {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Phrase, tt.want[i].Phrase)
-					}
-					if got[i].Prefix != tt.want[i].Prefix {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Prefix, tt.want[i].Prefix)
-					}
-					if got[i].Suffix != tt.want[i].Suffix {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Suffix, tt.want[i].Suffix)
-					}
-					if got[i].IsFavorited != tt.want[i].IsFavorited {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].IsFavorited, tt.want[i].IsFavorited)
-					}
+			if len(got) != len(tt.want) {
+				t.Errorf("getHistoryUseCase.Run() : len(got) = %v, want %v", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Phrase, tt.want[i].Phrase)
 				}
 			}
 		})
 	}
 }
+
+func Test_getHistoryUseCase_RunCount(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx    context.Context
+		ghiDto *GetHistoryUseCaseInputDto
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    int
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Favorited: true,
+					Number:    10,
+					Offset:    10,
+					Cursor:    3,
+					Tag:       "tag",
+				},
+			},
+			want:    2,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().CountBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, true, "tag", time.Time{}, time.Time{}, 0, 0, 0, historyDomain.SortById, false)).Return(2, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid sort key)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{
+					Sort: "test",
+				},
+			},
+			want:    0,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (CountBySpec(ctx, spec) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				ghiDto: &GetHistoryUseCaseInputDto{},
+			},
+			want:    0,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().CountBySpec(gomock.Any(), gomock.Any()).Return(0, errors.New("HistoryRepository.CountBySpec() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getHistoryUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.RunCount(tt.args.ctx, tt.args.ghiDto)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getHistoryUseCase.RunCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("getHistoryUseCase.RunCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getHistoryUseCase_RunById(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *GetHistoryUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want: &GetHistoryUseCaseOutputDto{
+				ID:          1,
+				Phrase:      "test",
+				WordIDs:     []int{1, 2},
+				Prefix:      "",
+				Suffix:      "",
+				IsFavorited: 0,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindByIdIs(gomock.Any(), 1).Return(&historyDomain.History{
+					ID:          1,
+					Phrase:      "test",
+					WordIDs:     sql.NullString{String: "1,2", Valid: true},
+					Prefix:      sql.NullString{String: "", Valid: false},
+					Suffix:      sql.NullString{String: "", Valid: false},
+					IsFavorited: 0,
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (history not found)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindByIdIs(gomock.Any(), 1).Return(nil, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (uc.historyRepo.FindByIdIs(ctx, id) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindByIdIs(gomock.Any(), 1).Return(nil, errors.New("HistoryRepository.FindByIdIs() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getHistoryUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.RunById(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getHistoryUseCase.RunById() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getHistoryUseCase.RunById() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
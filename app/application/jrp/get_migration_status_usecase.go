@@ -0,0 +1,52 @@
+package jrp
+
+import (
+	"context"
+	"time"
+)
+
+// getMigrationStatusUseCase is a struct that contains the use case of getting the status of the migrations of the jrp database.
+type getMigrationStatusUseCase struct {
+	schemaMigrator SchemaMigrator
+}
+
+// NewGetMigrationStatusUseCase returns a new instance of the GetMigrationStatusUseCase struct.
+func NewGetMigrationStatusUseCase(
+	schemaMigrator SchemaMigrator,
+) *getMigrationStatusUseCase {
+	return &getMigrationStatusUseCase{
+		schemaMigrator: schemaMigrator,
+	}
+}
+
+// GetMigrationStatusUseCaseOutputDto is a DTO struct that contains the output data of the GetMigrationStatusUseCase.
+type GetMigrationStatusUseCaseOutputDto struct {
+	// Version is the version of the schema the migration migrates to.
+	Version int
+	// Description is the description of the migration.
+	Description string
+	// IsApplied is the flag to indicate whether the migration is applied.
+	IsApplied bool
+	// AppliedAt is the timestamp when the migration is applied.
+	AppliedAt time.Time
+}
+
+// Run returns the output of the GetMigrationStatusUseCase.
+func (uc *getMigrationStatusUseCase) Run(ctx context.Context) ([]*GetMigrationStatusUseCaseOutputDto, error) {
+	migrations, err := uc.schemaMigrator.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ucDtos []*GetMigrationStatusUseCaseOutputDto
+	for _, migration := range migrations {
+		ucDtos = append(ucDtos, &GetMigrationStatusUseCaseOutputDto{
+			Version:     migration.Version,
+			Description: migration.Description,
+			IsApplied:   migration.IsApplied,
+			AppliedAt:   migration.AppliedAt,
+		})
+	}
+
+	return ucDtos, nil
+}
@@ -0,0 +1,147 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetMigrationStatusUseCase(t *testing.T) {
+	type args struct {
+		schemaMigrator SchemaMigrator
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *getMigrationStatusUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *getMigrationStatusUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				schemaMigrator: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *getMigrationStatusUseCase {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				tt.schemaMigrator = mockSchemaMigrator
+				return &getMigrationStatusUseCase{
+					schemaMigrator: mockSchemaMigrator,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewGetMigrationStatusUseCase(tt.args.schemaMigrator); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGetMigrationStatusUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getMigrationStatusUseCase_Run(t *testing.T) {
+	appliedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		schemaMigrator SchemaMigrator
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*GetMigrationStatusUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				schemaMigrator: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*GetMigrationStatusUseCaseOutputDto{
+				{
+					Version:     1,
+					Description: "create the history table",
+					IsApplied:   true,
+					AppliedAt:   appliedAt,
+				},
+				{
+					Version:     2,
+					Description: "add the reading columns to the history table",
+					IsApplied:   false,
+					AppliedAt:   time.Time{},
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				mockSchemaMigrator.EXPECT().FindAll(gomock.Any()).Return([]*SchemaMigrationDto{
+					{
+						Version:     1,
+						Description: "create the history table",
+						IsApplied:   true,
+						AppliedAt:   appliedAt,
+					},
+					{
+						Version:     2,
+						Description: "add the reading columns to the history table",
+						IsApplied:   false,
+						AppliedAt:   time.Time{},
+					},
+				}, nil)
+				tt.schemaMigrator = mockSchemaMigrator
+			},
+		},
+		{
+			name: "negative testing (SchemaMigrator.FindAll() failed)",
+			fields: fields{
+				schemaMigrator: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				mockSchemaMigrator.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("SchemaMigrator.FindAll() failed"))
+				tt.schemaMigrator = mockSchemaMigrator
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getMigrationStatusUseCase{
+				schemaMigrator: tt.fields.schemaMigrator,
+			}
+			got, err := uc.Run(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getMigrationStatusUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getMigrationStatusUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
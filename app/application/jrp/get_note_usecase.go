@@ -0,0 +1,50 @@
+package jrp
+
+import (
+	"context"
+	"time"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// getNoteUseCase is a struct that contains the use case of the getting the note of jrp from the table history_note in jrp sqlite database.
+type getNoteUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewGetNoteUseCase returns a new instance of the GetNoteUseCase struct.
+func NewGetNoteUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *getNoteUseCase {
+	return &getNoteUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// GetNoteUseCaseOutputDto is a DTO struct that contains the output data of the GetNoteUseCase.
+type GetNoteUseCaseOutputDto struct {
+	// ID is the identifier of the phrase the note is written on.
+	ID int
+	// Note is the note of the phrase.
+	Note string
+	// UpdatedAt is the timestamp when the note is updated.
+	UpdatedAt time.Time
+}
+
+// Run returns the output of the GetNoteUseCase.
+// it returns nil if the history of the ID has no note.
+func (uc *getNoteUseCase) Run(ctx context.Context, id int) (*GetNoteUseCaseOutputDto, error) {
+	note, err := uc.historyRepo.FindNoteByIdIs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if note == nil {
+		return nil, nil
+	}
+
+	return &GetNoteUseCaseOutputDto{
+		ID:        note.HistoryID,
+		Note:      note.Note,
+		UpdatedAt: note.UpdatedAt,
+	}, nil
+}
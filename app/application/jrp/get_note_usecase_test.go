@@ -0,0 +1,147 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetNoteUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *getNoteUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *getNoteUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *getNoteUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &getNoteUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewGetNoteUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGetNoteUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getNoteUseCase_Run(t *testing.T) {
+	now := time.Now()
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *GetNoteUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (the note exists)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want: &GetNoteUseCaseOutputDto{
+				ID:        1,
+				Note:      "note",
+				UpdatedAt: now,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindNoteByIdIs(gomock.Any(), 1).Return(historyDomain.NewNote(1, "note", now), nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (the note does not exist)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindNoteByIdIs(gomock.Any(), 1).Return(nil, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (uc.historyRepo.FindNoteByIdIs(ctx, id) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindNoteByIdIs(gomock.Any(), 1).Return(nil, errors.New("HistoryRepository.FindNoteByIdIs() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getNoteUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getNoteUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getNoteUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package jrp
+
+import (
+	"context"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// getTagsUseCase is a struct that contains the use case of the getting the tags of jrp from the table history_tag in jrp sqlite database.
+type getTagsUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewGetTagsUseCase returns a new instance of the GetTagsUseCase struct.
+func NewGetTagsUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *getTagsUseCase {
+	return &getTagsUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// GetTagsUseCaseOutputDto is a DTO struct that contains the output data of the GetTagsUseCase.
+type GetTagsUseCaseOutputDto struct {
+	// Name is the name of the tag.
+	Name string
+	// HistoryIDs is the IDs of the phrases tagged with the tag.
+	HistoryIDs []int
+}
+
+// Run returns the output of the GetTagsUseCase.
+// the tags are ordered by the name.
+func (uc *getTagsUseCase) Run(ctx context.Context) ([]*GetTagsUseCaseOutputDto, error) {
+	tags, err := uc.historyRepo.FindAllTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ucDtos []*GetTagsUseCaseOutputDto
+	for _, tag := range tags {
+		if len(ucDtos) == 0 || ucDtos[len(ucDtos)-1].Name != tag.Name {
+			ucDtos = append(ucDtos, &GetTagsUseCaseOutputDto{
+				Name: tag.Name,
+			})
+		}
+		ucDtos[len(ucDtos)-1].HistoryIDs = append(ucDtos[len(ucDtos)-1].HistoryIDs, tag.HistoryID)
+	}
+
+	return ucDtos, nil
+}
@@ -0,0 +1,150 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetTagsUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *getTagsUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *getTagsUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *getTagsUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &getTagsUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewGetTagsUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGetTagsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getTagsUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*GetTagsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (no tags)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindAllTags(gomock.Any()).Return([]*historyDomain.Tag{}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (the tags are grouped by the name)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*GetTagsUseCaseOutputDto{
+				{
+					Name:       "release-names",
+					HistoryIDs: []int{1, 3},
+				},
+				{
+					Name:       "team-names",
+					HistoryIDs: []int{2},
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindAllTags(gomock.Any()).Return([]*historyDomain.Tag{
+					historyDomain.NewTag(1, "release-names"),
+					historyDomain.NewTag(3, "release-names"),
+					historyDomain.NewTag(2, "team-names"),
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (uc.historyRepo.FindAllTags(ctx) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindAllTags(gomock.Any()).Return(nil, errors.New("HistoryRepository.FindAllTags() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getTagsUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.Run(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getTagsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTagsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
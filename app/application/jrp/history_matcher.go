@@ -0,0 +1,150 @@
+package jrp
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+const (
+	// fuzzyThreshold is the lowest similarity score of the histories matched by the fuzzy matcher.
+	fuzzyThreshold = 0.6
+)
+
+// historyMatcher is a function that returns whether the history matches and the similarity score of it.
+type historyMatcher func(h *historyDomain.History) (bool, float64)
+
+// newRegexMatcher returns the matcher matching the phrase by the keywords as the regular expressions.
+// the score of the matched histories is always zero.
+func newRegexMatcher(keywords []string, and bool) (historyMatcher, error) {
+	patterns := make([]*regexp.Regexp, 0, len(keywords))
+	for _, keyword := range keywords {
+		pattern, err := regexp.Compile(keyword)
+		if err != nil {
+			return nil, errors.New("invalid regular expression")
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	return func(h *historyDomain.History) (bool, float64) {
+		for _, pattern := range patterns {
+			matched := pattern.MatchString(h.Phrase)
+			if matched && !and {
+				return true, 0
+			}
+			if !matched && and {
+				return false, 0
+			}
+		}
+		return and && len(patterns) > 0, 0
+	}, nil
+}
+
+// newFuzzyMatcher returns the matcher matching the phrase, the reading or the romaji similar to the keywords.
+// the score of a keyword is the best similarity of them, and the score of the history is
+// the lowest score of the keywords by AND condition or the highest score by OR condition.
+func newFuzzyMatcher(keywords []string, and bool) historyMatcher {
+	return func(h *historyDomain.History) (bool, float64) {
+		var score float64
+		for i, keyword := range keywords {
+			s := max(
+				similarity(keyword, h.Phrase),
+				similarity(keyword, h.Reading.String),
+				similarity(keyword, h.Romaji.String),
+			)
+			if i == 0 || (and && s < score) || (!and && s > score) {
+				score = s
+			}
+		}
+		return len(keywords) > 0 && score >= fuzzyThreshold, score
+	}
+}
+
+// similarity returns the similarity score between 0 and 1 of the keyword to the most similar part of the text.
+// it is 1 minus the edit distance to the part divided by the length of the keyword, and it ignores the case.
+func similarity(keyword string, text string) float64 {
+	k := []rune(strings.ToLower(keyword))
+	if len(k) == 0 {
+		return 1
+	}
+	s := []rune(strings.ToLower(text))
+
+	// the edit distances of the prefixes of the keyword to the parts ending at each position of the text,
+	// the part can start anywhere in the text, so the first row is all zero.
+	prev := make([]int, len(s)+1)
+	curr := make([]int, len(s)+1)
+	for i := 1; i <= len(k); i++ {
+		curr[0] = i
+		for j := 1; j <= len(s); j++ {
+			cost := 1
+			if k[i-1] == s[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	distance := len(k)
+	for _, d := range prev {
+		distance = min(distance, d)
+	}
+
+	return 1 - float64(distance)/float64(len(k))
+}
+
+// scoredHistory is a struct that holds the history matched by the matcher and the similarity score of it.
+type scoredHistory struct {
+	history *historyDomain.History
+	score   float64
+}
+
+// matchHistories returns the histories the matcher matches with the scores of them.
+// the histories keep the order if they are not ranked, and are sorted by the score in descending order if they are ranked.
+func matchHistories(histories []*historyDomain.History, matcher historyMatcher, ranked bool) []scoredHistory {
+	var matched []scoredHistory
+	for _, h := range histories {
+		if ok, score := matcher(h); ok {
+			matched = append(matched, scoredHistory{history: h, score: score})
+		}
+	}
+
+	if ranked {
+		rankScoredHistories(matched)
+	}
+
+	return matched
+}
+
+// rankScoredHistories sorts the matched histories by the score in descending order keeping the order of the same scores.
+func rankScoredHistories(matched []scoredHistory) {
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].score > matched[j].score
+	})
+}
+
+// sortScoredHistories sorts the matched histories by the key in the same order as the repository does.
+func sortScoredHistories(matched []scoredHistory, sortBy historyDomain.SortKey, desc bool) {
+	less := func(a, b *historyDomain.History) bool {
+		switch sortBy {
+		case historyDomain.SortByCreatedAt:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case historyDomain.SortByPhrase:
+			if a.Phrase != b.Phrase {
+				return a.Phrase < b.Phrase
+			}
+		}
+		return a.ID < b.ID
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if desc {
+			return less(matched[j].history, matched[i].history)
+		}
+		return less(matched[i].history, matched[j].history)
+	})
+}
@@ -0,0 +1,276 @@
+package jrp
+
+import (
+	"database/sql"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+func Test_newRegexMatcher(t *testing.T) {
+	history := &historyDomain.History{
+		ID:     1,
+		Phrase: "静かな山",
+	}
+
+	type args struct {
+		keywords []string
+		and      bool
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantMatched bool
+		wantErr     bool
+	}{
+		{
+			name:        "positive testing (or, matched)",
+			args:        args{keywords: []string{"^遊", "山$"}, and: false},
+			wantMatched: true,
+			wantErr:     false,
+		},
+		{
+			name:        "positive testing (or, not matched)",
+			args:        args{keywords: []string{"^遊", "猫$"}, and: false},
+			wantMatched: false,
+			wantErr:     false,
+		},
+		{
+			name:        "positive testing (and, matched)",
+			args:        args{keywords: []string{"^静", "山$"}, and: true},
+			wantMatched: true,
+			wantErr:     false,
+		},
+		{
+			name:        "positive testing (and, not matched)",
+			args:        args{keywords: []string{"^静", "猫$"}, and: true},
+			wantMatched: false,
+			wantErr:     false,
+		},
+		{
+			name:        "negative testing (invalid regular expression)",
+			args:        args{keywords: []string{"("}, and: false},
+			wantMatched: false,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := newRegexMatcher(tt.args.keywords, tt.args.and)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newRegexMatcher() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if matched, score := matcher(history); matched != tt.wantMatched || score != 0 {
+				t.Errorf("newRegexMatcher()() = %v, %v, want %v, 0", matched, score, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func Test_newFuzzyMatcher(t *testing.T) {
+	history := &historyDomain.History{
+		ID:      1,
+		Phrase:  "静かな山",
+		Reading: sql.NullString{String: "しずかなやま", Valid: true},
+		Romaji:  sql.NullString{String: "shizukanayama", Valid: true},
+	}
+
+	type args struct {
+		keywords []string
+		and      bool
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantMatched bool
+		wantScore   float64
+	}{
+		{
+			name:        "positive testing (phrase)",
+			args:        args{keywords: []string{"静かな山の"}, and: false},
+			wantMatched: true,
+			wantScore:   0.8,
+		},
+		{
+			name:        "positive testing (romaji, ignoring the case)",
+			args:        args{keywords: []string{"SHIZUKA"}, and: false},
+			wantMatched: true,
+			wantScore:   1,
+		},
+		{
+			name:        "positive testing (or takes the highest score)",
+			args:        args{keywords: []string{"猫", "しずかなやま"}, and: false},
+			wantMatched: true,
+			wantScore:   1,
+		},
+		{
+			name:        "positive testing (and takes the lowest score)",
+			args:        args{keywords: []string{"猫", "しずかなやま"}, and: true},
+			wantMatched: false,
+			wantScore:   0,
+		},
+		{
+			name:        "positive testing (no keywords)",
+			args:        args{keywords: nil, and: false},
+			wantMatched: false,
+			wantScore:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, score := newFuzzyMatcher(tt.args.keywords, tt.args.and)(history)
+			if matched != tt.wantMatched || math.Abs(score-tt.wantScore) > 1e-9 {
+				t.Errorf("newFuzzyMatcher()() = %v, %v, want %v, %v", matched, score, tt.wantMatched, tt.wantScore)
+			}
+		})
+	}
+}
+
+func Test_similarity(t *testing.T) {
+	type args struct {
+		keyword string
+		text    string
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "positive testing (same)",
+			args: args{keyword: "静かな山", text: "静かな山"},
+			want: 1,
+		},
+		{
+			name: "positive testing (part of the text)",
+			args: args{keyword: "かな", text: "静かな山"},
+			want: 1,
+		},
+		{
+			name: "positive testing (one character is missing)",
+			args: args{keyword: "shizukanayma", text: "shizukanayama"},
+			want: 1 - 1.0/12,
+		},
+		{
+			name: "positive testing (not similar)",
+			args: args{keyword: "猫", text: "静かな山"},
+			want: 0,
+		},
+		{
+			name: "positive testing (empty text)",
+			args: args{keyword: "猫", text: ""},
+			want: 0,
+		},
+		{
+			name: "positive testing (empty keyword)",
+			args: args{keyword: "", text: "静かな山"},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := similarity(tt.args.keyword, tt.args.text); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("similarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchHistories(t *testing.T) {
+	histories := []*historyDomain.History{
+		{ID: 3, Phrase: "静かな猫"},
+		{ID: 2, Phrase: "遊ぶ犬"},
+		{ID: 1, Phrase: "静かな山"},
+	}
+
+	type args struct {
+		keywords []string
+		ranked   bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "positive testing (not ranked)",
+			args: args{keywords: []string{"静かな山の"}, ranked: false},
+			want: []int{3, 1},
+		},
+		{
+			name: "positive testing (ranked)",
+			args: args{keywords: []string{"静かな山の"}, ranked: true},
+			want: []int{1, 3},
+		},
+		{
+			name: "positive testing (no histories matched)",
+			args: args{keywords: []string{"赤い"}, ranked: true},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, m := range matchHistories(histories, newFuzzyMatcher(tt.args.keywords, false), tt.args.ranked) {
+				got = append(got, m.history.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matchHistories() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_sortScoredHistories(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		sortBy historyDomain.SortKey
+		desc   bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "positive testing (id)",
+			args: args{sortBy: historyDomain.SortById, desc: false},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "positive testing (created, desc)",
+			args: args{sortBy: historyDomain.SortByCreatedAt, desc: true},
+			want: []int{2, 3, 1},
+		},
+		{
+			name: "positive testing (phrase)",
+			args: args{sortBy: historyDomain.SortByPhrase, desc: false},
+			want: []int{3, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := []scoredHistory{
+				{history: &historyDomain.History{ID: 3, Phrase: "a", CreatedAt: base.Add(time.Hour)}},
+				{history: &historyDomain.History{ID: 1, Phrase: "b", CreatedAt: base}},
+				{history: &historyDomain.History{ID: 2, Phrase: "c", CreatedAt: base.Add(2 * time.Hour)}},
+			}
+			sortScoredHistories(matched, tt.args.sortBy, tt.args.desc)
+			var got []int
+			for _, m := range matched {
+				got = append(got, m.history.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortScoredHistories() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package jrp
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// JrpTemplate is a struct that contains the parsed template of the jrp.
+type JrpTemplate struct {
+	tokens []jrpTemplateToken
+}
+
+// jrpTemplateToken is a struct that contains a literal or a slot of the template.
+type jrpTemplateToken struct {
+	// literal is the text to be output as it is.
+	literal string
+	// pos is the part of speech of the slot. it is empty if the token is a literal.
+	pos string
+}
+
+var (
+	// templateSlots is a variable that contains the slots available in the template and the part of speech they are filled with.
+	templateSlots = map[string]string{
+		"a": "a",
+		"v": "v",
+		"n": "n",
+	}
+)
+
+// ParseJrpTemplate parses the template like "{a}{n}の{n}" into the JrpTemplate struct.
+func ParseJrpTemplate(template string) (*JrpTemplate, error) {
+	if template == "" {
+		return nil, errors.New("template is empty")
+	}
+
+	var tokens []jrpTemplateToken
+	var literal strings.Builder
+	hasSlot := false
+	rest := template
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "{"):
+			end := strings.Index(rest, "}")
+			if end < 0 {
+				return nil, errors.New("template has an unclosed slot")
+			}
+			name := rest[1:end]
+			pos, ok := templateSlots[name]
+			if !ok {
+				return nil, errors.New("template has an unknown slot {" + name + "}")
+			}
+			if literal.Len() > 0 {
+				tokens = append(tokens, jrpTemplateToken{literal: literal.String()})
+				literal.Reset()
+			}
+			tokens = append(tokens, jrpTemplateToken{pos: pos})
+			hasSlot = true
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "}"):
+			return nil, errors.New("template has an unopened slot")
+		default:
+			next := strings.IndexAny(rest, "{}")
+			if next < 0 {
+				next = len(rest)
+			}
+			literal.WriteString(rest[:next])
+			rest = rest[next:]
+		}
+	}
+	if literal.Len() > 0 {
+		tokens = append(tokens, jrpTemplateToken{literal: literal.String()})
+	}
+
+	if !hasSlot {
+		return nil, errors.New("template has no slots")
+	}
+
+	return &JrpTemplate{tokens: tokens}, nil
+}
+
+// Pos returns the parts of speech needed to fill the slots of the template.
+func (t *JrpTemplate) Pos() []string {
+	var pos []string
+	for _, token := range t.tokens {
+		if token.pos != "" && !slices.Contains(pos, token.pos) {
+			pos = append(pos, token.pos)
+		}
+	}
+
+	return pos
+}
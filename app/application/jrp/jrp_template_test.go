@@ -0,0 +1,144 @@
+package jrp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseJrpTemplate(t *testing.T) {
+	type args struct {
+		template string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *JrpTemplate
+		wantErr bool
+	}{
+		{
+			name: "positive testing (template is \"{a}{n}\")",
+			args: args{
+				template: "{a}{n}",
+			},
+			want: &JrpTemplate{
+				tokens: []jrpTemplateToken{
+					{pos: "a"},
+					{pos: "n"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (template is \"{a}{n}の{n}\")",
+			args: args{
+				template: "{a}{n}の{n}",
+			},
+			want: &JrpTemplate{
+				tokens: []jrpTemplateToken{
+					{pos: "a"},
+					{pos: "n"},
+					{literal: "の"},
+					{pos: "n"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (template starts and ends with literals)",
+			args: args{
+				template: "あの{v}{n}です",
+			},
+			want: &JrpTemplate{
+				tokens: []jrpTemplateToken{
+					{literal: "あの"},
+					{pos: "v"},
+					{pos: "n"},
+					{literal: "です"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (template is empty)",
+			args: args{
+				template: "",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (template has no slots)",
+			args: args{
+				template: "テスト",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (template has an unknown slot)",
+			args: args{
+				template: "{x}{n}",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (template has an unclosed slot)",
+			args: args{
+				template: "{a}{n",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (template has an unopened slot)",
+			args: args{
+				template: "{a}n}",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJrpTemplate(tt.args.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseJrpTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseJrpTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJrpTemplate_Pos(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     []string
+	}{
+		{
+			name:     "positive testing (template is \"{a}{n}\")",
+			template: "{a}{n}",
+			want:     []string{"a", "n"},
+		},
+		{
+			name:     "positive testing (template is \"{v}{n}の{n}\")",
+			template: "{v}{n}の{n}",
+			want:     []string{"v", "n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jt, err := ParseJrpTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseJrpTemplate() error = %v", err)
+			}
+			if got := jt.Pos(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JrpTemplate.Pos() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package jrp
+
+import (
+	"context"
+	"time"
+)
+
+// migrateUseCase is a struct that contains the use case of migrating the schema of the jrp database.
+type migrateUseCase struct {
+	schemaMigrator SchemaMigrator
+}
+
+// NewMigrateUseCase returns a new instance of the MigrateUseCase struct.
+func NewMigrateUseCase(
+	schemaMigrator SchemaMigrator,
+) *migrateUseCase {
+	return &migrateUseCase{
+		schemaMigrator: schemaMigrator,
+	}
+}
+
+// MigrateUseCaseOutputDto is a DTO struct that contains the output data of the MigrateUseCase.
+type MigrateUseCaseOutputDto struct {
+	// Version is the version of the schema the migration migrates to.
+	Version int
+	// Description is the description of the migration.
+	Description string
+	// AppliedAt is the timestamp when the migration is applied.
+	AppliedAt time.Time
+}
+
+// Run applies the migrations not applied yet and returns them.
+func (uc *migrateUseCase) Run(ctx context.Context) ([]*MigrateUseCaseOutputDto, error) {
+	migrations, err := uc.schemaMigrator.Migrate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ucDtos []*MigrateUseCaseOutputDto
+	for _, migration := range migrations {
+		ucDtos = append(ucDtos, &MigrateUseCaseOutputDto{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   migration.AppliedAt,
+		})
+	}
+
+	return ucDtos, nil
+}
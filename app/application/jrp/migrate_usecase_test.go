@@ -0,0 +1,150 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewMigrateUseCase(t *testing.T) {
+	type args struct {
+		schemaMigrator SchemaMigrator
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *migrateUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *migrateUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				schemaMigrator: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *migrateUseCase {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				tt.schemaMigrator = mockSchemaMigrator
+				return &migrateUseCase{
+					schemaMigrator: mockSchemaMigrator,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMigrateUseCase(tt.args.schemaMigrator); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewMigrateUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_migrateUseCase_Run(t *testing.T) {
+	appliedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		schemaMigrator SchemaMigrator
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*MigrateUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (migrations applied)",
+			fields: fields{
+				schemaMigrator: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*MigrateUseCaseOutputDto{
+				{
+					Version:     1,
+					Description: "create the history table",
+					AppliedAt:   appliedAt,
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				mockSchemaMigrator.EXPECT().Migrate(gomock.Any()).Return([]*SchemaMigrationDto{
+					{
+						Version:     1,
+						Description: "create the history table",
+						IsApplied:   true,
+						AppliedAt:   appliedAt,
+					},
+				}, nil)
+				tt.schemaMigrator = mockSchemaMigrator
+			},
+		},
+		{
+			name: "positive testing (no migrations applied)",
+			fields: fields{
+				schemaMigrator: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				mockSchemaMigrator.EXPECT().Migrate(gomock.Any()).Return(nil, nil)
+				tt.schemaMigrator = mockSchemaMigrator
+			},
+		},
+		{
+			name: "negative testing (SchemaMigrator.Migrate() failed)",
+			fields: fields{
+				schemaMigrator: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockSchemaMigrator := NewMockSchemaMigrator(mockCtrl)
+				mockSchemaMigrator.EXPECT().Migrate(gomock.Any()).Return(nil, errors.New("SchemaMigrator.Migrate() failed"))
+				tt.schemaMigrator = mockSchemaMigrator
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &migrateUseCase{
+				schemaMigrator: tt.fields.schemaMigrator,
+			}
+			got, err := uc.Run(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("migrateUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("migrateUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// purgeHistoryUseCase is a struct that contains the use case of the purging jrp in the trash of the table history in jrp sqlite database.
+type purgeHistoryUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewPurgeHistoryUseCase returns a new instance of the PurgeHistoryUseCase struct.
+func NewPurgeHistoryUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *purgeHistoryUseCase {
+	return &purgeHistoryUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the PurgeHistoryUseCase.
+func (uc *purgeHistoryUseCase) Run(ctx context.Context, ids []int, all bool) error {
+	var rowsAffected int
+	var err error
+	if all {
+		rowsAffected, err = uc.historyRepo.PurgeAll(ctx)
+	} else {
+		rowsAffected, err = uc.historyRepo.PurgeByIdIn(ctx, ids)
+	}
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no histories to purge")
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package jrp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// romajiDigraphs is a variable that contains the romaji of the kana followed by the small ya, yu, yo and so on.
+	romajiDigraphs = map[string]string{
+		"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+		"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+		"しゃ": "sha", "しゅ": "shu", "しぇ": "she", "しょ": "sho",
+		"じゃ": "ja", "じゅ": "ju", "じぇ": "je", "じょ": "jo",
+		"ちゃ": "cha", "ちゅ": "chu", "ちぇ": "che", "ちょ": "cho",
+		"ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+		"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+		"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+		"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+		"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+		"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+		"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+		"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo",
+		"てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du",
+		"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+		"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo",
+	}
+	// romajiMonographs is a variable that contains the romaji of the single kana.
+	romajiMonographs = map[rune]string{
+		'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+		'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+		'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+		'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+		'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+		'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+		'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+		'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+		'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+		'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+		'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+		'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+		'や': "ya", 'ゆ': "yu", 'よ': "yo",
+		'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+		'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n",
+		'ゔ': "vu",
+		'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+		'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+		'ー': "-",
+	}
+)
+
+// toHiragana converts the katakana in the given string into the hiragana.
+func toHiragana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ァ' && r <= 'ヶ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, s)
+}
+
+// toRomaji converts the kana in the given string into the romaji in the Hepburn style.
+// the characters other than the kana are output as they are.
+func toRomaji(s string) string {
+	runes := []rune(toHiragana(s))
+
+	var romaji strings.Builder
+	var last string
+	geminate := false
+	syllabicN := false
+	for i := 0; i < len(runes); i++ {
+		var syllable string
+		isKana := false
+		if i+1 < len(runes) {
+			if digraph, ok := romajiDigraphs[string(runes[i:i+2])]; ok {
+				syllable = digraph
+				isKana = true
+				i++
+			}
+		}
+		if syllable == "" {
+			if runes[i] == 'っ' {
+				geminate = true
+				continue
+			}
+			syllable, isKana = romajiMonographs[runes[i]]
+			if !isKana {
+				syllable = string(runes[i])
+			}
+		}
+
+		switch {
+		case syllable == "-":
+			// the long vowel mark repeats the previous vowel.
+			syllable = ""
+			if r, _ := utf8.DecodeLastRuneInString(last); strings.ContainsRune("aiueo", r) {
+				syllable = string(r)
+			}
+		case geminate && !isKana:
+			// the small tsu can not double the characters other than the kana, so it is output as it is.
+			romaji.WriteString("っ")
+		case geminate && strings.HasPrefix(syllable, "ch"):
+			romaji.WriteString("t")
+		case geminate && !strings.ContainsRune("aiueon", rune(syllable[0])):
+			romaji.WriteByte(syllable[0])
+		case syllabicN && isKana && strings.ContainsRune("aiueoy", rune(syllable[0])):
+			// the syllabic n followed by a vowel or y is separated by an apostrophe.
+			romaji.WriteString("'")
+		}
+		geminate = false
+		syllabicN = runes[i] == 'ん'
+
+		romaji.WriteString(syllable)
+		last = syllable
+	}
+
+	return romaji.String()
+}
+
+// readingOf returns the reading of the given word in the hiragana.
+// the lemma is used if the word does not have the pronunciation.
+func readingOf(dto *GenerateJrpUseCaseInputDto) string {
+	if dto.Pron == "" {
+		return toHiragana(dto.Lemma)
+	}
+
+	return toHiragana(dto.Pron)
+}
@@ -0,0 +1,126 @@
+package jrp
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_toHiragana(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (katakana)",
+			args: args{s: "ウツクシイ"},
+			want: "うつくしい",
+		},
+		{
+			name: "positive testing (mixed)",
+			args: args{s: "静かなネコ"},
+			want: "静かなねこ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toHiragana(tt.args.s); got != tt.want {
+				t.Errorf("toHiragana() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_toRomaji(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (hiragana)",
+			args: args{s: "うつくしいねこ"},
+			want: "utsukushiineko",
+		},
+		{
+			name: "positive testing (katakana with the long vowel mark)",
+			args: args{s: "ラーメン"},
+			want: "raamen",
+		},
+		{
+			name: "positive testing (digraph)",
+			args: args{s: "きょうしゅう"},
+			want: "kyoushuu",
+		},
+		{
+			name: "positive testing (geminate)",
+			args: args{s: "がっこうのまっちゃ"},
+			want: "gakkounomatcha",
+		},
+		{
+			name: "positive testing (syllabic n followed by a vowel)",
+			args: args{s: "きんえんのこんや"},
+			want: "kin'ennokon'ya",
+		},
+		{
+			name: "positive testing (not kana)",
+			args: args{s: "testなn"},
+			want: "testnan",
+		},
+		{
+			name: "positive testing (geminate followed by the kanji of the lemma without the pronunciation)",
+			args: args{s: readingOf(&GenerateJrpUseCaseInputDto{Lemma: "取っ手", Pron: ""})},
+			want: "取っ手",
+		},
+		{
+			name: "positive testing (syllabic n followed by the kanji)",
+			args: args{s: "ん絵"},
+			want: "n絵",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toRomaji(tt.args.s); got != tt.want {
+				t.Errorf("toRomaji() = %v, want %v", got, tt.want)
+			}
+			if got := toRomaji(tt.args.s); !utf8.ValidString(got) {
+				t.Errorf("toRomaji() = %q, want the valid UTF-8", got)
+			}
+		})
+	}
+}
+
+func Test_readingOf(t *testing.T) {
+	type args struct {
+		dto *GenerateJrpUseCaseInputDto
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (pron is not empty)",
+			args: args{dto: &GenerateJrpUseCaseInputDto{Lemma: "猫", Pron: "ネコ"}},
+			want: "ねこ",
+		},
+		{
+			name: "positive testing (pron is empty)",
+			args: args{dto: &GenerateJrpUseCaseInputDto{Lemma: "ネコ", Pron: ""}},
+			want: "ねこ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readingOf(tt.args.dto); got != tt.want {
+				t.Errorf("readingOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// removeNoteUseCase is a struct that contains the use case of the removing the note of jrp from the table history_note in jrp sqlite database.
+type removeNoteUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewRemoveNoteUseCase returns a new instance of the RemoveNoteUseCase struct.
+func NewRemoveNoteUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *removeNoteUseCase {
+	return &removeNoteUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the RemoveNote usecase.
+func (uc *removeNoteUseCase) Run(ctx context.Context, id int) error {
+	rowsAffected, err := uc.historyRepo.DeleteNoteByIdIs(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no notes to remove")
+	}
+
+	return nil
+}
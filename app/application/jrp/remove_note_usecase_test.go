@@ -0,0 +1,132 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewRemoveNoteUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *removeNoteUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *removeNoteUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *removeNoteUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &removeNoteUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRemoveNoteUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRemoveNoteUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_removeNoteUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().DeleteNoteByIdIs(gomock.Any(), 1).Return(1, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (err != nil)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().DeleteNoteByIdIs(gomock.Any(), 1).Return(0, errors.New("HistoryRepository.DeleteNoteByIdIs() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (rowsAffected == 0)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().DeleteNoteByIdIs(gomock.Any(), 1).Return(0, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &removeNoteUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			if err := uc.Run(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
+				t.Errorf("removeNoteUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// restoreFavoriteUseCase is a struct that contains the use case of the restoring the favorites of jrp unfavorited all at once in the table history in jrp sqlite database.
+type restoreFavoriteUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewRestoreFavoriteUseCase returns a new instance of the RestoreFavoriteUseCase struct.
+func NewRestoreFavoriteUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *restoreFavoriteUseCase {
+	return &restoreFavoriteUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the RestoreFavoriteUseCase.
+func (uc *restoreFavoriteUseCase) Run(ctx context.Context) error {
+	rowsAffected, err := uc.historyRepo.RestoreFavorites(ctx)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no favorites to restore")
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewRestoreFavoriteUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *restoreFavoriteUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *restoreFavoriteUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *restoreFavoriteUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &restoreFavoriteUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewRestoreFavoriteUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRestoreFavoriteUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_restoreFavoriteUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreFavorites(gomock.Any()).Return(2, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (err != nil)",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreFavorites(gomock.Any()).Return(0, errors.New("HistoryRepository.RestoreFavorites() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (rows affected == 0)",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreFavorites(gomock.Any()).Return(0, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &restoreFavoriteUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			if err := uc.Run(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("restoreFavoriteUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// restoreHistoryUseCase is a struct that contains the use case of the restoring jrp from the trash of the table history in jrp sqlite database.
+type restoreHistoryUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewRestoreHistoryUseCase returns a new instance of the RestoreHistoryUseCase struct.
+func NewRestoreHistoryUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *restoreHistoryUseCase {
+	return &restoreHistoryUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the RestoreHistoryUseCase.
+func (uc *restoreHistoryUseCase) Run(ctx context.Context, ids []int, all bool) error {
+	var rowsAffected int
+	var err error
+	if all {
+		rowsAffected, err = uc.historyRepo.RestoreAll(ctx)
+	} else {
+		rowsAffected, err = uc.historyRepo.RestoreByIdIn(ctx, ids)
+	}
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no histories to restore")
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewRestoreHistoryUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *restoreHistoryUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *restoreHistoryUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *restoreHistoryUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &restoreHistoryUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRestoreHistoryUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRestoreHistoryUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_restoreHistoryUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx context.Context
+		ids []int
+		all bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (all)",
+			args: args{
+				ctx: context.Background(),
+				ids: nil,
+				all: true,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreAll(gomock.Any()).Return(1, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (not all)",
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1, 2},
+				all: false,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreByIdIn(gomock.Any(), []int{1, 2}).Return(2, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (err != nil)",
+			args: args{
+				ctx: context.Background(),
+				ids: nil,
+				all: true,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreAll(gomock.Any()).Return(0, errors.New("HistoryRepository.RestoreAll() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (rows affected == 0)",
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+				all: false,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().RestoreByIdIn(gomock.Any(), []int{1}).Return(0, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &restoreHistoryUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			if err := uc.Run(tt.args.ctx, tt.args.ids, tt.args.all); (err != nil) != tt.wantErr {
+				t.Errorf("restoreHistoryUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
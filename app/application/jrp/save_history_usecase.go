@@ -25,6 +25,12 @@ func NewSaveHistoryUseCase(
 type SaveHistoryUseCaseInputDto struct {
 	// Phrase is the generated phrase.
 	Phrase string
+	// Reading is the reading of the generated phrase in the hiragana.
+	Reading string
+	// Romaji is the reading of the generated phrase in the romaji.
+	Romaji string
+	// WordIDs is the IDs of the words in the WordNet Japan the phrase is generated from.
+	WordIDs []int
 	// Prefix is the prefix when the phrase is generated.
 	Prefix string
 	// Suffix is the suffix when the phrase is generated.
@@ -43,6 +49,12 @@ type SaveHistoryUseCaseOutputDto struct {
 	ID int
 	// Phrase is the generated phrase.
 	Phrase string
+	// Reading is the reading of the generated phrase in the hiragana.
+	Reading string
+	// Romaji is the reading of the generated phrase in the romaji.
+	Romaji string
+	// WordIDs is the IDs of the words in the WordNet Japan the phrase is generated from.
+	WordIDs []int
 	// Prefix is the prefix when the phrase is generated.
 	Prefix string
 	// Suffix is the suffix when the phrase is generated.
@@ -61,6 +73,9 @@ func (uc *saveHistoryUseCase) Run(ctx context.Context, inputDtos []*SaveHistoryU
 	for _, dto := range inputDtos {
 		history := historyDomain.NewHistory(
 			dto.Phrase,
+			dto.Reading,
+			dto.Romaji,
+			dto.WordIDs,
 			dto.Prefix,
 			dto.Suffix,
 			dto.IsFavorited,
@@ -80,6 +95,9 @@ func (uc *saveHistoryUseCase) Run(ctx context.Context, inputDtos []*SaveHistoryU
 		outputDto := &SaveHistoryUseCaseOutputDto{
 			ID:          history.ID,
 			Phrase:      history.Phrase,
+			Reading:     history.Reading.String,
+			Romaji:      history.Romaji.String,
+			WordIDs:     history.GetWordIDs(),
 			Prefix:      history.Prefix.String,
 			Suffix:      history.Suffix.String,
 			IsFavorited: history.IsFavorited,
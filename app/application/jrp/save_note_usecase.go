@@ -0,0 +1,42 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// saveNoteUseCase is a struct that contains the use case of the saving the note of jrp to the table history_note in jrp sqlite database.
+type saveNoteUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewSaveNoteUseCase returns a new instance of the SaveNoteUseCase struct.
+func NewSaveNoteUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *saveNoteUseCase {
+	return &saveNoteUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the SaveNote usecase.
+// the existing note of the history is replaced.
+func (uc *saveNoteUseCase) Run(ctx context.Context, id int, note string) error {
+	if strings.TrimSpace(note) == "" {
+		return errors.New("note is empty")
+	}
+
+	rowsAffected, err := uc.historyRepo.SaveNote(ctx, historyDomain.NewNote(id, note, time.Now()))
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no histories to note")
+	}
+
+	return nil
+}
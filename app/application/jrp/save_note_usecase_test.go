@@ -0,0 +1,154 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewSaveNoteUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *saveNoteUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *saveNoteUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *saveNoteUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &saveNoteUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSaveNoteUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSaveNoteUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_saveNoteUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx  context.Context
+		id   int
+		note string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   1,
+				note: "note",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveNote(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, note *historyDomain.Note) (int, error) {
+					if note.HistoryID != 1 || note.Note != "note" || note.UpdatedAt.IsZero() {
+						t.Errorf("HistoryRepository.SaveNote() note = %v", note)
+					}
+					return 1, nil
+				})
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (note is empty)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   1,
+				note: " ",
+			},
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name: "negative testing (err != nil)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   1,
+				note: "note",
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveNote(gomock.Any(), gomock.Any()).Return(0, errors.New("HistoryRepository.SaveNote() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (rowsAffected == 0)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   1,
+				note: "note",
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveNote(gomock.Any(), gomock.Any()).Return(0, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &saveNoteUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			if err := uc.Run(tt.args.ctx, tt.args.id, tt.args.note); (err != nil) != tt.wantErr {
+				t.Errorf("saveNoteUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
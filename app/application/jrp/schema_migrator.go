@@ -0,0 +1,24 @@
+package jrp
+
+import (
+	"context"
+	"time"
+)
+
+// SchemaMigrationDto is a DTO struct that contains the migration of the schema of the jrp database.
+type SchemaMigrationDto struct {
+	// Version is the version of the schema the migration migrates to.
+	Version int
+	// Description is the description of the migration.
+	Description string
+	// IsApplied is the flag to indicate whether the migration is applied.
+	IsApplied bool
+	// AppliedAt is the timestamp when the migration is applied.
+	AppliedAt time.Time
+}
+
+// SchemaMigrator is an interface that provides the methods to migrate the schema of the jrp database.
+type SchemaMigrator interface {
+	FindAll(ctx context.Context) ([]*SchemaMigrationDto, error)
+	Migrate(ctx context.Context) ([]*SchemaMigrationDto, error)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./app/application/jrp/schema_migrator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./app/application/jrp/schema_migrator.go -destination=./app/application/jrp/schema_migrator_mock.go -package=jrp
+//
+
+// Package jrp is a generated GoMock package.
+package jrp
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSchemaMigrator is a mock of SchemaMigrator interface.
+type MockSchemaMigrator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaMigratorMockRecorder
+	isgomock struct{}
+}
+
+// MockSchemaMigratorMockRecorder is the mock recorder for MockSchemaMigrator.
+type MockSchemaMigratorMockRecorder struct {
+	mock *MockSchemaMigrator
+}
+
+// NewMockSchemaMigrator creates a new mock instance.
+func NewMockSchemaMigrator(ctrl *gomock.Controller) *MockSchemaMigrator {
+	mock := &MockSchemaMigrator{ctrl: ctrl}
+	mock.recorder = &MockSchemaMigratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaMigrator) EXPECT() *MockSchemaMigratorMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockSchemaMigrator) FindAll(ctx context.Context) ([]*SchemaMigrationDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*SchemaMigrationDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSchemaMigratorMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSchemaMigrator)(nil).FindAll), ctx)
+}
+
+// Migrate mocks base method.
+func (m *MockSchemaMigrator) Migrate(ctx context.Context) ([]*SchemaMigrationDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx)
+	ret0, _ := ret[0].([]*SchemaMigrationDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockSchemaMigratorMockRecorder) Migrate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockSchemaMigrator)(nil).Migrate), ctx)
+}
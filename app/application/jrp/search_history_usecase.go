@@ -2,11 +2,17 @@ package jrp
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
 )
 
+var (
+	// matchBatchSize is the number of the histories read at once in the regex and the fuzzy search for injecting dependencies in testing.
+	matchBatchSize = 1000
+)
+
 // searchHistoryUseCase is a struct that contains the use case of the searching jrp from the table history in jrp sqlite database.
 type searchHistoryUseCase struct {
 	historyRepo historyDomain.HistoryRepository
@@ -27,6 +33,12 @@ type SearchHistoryUseCaseOutputDto struct {
 	ID int
 	// Phrase is the generated phrase.
 	Phrase string
+	// Reading is the reading of the generated phrase in the hiragana.
+	Reading string
+	// Romaji is the reading of the generated phrase in the romaji.
+	Romaji string
+	// WordIDs is the IDs of the words in the WordNet Japan the phrase is generated from.
+	WordIDs []int
 	// Prefix is the prefix when the phrase is generated.
 	Prefix string
 	// Suffix is the suffix when the phrase is generated.
@@ -37,37 +49,168 @@ type SearchHistoryUseCaseOutputDto struct {
 	CreatedAt time.Time
 	// UpdatedAt is the timestamp when the phrase is updated.
 	UpdatedAt time.Time
+	// Score is the similarity score between 0 and 1 of the phrase to the keywords in the fuzzy search. It is zero in the other searches.
+	Score float64
+}
+
+// SearchHistoryUseCaseInputDto is a DTO struct that contains the input data of the SearchHistoryUseCase.
+type SearchHistoryUseCaseInputDto struct {
+	// Keywords is the keywords the phrase contains.
+	Keywords []string
+	// And is the flag to search the histories containing all the keywords.
+	And bool
+	// Regex is the flag to search the histories by the keywords as the regular expressions.
+	Regex bool
+	// Fuzzy is the flag to search the histories similar to the keywords and rank them by the similarity score.
+	Fuzzy bool
+	// All is the flag to search all the histories.
+	All bool
+	// Favorited is the flag to search only the favorited histories.
+	Favorited bool
+	// Number is the number of the most recent histories to search. It is ignored if All is true.
+	Number int
+	// Offset is the number of the most recent histories to skip. It is ignored if All is true.
+	Offset int
+	// Cursor is the ID the histories to search are older than. No cursor is used if it is zero or less.
+	Cursor int
+	// Tag is the tag the histories are tagged with. All the histories are searched if it is empty.
+	Tag string
+	// Since is the inclusive lower bound of the timestamp when the phrase is created. It is unbounded if it is zero.
+	Since time.Time
+	// Until is the exclusive upper bound of the timestamp when the phrase is created. It is unbounded if it is zero.
+	Until time.Time
+	// Sort is the key to sort the histories by. "id", "created" and "phrase" are available. The histories are sorted by the ID if it is empty.
+	Sort string
+	// Desc is the flag to sort the histories in descending order.
+	Desc bool
 }
 
 // Run returns the output of the SearchHistoryUseCase.
-func (uc *searchHistoryUseCase) Run(ctx context.Context, keywords []string, and bool, all bool, favorited bool, number int) ([]*SearchHistoryUseCaseOutputDto, error) {
-	var histories []*historyDomain.History
-	var err error
-	if all && favorited {
-		histories, err = uc.historyRepo.FindByIsFavoritedIsAndPhraseContains(ctx, keywords, and, 1)
-	} else if all && !favorited {
-		histories, err = uc.historyRepo.FindByPhraseContains(ctx, keywords, and)
-	} else if !all && favorited {
-		histories, err = uc.historyRepo.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(ctx, keywords, and, number, 1)
-	} else {
-		histories, err = uc.historyRepo.FindTopNByPhraseContainsOrderByIdAsc(ctx, keywords, and, number)
+func (uc *searchHistoryUseCase) Run(ctx context.Context, shiDto *SearchHistoryUseCaseInputDto) ([]*SearchHistoryUseCaseOutputDto, error) {
+	if !shiDto.All && shiDto.Number <= 0 {
+		return nil, nil
+	}
+	if shiDto.Regex || shiDto.Fuzzy {
+		return uc.runMatch(ctx, shiDto)
 	}
+	spec, err := newHistorySpec(shiDto.Keywords, shiDto.And, shiDto.All, shiDto.Favorited, shiDto.Number, shiDto.Offset, shiDto.Cursor, shiDto.Tag, shiDto.Since, shiDto.Until, shiDto.Sort, shiDto.Desc)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := uc.historyRepo.FindBySpec(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	var ucDtos []*SearchHistoryUseCaseOutputDto
 	for _, h := range histories {
-		ucDtos = append(ucDtos, &SearchHistoryUseCaseOutputDto{
-			ID:          h.ID,
-			Phrase:      h.Phrase,
-			Prefix:      h.Prefix.String,
-			Suffix:      h.Suffix.String,
-			IsFavorited: h.IsFavorited,
-			CreatedAt:   h.CreatedAt,
-			UpdatedAt:   h.UpdatedAt,
-		})
+		ucDtos = append(ucDtos, newSearchHistoryUseCaseOutputDto(h, 0))
+	}
+
+	return ucDtos, nil
+}
+
+// RunCount returns the number of the histories matching the input of the SearchHistoryUseCase.
+// the number, the offset and the cursor of the input are ignored.
+func (uc *searchHistoryUseCase) RunCount(ctx context.Context, shiDto *SearchHistoryUseCaseInputDto) (int, error) {
+	if shiDto.Regex || shiDto.Fuzzy {
+		dto := *shiDto
+		dto.Cursor = 0
+		matched, err := uc.match(ctx, &dto, 0)
+		if err != nil {
+			return 0, err
+		}
+		return len(matched), nil
+	}
+	spec, err := newHistorySpec(shiDto.Keywords, shiDto.And, true, shiDto.Favorited, 0, 0, 0, shiDto.Tag, shiDto.Since, shiDto.Until, shiDto.Sort, shiDto.Desc)
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.historyRepo.CountBySpec(ctx, spec)
+}
+
+// runMatch returns the output of the SearchHistoryUseCase searching by the regular expressions or the similarity.
+// the regular expression search takes the most recent histories and sorts them as the repository does,
+// and the fuzzy search takes the histories of the highest scores ranked by the score.
+func (uc *searchHistoryUseCase) runMatch(ctx context.Context, shiDto *SearchHistoryUseCaseInputDto) ([]*SearchHistoryUseCaseOutputDto, error) {
+	limit := 0
+	if !shiDto.All && !shiDto.Fuzzy {
+		limit = shiDto.Offset + shiDto.Number
+	}
+	matched, err := uc.match(ctx, shiDto, limit)
+	if err != nil {
+		return nil, err
+	}
+	if !shiDto.All {
+		matched = matched[min(shiDto.Offset, len(matched)):min(shiDto.Offset+shiDto.Number, len(matched))]
+	}
+	if !shiDto.Fuzzy {
+		sortBy, _ := historyDomain.ParseSortKey(shiDto.Sort)
+		sortScoredHistories(matched, sortBy, shiDto.Desc)
+	}
+
+	var ucDtos []*SearchHistoryUseCaseOutputDto
+	for _, m := range matched {
+		ucDtos = append(ucDtos, newSearchHistoryUseCaseOutputDto(m.history, m.score))
 	}
 
 	return ucDtos, nil
 }
+
+// match returns the histories matching the keywords by the regular expressions or the similarity.
+// the histories are read by the batches from the most recent one, and the reading stops when the limit of the matched histories is reached if it is more than zero.
+// the matched histories are ordered from the most recent one, or ranked by the score in the fuzzy search.
+func (uc *searchHistoryUseCase) match(ctx context.Context, shiDto *SearchHistoryUseCaseInputDto, limit int) ([]scoredHistory, error) {
+	if shiDto.Regex && shiDto.Fuzzy {
+		return nil, errors.New("regex and fuzzy are both specified")
+	}
+	spec, err := newHistorySpec(nil, false, true, shiDto.Favorited, 0, shiDto.Offset, shiDto.Cursor, shiDto.Tag, shiDto.Since, shiDto.Until, shiDto.Sort, shiDto.Desc)
+	if err != nil {
+		return nil, err
+	}
+	var matcher historyMatcher
+	if shiDto.Fuzzy {
+		matcher = newFuzzyMatcher(shiDto.Keywords, shiDto.And)
+	} else if matcher, err = newRegexMatcher(shiDto.Keywords, shiDto.And); err != nil {
+		return nil, err
+	}
+
+	spec.Number = matchBatchSize
+	spec.SortBy = historyDomain.SortById
+	spec.Desc = true
+	var matched []scoredHistory
+	for {
+		histories, err := uc.historyRepo.FindBySpec(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		matched = append(matched, matchHistories(histories, matcher, false)...)
+		if len(histories) < spec.Number || (limit > 0 && len(matched) >= limit) {
+			break
+		}
+		spec.Cursor = histories[len(histories)-1].ID
+	}
+	if shiDto.Fuzzy {
+		rankScoredHistories(matched)
+	}
+
+	return matched, nil
+}
+
+// newSearchHistoryUseCaseOutputDto returns the output of the SearchHistoryUseCase from the history and the score of it.
+func newSearchHistoryUseCaseOutputDto(h *historyDomain.History, score float64) *SearchHistoryUseCaseOutputDto {
+	return &SearchHistoryUseCaseOutputDto{
+		ID:          h.ID,
+		Phrase:      h.Phrase,
+		Reading:     h.Reading.String,
+		Romaji:      h.Romaji.String,
+		WordIDs:     h.GetWordIDs(),
+		Prefix:      h.Prefix.String,
+		Suffix:      h.Suffix.String,
+		IsFavorited: h.IsFavorited,
+		CreatedAt:   h.CreatedAt,
+		UpdatedAt:   h.UpdatedAt,
+		Score:       score,
+	}
+}
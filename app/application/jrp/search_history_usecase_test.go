@@ -2,10 +2,11 @@ package jrp
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
 
@@ -52,16 +53,15 @@ func TestNewSearchHistoryUseCase(t *testing.T) {
 }
 
 func Test_searchHistoryUseCase_Run(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
 	type fields struct {
 		historyRepo historyDomain.HistoryRepository
 	}
 	type args struct {
-		ctx       context.Context
-		keywords  []string
-		and       bool
-		all       bool
-		favorited bool
-		number    int
+		ctx    context.Context
+		shiDto *SearchHistoryUseCaseInputDto
 	}
 	tests := []struct {
 		name    string
@@ -77,217 +77,444 @@ func Test_searchHistoryUseCase_Run(t *testing.T) {
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				keywords:  []string{"test"},
-				and:       true,
-				all:       true,
-				favorited: true,
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords:  []string{"test"},
+					And:       true,
+					All:       true,
+					Favorited: true,
+					Number:    10,
+				},
 			},
 			want: []*SearchHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     1,
+					Phrase: "test",
 				},
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     2,
+					Phrase: "test2",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindByIsFavoritedIsAndPhraseContains(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec([]string{"test"}, true, true, "", time.Time{}, time.Time{}, 0, 0, 0, historyDomain.SortById, false)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     1,
+						Phrase: "test",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     2,
+						Phrase: "test2",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (all and not favorited)",
+			name: "positive testing (not all, number 1)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				keywords:  []string{"test"},
-				and:       true,
-				all:       true,
-				favorited: false,
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   1,
+				},
+			},
+			want: []*SearchHistoryUseCaseOutputDto{
+				{
+					ID:     2,
+					Phrase: "test2",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec([]string{"test"}, true, false, "", time.Time{}, time.Time{}, 1, 0, 0, historyDomain.SortById, false)).Return([]*historyDomain.History{
+					{
+						ID:     2,
+						Phrase: "test2",
+					},
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (tag, since, until, sort by phrase desc)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   10,
+					Tag:      "tag",
+					Since:    since,
+					Until:    until,
+					Sort:     "phrase",
+					Desc:     true,
+				},
 			},
 			want: []*SearchHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     2,
+					Phrase: "test2",
 				},
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     1,
+					Phrase: "test",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindByPhraseContains(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec([]string{"test"}, true, false, "tag", since, until, 10, 0, 0, historyDomain.SortByPhrase, true)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     2,
+						Phrase: "test2",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     1,
+						Phrase: "test",
+					},
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (number 0)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   0,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid sort key)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   10,
+					Sort:     "test",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (since is not before until)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   10,
+					Since:    until,
+					Until:    since,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (FindBySpec(ctx, spec) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   10,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), gomock.Any()).Return(nil, errors.New("HistoryRepository.FindBySpec() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (offset and cursor)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   1,
+					Offset:   1,
+					Cursor:   3,
+				},
+			},
+			want: []*SearchHistoryUseCaseOutputDto{
+				{
+					ID:     1,
+					Phrase: "test",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec([]string{"test"}, true, false, "", time.Time{}, time.Time{}, 1, 1, 3, historyDomain.SortById, false)).Return([]*historyDomain.History{
+					{
+						ID:     1,
+						Phrase: "test",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (not all and favorited)",
+			name: "negative testing (offset is negative)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				keywords:  []string{"test"},
-				and:       true,
-				all:       false,
-				favorited: true,
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Number:   1,
+					Offset:   -1,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (regex)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"^遊", "山$"},
+					Regex:    true,
+					Number:   2,
+					Sort:     "phrase",
+					Desc:     true,
+				},
 			},
 			want: []*SearchHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     1,
+					Phrase: "静かな山",
+				},
+				{
+					ID:     2,
+					Phrase: "遊ぶ犬",
 				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, matchBatchSize, 0, 0, historyDomain.SortById, true)).Return([]*historyDomain.History{
+					{
+						ID:     3,
+						Phrase: "静かな猫",
+					},
+					{
+						ID:     2,
+						Phrase: "遊ぶ犬",
+					},
+					{
+						ID:     1,
+						Phrase: "静かな山",
+					},
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (regex, number 1, sort by phrase desc takes the most recent history)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"^遊", "山$"},
+					Regex:    true,
+					Number:   1,
+					Sort:     "phrase",
+					Desc:     true,
+				},
+			},
+			want: []*SearchHistoryUseCaseOutputDto{
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 1,
+					ID:     2,
+					Phrase: "遊ぶ犬",
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, matchBatchSize, 0, 0, historyDomain.SortById, true)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     3,
+						Phrase: "静かな猫",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 1,
+						ID:     2,
+						Phrase: "遊ぶ犬",
+					},
+					{
+						ID:     1,
+						Phrase: "静かな山",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "positive testing (not all and not favorited)",
+			name: "positive testing (fuzzy)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				keywords:  []string{"test"},
-				and:       true,
-				all:       false,
-				favorited: false,
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"静かな山の"},
+					Fuzzy:    true,
+					Number:   10,
+				},
 			},
 			want: []*SearchHistoryUseCaseOutputDto{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     1,
+					Phrase: "静かな山",
+					Score:  0.8,
 				},
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      "",
-					Suffix:      "",
-					IsFavorited: 0,
+					ID:     3,
+					Phrase: "静かな猫",
+					Score:  0.6,
 				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindTopNByPhraseContainsOrderByIdAsc(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*historyDomain.History{
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, matchBatchSize, 0, 0, historyDomain.SortById, true)).Return([]*historyDomain.History{
 					{
-						ID:          1,
-						Phrase:      "test",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     3,
+						Phrase: "静かな猫",
 					},
 					{
-						ID:          2,
-						Phrase:      "test2",
-						Prefix:      sql.NullString{String: "", Valid: false},
-						Suffix:      sql.NullString{String: "", Valid: false},
-						IsFavorited: 0,
+						ID:     2,
+						Phrase: "遊ぶ犬",
+					},
+					{
+						ID:     1,
+						Phrase: "静かな山",
 					},
 				}, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
 		{
-			name: "negative testing (err != nil)",
+			name: "negative testing (regex and fuzzy are both specified)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					Regex:    true,
+					Fuzzy:    true,
+					Number:   10,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid regular expression)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"("},
+					Regex:    true,
+					Number:   10,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (FindBySpec(ctx, spec) failed in the regex search)",
 			fields: fields{
 				historyRepo: nil,
 			},
 			args: args{
-				ctx:       context.Background(),
-				keywords:  []string{"test"},
-				and:       true,
-				all:       true,
-				favorited: true,
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					Regex:    true,
+					Number:   10,
+				},
 			},
 			want:    nil,
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().FindByIsFavoritedIsAndPhraseContains(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, matchBatchSize, 0, 0, historyDomain.SortById, true)).Return(nil, errors.New("HistoryRepository.FindBySpec() failed"))
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
@@ -302,30 +529,340 @@ func Test_searchHistoryUseCase_Run(t *testing.T) {
 			uc := &searchHistoryUseCase{
 				historyRepo: tt.fields.historyRepo,
 			}
-			got, err := uc.Run(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.all, tt.args.favorited, tt.args.number)
+			got, err := uc.Run(tt.args.ctx, tt.args.shiDto)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("searchHistoryUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != nil && tt.want != nil {
-				for i := range got {
-					if got[i].ID != tt.want[i].ID {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].ID, tt.want[i].ID)
-					}
-					if got[i].Phrase != tt.want[i].Phrase {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Phrase, tt.want[i].Phrase)
-					}
-					if got[i].Prefix != tt.want[i].Prefix {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Prefix, tt.want[i].Prefix)
-					}
-					if got[i].Suffix != tt.want[i].Suffix {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].Suffix, tt.want[i].Suffix)
-					}
-					if got[i].IsFavorited != tt.want[i].IsFavorited {
-						t.Errorf("getHistoryUseCase.Run() = %v, want %v", got[i].IsFavorited, tt.want[i].IsFavorited)
-					}
+			if len(got) != len(tt.want) {
+				t.Errorf("searchHistoryUseCase.Run() : len(got) = %v, want %v", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("searchHistoryUseCase.Run() = %v, want %v", got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("searchHistoryUseCase.Run() = %v, want %v", got[i].Phrase, tt.want[i].Phrase)
+				}
+				if math.Abs(got[i].Score-tt.want[i].Score) > 1e-9 {
+					t.Errorf("searchHistoryUseCase.Run() = %v, want %v", got[i].Score, tt.want[i].Score)
 				}
 			}
 		})
 	}
 }
+
+func Test_searchHistoryUseCase_match(t *testing.T) {
+	origMatchBatchSize := matchBatchSize
+	histories := []*historyDomain.History{
+		{ID: 5, Phrase: "静かな猫"},
+		{ID: 4, Phrase: "遊ぶ犬"},
+		{ID: 3, Phrase: "静かな山"},
+		{ID: 2, Phrase: "遊ぶ鳥"},
+		{ID: 1, Phrase: "静かな川"},
+	}
+	batchSpec := func(cursor int) *historyDomain.HistorySpec {
+		return historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 2, 0, cursor, historyDomain.SortById, true)
+	}
+
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx    context.Context
+		shiDto *SearchHistoryUseCaseInputDto
+		limit  int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantIDs []int
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (regex, reading stops at the limit)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"^遊"},
+					Regex:    true,
+				},
+				limit: 1,
+			},
+			wantIDs: []int{4},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(0)).Return(histories[0:2], nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (regex, no limit)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"^遊"},
+					Regex:    true,
+				},
+				limit: 0,
+			},
+			wantIDs: []int{4, 2},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				gomock.InOrder(
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(0)).Return(histories[0:2], nil),
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(4)).Return(histories[2:4], nil),
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(2)).Return(histories[4:], nil),
+				)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (fuzzy, ranked over the batches)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"静かな山"},
+					Fuzzy:    true,
+				},
+				limit: 0,
+			},
+			wantIDs: []int{3, 5, 1},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				gomock.InOrder(
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(0)).Return(histories[0:2], nil),
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(4)).Return(histories[2:4], nil),
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(2)).Return(histories[4:], nil),
+				)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (FindBySpec(ctx, spec) failed in the second batch)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"^遊"},
+					Regex:    true,
+				},
+				limit: 0,
+			},
+			wantIDs: nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				gomock.InOrder(
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(0)).Return(histories[0:2], nil),
+					mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), batchSpec(4)).Return(nil, errors.New("HistoryRepository.FindBySpec() failed")),
+				)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			matchBatchSize = 2
+			defer func() {
+				matchBatchSize = origMatchBatchSize
+			}()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &searchHistoryUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.match(tt.args.ctx, tt.args.shiDto, tt.args.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("searchHistoryUseCase.match() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var gotIDs []int
+			for _, m := range got {
+				gotIDs = append(gotIDs, m.history.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("searchHistoryUseCase.match() = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func Test_searchHistoryUseCase_RunCount(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx    context.Context
+		shiDto *SearchHistoryUseCaseInputDto
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    int
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords:  []string{"test"},
+					And:       true,
+					Favorited: true,
+					Number:    10,
+					Offset:    10,
+					Cursor:    3,
+					Tag:       "tag",
+				},
+			},
+			want:    2,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().CountBySpec(gomock.Any(), historyDomain.NewHistorySpec([]string{"test"}, true, true, "tag", time.Time{}, time.Time{}, 0, 0, 0, historyDomain.SortById, false)).Return(2, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid sort key)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+					Sort:     "test",
+				},
+			},
+			want:    0,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (CountBySpec(ctx, spec) failed)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"test"},
+					And:      true,
+				},
+			},
+			want:    0,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().CountBySpec(gomock.Any(), gomock.Any()).Return(0, errors.New("HistoryRepository.CountBySpec() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "positive testing (fuzzy)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"静か山"},
+					Fuzzy:    true,
+					Number:   1,
+					Cursor:   3,
+				},
+			},
+			want:    2,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().FindBySpec(gomock.Any(), historyDomain.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, matchBatchSize, 0, 0, historyDomain.SortById, true)).Return([]*historyDomain.History{
+					{
+						ID:     3,
+						Phrase: "静かな猫",
+					},
+					{
+						ID:     2,
+						Phrase: "遊ぶ犬",
+					},
+					{
+						ID:     1,
+						Phrase: "静かな山",
+					},
+				}, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (invalid regular expression)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				shiDto: &SearchHistoryUseCaseInputDto{
+					Keywords: []string{"("},
+					Regex:    true,
+					Number:   10,
+				},
+			},
+			want:    0,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &searchHistoryUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			got, err := uc.RunCount(tt.args.ctx, tt.args.shiDto)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("searchHistoryUseCase.RunCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("searchHistoryUseCase.RunCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// tagUseCase is a struct that contains the use case of the tagging jrp in the table history in jrp sqlite database.
+type tagUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewTagUseCase returns a new instance of the TagUseCase struct.
+func NewTagUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *tagUseCase {
+	return &tagUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the Tag usecase.
+func (uc *tagUseCase) Run(ctx context.Context, name string, ids []int) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("tag is empty")
+	}
+
+	rowsAffected, err := uc.historyRepo.SaveTagByIdIn(ctx, name, ids)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no histories to tag")
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewTagUseCase(t *testing.T) {
+	type args struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *tagUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *tagUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *tagUseCase {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				tt.historyRepo = mockHistoryRepo
+				return &tagUseCase{
+					historyRepo: mockHistoryRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		if tt.setup != nil {
+			tt.want = tt.setup(mockCtrl, &tt.args)
+		}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewTagUseCase(tt.args.historyRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTagUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tagUseCase_Run(t *testing.T) {
+	type fields struct {
+		historyRepo historyDomain.HistoryRepository
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+		ids  []int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: " tag ",
+				ids:  []int{1, 2},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveTagByIdIn(gomock.Any(), "tag", []int{1, 2}).Return(2, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (name is empty)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: " ",
+				ids:  []int{1, 2},
+			},
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name: "negative testing (err != nil)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1, 2},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveTagByIdIn(gomock.Any(), "tag", []int{1, 2}).Return(0, errors.New("HistoryRepository.SaveTagByIdIn() failed"))
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+		{
+			name: "negative testing (rowsAffected == 0)",
+			fields: fields{
+				historyRepo: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1, 2},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
+				mockHistoryRepo.EXPECT().SaveTagByIdIn(gomock.Any(), "tag", []int{1, 2}).Return(0, nil)
+				tt.historyRepo = mockHistoryRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &tagUseCase{
+				historyRepo: tt.fields.historyRepo,
+			}
+			if err := uc.Run(tt.args.ctx, tt.args.name, tt.args.ids); (err != nil) != tt.wantErr {
+				t.Errorf("tagUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
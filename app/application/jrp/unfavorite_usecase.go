@@ -26,7 +26,7 @@ func (uc *unfavoriteUseCase) Run(ctx context.Context, ids []int, all bool) error
 	var rowsAffected int
 	var err error
 	if all {
-		rowsAffected, err = uc.historyRepo.UpdateIsFavoritedByIsFavoritedIs(ctx, 0, 1)
+		rowsAffected, err = uc.historyRepo.UnfavoriteAll(ctx)
 	} else {
 		rowsAffected, err = uc.historyRepo.UpdateIsFavoritedByIdIn(ctx, 0, ids)
 	}
@@ -79,7 +79,7 @@ func Test_unfavoriteUseCase_Run(t *testing.T) {
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().UpdateIsFavoritedByIsFavoritedIs(gomock.Any(), 0, 1).Return(1, nil)
+				mockHistoryRepo.EXPECT().UnfavoriteAll(gomock.Any()).Return(1, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
@@ -113,7 +113,7 @@ func Test_unfavoriteUseCase_Run(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().UpdateIsFavoritedByIsFavoritedIs(gomock.Any(), 0, 1).Return(0, errors.New("error"))
+				mockHistoryRepo.EXPECT().UnfavoriteAll(gomock.Any()).Return(0, errors.New("error"))
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
@@ -130,7 +130,7 @@ func Test_unfavoriteUseCase_Run(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockHistoryRepo := historyDomain.NewMockHistoryRepository(mockCtrl)
-				mockHistoryRepo.EXPECT().UpdateIsFavoritedByIsFavoritedIs(gomock.Any(), 0, 1).Return(0, nil)
+				mockHistoryRepo.EXPECT().UnfavoriteAll(gomock.Any()).Return(0, nil)
 				tt.historyRepo = mockHistoryRepo
 			},
 		},
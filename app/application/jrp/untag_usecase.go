@@ -0,0 +1,41 @@
+package jrp
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+)
+
+// untagUseCase is a struct that contains the use case of the untagging jrp in the table history in jrp sqlite database.
+type untagUseCase struct {
+	historyRepo historyDomain.HistoryRepository
+}
+
+// NewUntagUseCase returns a new instance of the UntagUseCase struct.
+func NewUntagUseCase(
+	historyRepo historyDomain.HistoryRepository,
+) *untagUseCase {
+	return &untagUseCase{
+		historyRepo: historyRepo,
+	}
+}
+
+// Run returns the output of the Untag usecase.
+func (uc *untagUseCase) Run(ctx context.Context, name string, ids []int) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("tag is empty")
+	}
+
+	rowsAffected, err := uc.historyRepo.DeleteTagByNameIsAndIdIn(ctx, name, ids)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no histories to untag")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package jrp
+
+import (
+	"sync"
+)
+
+// WordPool is a struct that contains the words to generate jrps from and the words grouped by the part of speech.
+type WordPool struct {
+	// Words are all the words of the pool.
+	Words []*GenerateJrpUseCaseInputDto
+	// byPos are the words of the pool grouped by the part of speech.
+	byPos map[string][]*GenerateJrpUseCaseInputDto
+}
+
+// NewWordPool returns a new instance of the WordPool grouping the words by the part of speech.
+func NewWordPool(words []*GenerateJrpUseCaseInputDto) *WordPool {
+	byPos := make(map[string][]*GenerateJrpUseCaseInputDto)
+	for _, word := range words {
+		byPos[word.Pos] = append(byPos[word.Pos], word)
+	}
+
+	return &WordPool{
+		Words: words,
+		byPos: byPos,
+	}
+}
+
+// Pos returns the words of the given part of speech in the pool.
+func (p *WordPool) Pos(pos string) []*GenerateJrpUseCaseInputDto {
+	return p.byPos[pos]
+}
+
+var (
+	// gwpVersion is the version of the words the global word pool is built for.
+	gwpVersion string
+	// gwp is the global word pool.
+	gwp *WordPool
+	// gwpMutex is a global mutex for the word pool.
+	gwpMutex = &sync.Mutex{}
+)
+
+// InitializeWordPool makes GetWordPool keep the word pool built for the version of the words.
+func InitializeWordPool(version string) {
+	gwpMutex.Lock()
+	defer gwpMutex.Unlock()
+
+	gwpVersion = version
+	gwp = nil
+}
+
+// ResetWordPool resets the global word pool.
+func ResetWordPool() {
+	gwpMutex.Lock()
+	defer gwpMutex.Unlock()
+
+	gwpVersion = ""
+	gwp = nil
+}
+
+// GetWordPool returns the word pool built by the fetch function.
+// the pool is kept only once for the version initialized by InitializeWordPool, so the words are not fetched and copied for every generation.
+// the pool is not kept if the version is not initialized.
+func GetWordPool(fetch func() ([]*GenerateJrpUseCaseInputDto, error)) (*WordPool, error) {
+	gwpMutex.Lock()
+	defer gwpMutex.Unlock()
+
+	if gwp != nil {
+		return gwp, nil
+	}
+
+	words, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	pool := NewWordPool(words)
+	if gwpVersion != "" {
+		gwp = pool
+	}
+
+	return pool, nil
+}
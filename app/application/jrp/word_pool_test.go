@@ -0,0 +1,216 @@
+package jrp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var (
+	testPoolWords = []*GenerateJrpUseCaseInputDto{
+		{
+			WordID: 1,
+			Lang:   "jpn",
+			Lemma:  "美しい",
+			Pron:   "ウツクシイ",
+			Pos:    "a",
+		},
+		{
+			WordID: 2,
+			Lang:   "jpn",
+			Lemma:  "走る",
+			Pron:   "ハシル",
+			Pos:    "v",
+		},
+		{
+			WordID: 3,
+			Lang:   "jpn",
+			Lemma:  "犬",
+			Pron:   "イヌ",
+			Pos:    "n",
+		},
+		{
+			WordID: 4,
+			Lang:   "jpn",
+			Lemma:  "猫",
+			Pron:   "ネコ",
+			Pos:    "n",
+		},
+	}
+)
+
+func TestNewWordPool(t *testing.T) {
+	type args struct {
+		words []*GenerateJrpUseCaseInputDto
+	}
+	tests := []struct {
+		name string
+		args args
+		want *WordPool
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				words: testPoolWords,
+			},
+			want: &WordPool{
+				Words: testPoolWords,
+				byPos: map[string][]*GenerateJrpUseCaseInputDto{
+					"a": {testPoolWords[0]},
+					"v": {testPoolWords[1]},
+					"n": {testPoolWords[2], testPoolWords[3]},
+				},
+			},
+		},
+		{
+			name: "positive testing (no words)",
+			args: args{
+				words: nil,
+			},
+			want: &WordPool{
+				Words: nil,
+				byPos: map[string][]*GenerateJrpUseCaseInputDto{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewWordPool(tt.args.words); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewWordPool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordPool_Pos(t *testing.T) {
+	type args struct {
+		pos string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*GenerateJrpUseCaseInputDto
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				pos: "n",
+			},
+			want: []*GenerateJrpUseCaseInputDto{testPoolWords[2], testPoolWords[3]},
+		},
+		{
+			name: "positive testing (no words of the part of speech)",
+			args: args{
+				pos: "x",
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewWordPool(testPoolWords).Pos(tt.args.pos); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WordPool.Pos() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetWordPool(t *testing.T) {
+	tests := []struct {
+		name       string
+		versions   []string
+		fetchErr   error
+		wantFetch  int
+		wantShared bool
+		wantErr    bool
+	}{
+		{
+			name:       "positive testing (the pool is built only once for the same version)",
+			versions:   []string{"1-1", "1-1", "1-1"},
+			fetchErr:   nil,
+			wantFetch:  1,
+			wantShared: true,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (the pool is built again for the new version)",
+			versions:   []string{"1-1", "2-2"},
+			fetchErr:   nil,
+			wantFetch:  2,
+			wantShared: false,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (the pool is not kept without the version)",
+			versions:   []string{"", ""},
+			fetchErr:   nil,
+			wantFetch:  2,
+			wantShared: false,
+			wantErr:    false,
+		},
+		{
+			name:       "negative testing (fetch() failed)",
+			versions:   []string{"1-1", "1-1"},
+			fetchErr:   errors.New("fetch() failed"),
+			wantFetch:  2,
+			wantShared: false,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ResetWordPool()
+			defer ResetWordPool()
+			fetched := 0
+			fetch := func() ([]*GenerateJrpUseCaseInputDto, error) {
+				fetched++
+				if tt.fetchErr != nil {
+					return nil, tt.fetchErr
+				}
+				return testPoolWords, nil
+			}
+			var pools []*WordPool
+			for i, version := range tt.versions {
+				if i == 0 || version != tt.versions[i-1] {
+					InitializeWordPool(version)
+				}
+				pool, err := GetWordPool(fetch)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("GetWordPool() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err == nil && !reflect.DeepEqual(pool.Words, testPoolWords) {
+					t.Errorf("GetWordPool() = %v, want %v", pool.Words, testPoolWords)
+				}
+				pools = append(pools, pool)
+			}
+			if fetched != tt.wantFetch {
+				t.Errorf("GetWordPool() fetched %v times, want %v", fetched, tt.wantFetch)
+			}
+			if shared := pools[0] != nil && pools[0] == pools[len(pools)-1]; shared != tt.wantShared {
+				t.Errorf("GetWordPool() shared the pool = %v, want %v", shared, tt.wantShared)
+			}
+		})
+	}
+}
+
+func BenchmarkGetWordPool(b *testing.B) {
+	words := make([]*GenerateJrpUseCaseInputDto, 0, 100000)
+	for i := 0; i < cap(words); i++ {
+		words = append(words, testPoolWords[i%len(testPoolWords)])
+	}
+	fetch := func() ([]*GenerateJrpUseCaseInputDto, error) {
+		return words, nil
+	}
+	ResetWordPool()
+	defer ResetWordPool()
+	InitializeWordPool("1-1")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// the pool is kept for the version, so nothing is allocated for each request.
+		if _, err := GetWordPool(fetch); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
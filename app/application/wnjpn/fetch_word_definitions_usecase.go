@@ -0,0 +1,109 @@
+package wnjpn
+
+import (
+	"context"
+	"strings"
+)
+
+// FetchWordDefinitionsUseCase is an interface that defines the use case of fetching the definitions of words.
+type FetchWordDefinitionsUseCase interface {
+	Run(ctx context.Context, wordIDs []int) ([]*FetchWordDefinitionsUseCaseOutputDto, error)
+}
+
+// FetchWordDefinitionsUseCaseStruct is a struct that implements the FetchWordDefinitionsUseCase interface.
+type FetchWordDefinitionsUseCaseStruct struct {
+	wordQueryService WordQueryService
+}
+
+var (
+	// NewFetchWordDefinitionsUseCase is a function that returns a new instance of the fetchWordDefinitionsUseCase struct.
+	NewFetchWordDefinitionsUseCase = newFetchWordDefinitionsUseCase
+)
+
+// newFetchWordDefinitionsUseCase returns a new instance of the fetchWordDefinitionsUseCase struct.
+func newFetchWordDefinitionsUseCase(
+	wordQueryService WordQueryService,
+) *FetchWordDefinitionsUseCaseStruct {
+	return &FetchWordDefinitionsUseCaseStruct{
+		wordQueryService: wordQueryService,
+	}
+}
+
+// FetchWordDefinitionsUseCaseOutputDto is a DTO struct that contains the output data of the FetchWordDefinitionsUseCase.
+type FetchWordDefinitionsUseCaseOutputDto struct {
+	WordID     int
+	Lemma      string
+	Pron       string
+	Pos        string
+	Synset     string
+	Name       string
+	Definition string
+	Gloss      string
+}
+
+// Run returns the output of the FetchWordDefinitionsUseCase.
+// the output is ordered by the given word IDs and has a row per the synset of each word.
+func (uc *FetchWordDefinitionsUseCaseStruct) Run(ctx context.Context, wordIDs []int) ([]*FetchWordDefinitionsUseCaseOutputDto, error) {
+	if len(wordIDs) == 0 {
+		return nil, nil
+	}
+
+	qsDtos, err := uc.wordQueryService.FindDefinitionsByWordIdIn(ctx, wordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		wordID int
+		synset string
+	}
+	senses := make(map[int][]*FetchWordDefinitionsUseCaseOutputDto)
+	found := make(map[key]*FetchWordDefinitionsUseCaseOutputDto)
+	for _, qsDto := range qsDtos {
+		k := key{wordID: qsDto.WordID, synset: qsDto.Synset.String}
+		ucDto, ok := found[k]
+		if !ok {
+			ucDto = &FetchWordDefinitionsUseCaseOutputDto{
+				WordID: qsDto.WordID,
+				Lemma:  qsDto.Lemma.String,
+				Pron:   qsDto.Pron.String,
+				Pos:    qsDto.Pos.String,
+				Synset: qsDto.Synset.String,
+				Name:   qsDto.Name.String,
+			}
+			found[k] = ucDto
+			senses[qsDto.WordID] = append(senses[qsDto.WordID], ucDto)
+		}
+		ucDto.Definition = appendDefinition(ucDto.Definition, qsDto.Definition.String)
+		ucDto.Gloss = appendDefinition(ucDto.Gloss, qsDto.Gloss.String)
+	}
+
+	var ucDtos []*FetchWordDefinitionsUseCaseOutputDto
+	seen := make(map[int]bool)
+	for _, wordID := range wordIDs {
+		if seen[wordID] {
+			continue
+		}
+		seen[wordID] = true
+		ucDtos = append(ucDtos, senses[wordID]...)
+	}
+
+	return ucDtos, nil
+}
+
+// appendDefinition appends the definition to the definitions separated by semicolons if it is not contained yet.
+func appendDefinition(definitions string, definition string) string {
+	if definition == "" {
+		return definitions
+	}
+	if definitions == "" {
+		return definition
+	}
+	for _, d := range strings.Split(definitions, "; ") {
+		if d == definition {
+			return definitions
+		}
+	}
+
+	return definitions + "; " + definition
+}
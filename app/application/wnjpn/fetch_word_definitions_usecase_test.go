@@ -0,0 +1,187 @@
+package wnjpn
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+func Test_newFetchWordDefinitionsUseCase(t *testing.T) {
+	type args struct {
+		wordQueryService WordQueryService
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *FetchWordDefinitionsUseCaseStruct
+		setup func(mockCtrl *gomock.Controller, tt *args) *FetchWordDefinitionsUseCaseStruct
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				wordQueryService: nil,
+			},
+			want: &FetchWordDefinitionsUseCaseStruct{
+				wordQueryService: nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) *FetchWordDefinitionsUseCaseStruct {
+				mockWordQueryService := NewMockWordQueryService(mockCtrl)
+				tt.wordQueryService = mockWordQueryService
+				return newFetchWordDefinitionsUseCase(mockWordQueryService)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := newFetchWordDefinitionsUseCase(tt.args.wordQueryService); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFetchWordDefinitionsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchWordDefinitionsUseCaseStruct_Run(t *testing.T) {
+	type fields struct {
+		wordQueryService WordQueryService
+	}
+	type args struct {
+		ctx     context.Context
+		wordIDs []int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*FetchWordDefinitionsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				wordQueryService: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				wordIDs: []int{2, 1},
+			},
+			want: []*FetchWordDefinitionsUseCaseOutputDto{
+				{
+					WordID:     2,
+					Lemma:      "犬",
+					Pron:       "イヌ",
+					Pos:        "n",
+					Synset:     "02084071-n",
+					Name:       "dog",
+					Definition: "イヌ属の動物; 飼い犬",
+					Gloss:      "a member of the genus Canis",
+				},
+				{
+					WordID:     1,
+					Lemma:      "静か",
+					Pron:       "シズカ",
+					Pos:        "a",
+					Synset:     "",
+					Name:       "",
+					Definition: "",
+					Gloss:      "",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockWordQueryService := NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().FindDefinitionsByWordIdIn(gomock.Any(), []int{2, 1}).Return([]*FetchWordDefinitionsDto{
+					{
+						WordID: 1,
+						Lang:   sql.NullString{String: "jpn", Valid: true},
+						Lemma:  sql.NullString{String: "静か", Valid: true},
+						Pron:   sql.NullString{String: "シズカ", Valid: true},
+						Pos:    sql.NullString{String: "a", Valid: true},
+					},
+					{
+						WordID:     2,
+						Lang:       sql.NullString{String: "jpn", Valid: true},
+						Lemma:      sql.NullString{String: "犬", Valid: true},
+						Pron:       sql.NullString{String: "イヌ", Valid: true},
+						Pos:        sql.NullString{String: "n", Valid: true},
+						Synset:     sql.NullString{String: "02084071-n", Valid: true},
+						Name:       sql.NullString{String: "dog", Valid: true},
+						Definition: sql.NullString{String: "イヌ属の動物", Valid: true},
+						Gloss:      sql.NullString{String: "a member of the genus Canis", Valid: true},
+					},
+					{
+						WordID:     2,
+						Lang:       sql.NullString{String: "jpn", Valid: true},
+						Lemma:      sql.NullString{String: "犬", Valid: true},
+						Pron:       sql.NullString{String: "イヌ", Valid: true},
+						Pos:        sql.NullString{String: "n", Valid: true},
+						Synset:     sql.NullString{String: "02084071-n", Valid: true},
+						Name:       sql.NullString{String: "dog", Valid: true},
+						Definition: sql.NullString{String: "飼い犬", Valid: true},
+						Gloss:      sql.NullString{String: "a member of the genus Canis", Valid: true},
+					},
+				}, nil)
+				tt.wordQueryService = mockWordQueryService
+			},
+		},
+		{
+			name: "positive testing (no word IDs)",
+			fields: fields{
+				wordQueryService: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				wordIDs: nil,
+			},
+			want:    nil,
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name: "negative testing (uc.wordQueryService.FindDefinitionsByWordIdIn(ctx, wordIDs) failed)",
+			fields: fields{
+				wordQueryService: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				wordIDs: []int{1},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockWordQueryService := NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().FindDefinitionsByWordIdIn(gomock.Any(), []int{1}).Return(nil, errors.New("WordQueryService.FindDefinitionsByWordIdIn() failed"))
+				tt.wordQueryService = mockWordQueryService
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &FetchWordDefinitionsUseCaseStruct{
+				wordQueryService: tt.fields.wordQueryService,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.wordIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fetchWordDefinitionsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fetchWordDefinitionsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,7 +14,21 @@ type FetchWordsDto struct {
 	Pos    sql.NullString
 }
 
+// FetchWordDefinitionsDto is a DTO struct that contains the input data of the FetchWordDefinitionsUseCase.
+type FetchWordDefinitionsDto struct {
+	WordID     int
+	Lang       sql.NullString
+	Lemma      sql.NullString
+	Pron       sql.NullString
+	Pos        sql.NullString
+	Synset     sql.NullString
+	Name       sql.NullString
+	Definition sql.NullString
+	Gloss      sql.NullString
+}
+
 // WordQueryService is an interface that provides the methods to query the words.
 type WordQueryService interface {
 	FindByLangIsAndPosIn(ctx context.Context, lang string, pos []string) ([]*FetchWordsDto, error)
+	FindDefinitionsByWordIdIn(ctx context.Context, wordIDs []int) ([]*FetchWordDefinitionsDto, error)
 }
@@ -54,3 +54,18 @@ func (mr *MockWordQueryServiceMockRecorder) FindByLangIsAndPosIn(ctx, lang, pos
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLangIsAndPosIn", reflect.TypeOf((*MockWordQueryService)(nil).FindByLangIsAndPosIn), ctx, lang, pos)
 }
+
+// FindDefinitionsByWordIdIn mocks base method.
+func (m *MockWordQueryService) FindDefinitionsByWordIdIn(ctx context.Context, wordIDs []int) ([]*FetchWordDefinitionsDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefinitionsByWordIdIn", ctx, wordIDs)
+	ret0, _ := ret[0].([]*FetchWordDefinitionsDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefinitionsByWordIdIn indicates an expected call of FindDefinitionsByWordIdIn.
+func (mr *MockWordQueryServiceMockRecorder) FindDefinitionsByWordIdIn(ctx, wordIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefinitionsByWordIdIn", reflect.TypeOf((*MockWordQueryService)(nil).FindDefinitionsByWordIdIn), ctx, wordIDs)
+}
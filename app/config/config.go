@@ -1,6 +1,8 @@
 package config
 
 import (
+	"path/filepath"
+
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
@@ -16,6 +18,7 @@ type Configurator interface {
 type BaseConfigurator struct {
 	Envconfig proxy.Envconfig
 	FileUtil  utility.FileUtil
+	Yaml      proxy.Yaml
 }
 
 // JrpConfig is a struct that contains the configuration of the Jrp application.
@@ -24,6 +27,12 @@ type JrpConfig struct {
 	WNJpnDBDsn  string
 }
 
+// DBFileConfig is a struct that contains the configuration of a database in the config file.
+type DBFileConfig struct {
+	Type database.DBType `yaml:"type,omitempty"`
+	Dsn  string          `yaml:"dsn,omitempty"`
+}
+
 // GetConfig gets the configuration of the Jrp application.
 func NewConfigurator(
 	envconfigProxy proxy.Envconfig,
@@ -32,5 +41,54 @@ func NewConfigurator(
 	return &BaseConfigurator{
 		Envconfig: envconfigProxy,
 		FileUtil:  fileUtil,
+		Yaml:      proxy.NewYaml(),
+	}
+}
+
+// GetConfigFilePath returns the path of the config file in the XDG config home directory.
+func (c *BaseConfigurator) GetConfigFilePath() (string, error) {
+	xdgConfigHome, err := c.FileUtil.GetXDGConfigHome()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(xdgConfigHome, "jrp", "config.yaml"), nil
+}
+
+// ReadConfigFile reads the config file into v.
+// it leaves v as it is if the config file does not exist.
+func (c *BaseConfigurator) ReadConfigFile(v interface{}) error {
+	configFilePath, err := c.GetConfigFilePath()
+	if err != nil {
+		return err
+	}
+	if !c.FileUtil.IsExist(configFilePath) {
+		return nil
 	}
+
+	data, err := c.FileUtil.ReadFile(configFilePath)
+	if err != nil {
+		return err
+	}
+
+	return c.Yaml.Unmarshal(data, v)
+}
+
+// WriteConfigFile writes v to the config file.
+// it creates the directory of the config file if it does not exist.
+func (c *BaseConfigurator) WriteConfigFile(v interface{}) error {
+	configFilePath, err := c.GetConfigFilePath()
+	if err != nil {
+		return err
+	}
+	if err := c.FileUtil.MkdirIfNotExist(filepath.Dir(configFilePath)); err != nil {
+		return err
+	}
+
+	data, err := c.Yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return c.FileUtil.WriteFile(configFilePath, data)
 }
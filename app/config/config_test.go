@@ -1,11 +1,13 @@
 package config
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
 	"github.com/yanosea/jrp/v2/pkg/utility"
+	"go.uber.org/mock/gomock"
 )
 
 func TestNewConfigurator(t *testing.T) {
@@ -34,6 +36,7 @@ func TestNewConfigurator(t *testing.T) {
 			want: &BaseConfigurator{
 				Envconfig: envconfig,
 				FileUtil:  fileUtil,
+				Yaml:      proxy.NewYaml(),
 			},
 		},
 	}
@@ -45,3 +48,330 @@ func TestNewConfigurator(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseConfigurator_GetConfigFilePath(t *testing.T) {
+	type fields struct {
+		Envconfig proxy.Envconfig
+		FileUtil  utility.FileUtil
+		Yaml      proxy.Yaml
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    "~/.config/jrp/config.yaml",
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.FileUtil.GetXDGConfigHome() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("", errors.New("FileUtil.GetXDGConfigHome() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			c := &BaseConfigurator{
+				Envconfig: tt.fields.Envconfig,
+				FileUtil:  tt.fields.FileUtil,
+				Yaml:      tt.fields.Yaml,
+			}
+			got, err := c.GetConfigFilePath()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BaseConfigurator.GetConfigFilePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BaseConfigurator.GetConfigFilePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseConfigurator_ReadConfigFile(t *testing.T) {
+	type config struct {
+		Name string `yaml:"name"`
+	}
+	type fields struct {
+		Envconfig proxy.Envconfig
+		FileUtil  utility.FileUtil
+		Yaml      proxy.Yaml
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    config
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (the config file exists)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    config{Name: "jrp"},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte("name: jrp\n"), nil)
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the config file does not exist)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    config{},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.GetConfigFilePath() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    config{},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("", errors.New("FileUtil.GetXDGConfigHome() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.FileUtil.ReadFile() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			want:    config{},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return(nil, errors.New("FileUtil.ReadFile() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.Yaml.Unmarshal() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      nil,
+			},
+			want:    config{},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte("name: jrp\n"), nil)
+				mockYaml := proxy.NewMockYaml(mockCtrl)
+				mockYaml.EXPECT().Unmarshal([]byte("name: jrp\n"), gomock.Any()).Return(errors.New("Yaml.Unmarshal() failed"))
+				tt.FileUtil = mockFileUtil
+				tt.Yaml = mockYaml
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			c := &BaseConfigurator{
+				Envconfig: tt.fields.Envconfig,
+				FileUtil:  tt.fields.FileUtil,
+				Yaml:      tt.fields.Yaml,
+			}
+			var got config
+			if err := c.ReadConfigFile(&got); (err != nil) != tt.wantErr {
+				t.Errorf("BaseConfigurator.ReadConfigFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BaseConfigurator.ReadConfigFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseConfigurator_WriteConfigFile(t *testing.T) {
+	type config struct {
+		Name string `yaml:"name"`
+	}
+	type fields struct {
+		Envconfig proxy.Envconfig
+		FileUtil  utility.FileUtil
+		Yaml      proxy.Yaml
+	}
+	type args struct {
+		v interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			args: args{
+				v: &config{Name: "jrp"},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockFileUtil.EXPECT().WriteFile("~/.config/jrp/config.yaml", []byte("name: jrp\n")).Return(nil)
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.GetConfigFilePath() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			args: args{
+				v: &config{Name: "jrp"},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("", errors.New("FileUtil.GetXDGConfigHome() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.FileUtil.MkdirIfNotExist() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			args: args{
+				v: &config{Name: "jrp"},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(errors.New("FileUtil.MkdirIfNotExist() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.Yaml.Marshal() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      nil,
+			},
+			args: args{
+				v: &config{Name: "jrp"},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockYaml := proxy.NewMockYaml(mockCtrl)
+				mockYaml.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("Yaml.Marshal() failed"))
+				tt.FileUtil = mockFileUtil
+				tt.Yaml = mockYaml
+			},
+		},
+		{
+			name: "negative testing (c.FileUtil.WriteFile() failed)",
+			fields: fields{
+				Envconfig: nil,
+				FileUtil:  nil,
+				Yaml:      proxy.NewYaml(),
+			},
+			args: args{
+				v: &config{Name: "jrp"},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockFileUtil.EXPECT().WriteFile("~/.config/jrp/config.yaml", []byte("name: jrp\n")).Return(errors.New("FileUtil.WriteFile() failed"))
+				tt.FileUtil = mockFileUtil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			c := &BaseConfigurator{
+				Envconfig: tt.fields.Envconfig,
+				FileUtil:  tt.fields.FileUtil,
+				Yaml:      tt.fields.Yaml,
+			}
+			if err := c.WriteConfigFile(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("BaseConfigurator.WriteConfigFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
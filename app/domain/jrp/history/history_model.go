@@ -2,6 +2,8 @@ package history
 
 import (
 	"database/sql"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -11,6 +13,12 @@ type History struct {
 	ID int
 	// Phrase is the generated phrase.
 	Phrase string
+	// Reading is the reading of the generated phrase in the hiragana.
+	Reading sql.NullString
+	// Romaji is the reading of the generated phrase in the romaji.
+	Romaji sql.NullString
+	// WordIDs is the comma separated IDs of the words in the WordNet Japan the phrase is generated from.
+	WordIDs sql.NullString
 	// Prefix is the prefix when the phrase is generated.
 	Prefix sql.NullString
 	// Suffix is the suffix when the phrase is generated.
@@ -26,6 +34,9 @@ type History struct {
 // NewHistory returns a new instance of the History struct.
 func NewHistory(
 	phrase string,
+	reading string,
+	romaji string,
+	wordIDs []int,
 	prefix string,
 	suffix string,
 	isFavorited int,
@@ -34,6 +45,9 @@ func NewHistory(
 ) *History {
 	return &History{
 		Phrase:      phrase,
+		Reading:     sql.NullString{String: reading, Valid: reading != ""},
+		Romaji:      sql.NullString{String: romaji, Valid: romaji != ""},
+		WordIDs:     joinWordIDs(wordIDs),
 		Prefix:      sql.NullString{String: prefix, Valid: prefix != ""},
 		Suffix:      sql.NullString{String: suffix, Valid: suffix != ""},
 		IsFavorited: isFavorited,
@@ -41,3 +55,35 @@ func NewHistory(
 		UpdatedAt:   updatedAt,
 	}
 }
+
+// GetWordIDs returns the IDs of the words in the WordNet Japan the phrase is generated from.
+func (h *History) GetWordIDs() []int {
+	if !h.WordIDs.Valid || h.WordIDs.String == "" {
+		return nil
+	}
+
+	var wordIDs []int
+	for _, s := range strings.Split(h.WordIDs.String, ",") {
+		wordID, err := strconv.Atoi(s)
+		if err != nil {
+			continue
+		}
+		wordIDs = append(wordIDs, wordID)
+	}
+
+	return wordIDs
+}
+
+// joinWordIDs joins the IDs of the words with commas to store them in the history table.
+func joinWordIDs(wordIDs []int) sql.NullString {
+	if len(wordIDs) == 0 {
+		return sql.NullString{String: "", Valid: false}
+	}
+
+	s := make([]string, 0, len(wordIDs))
+	for _, wordID := range wordIDs {
+		s = append(s, strconv.Itoa(wordID))
+	}
+
+	return sql.NullString{String: strings.Join(s, ","), Valid: true}
+}
@@ -11,6 +11,9 @@ func TestNewHistory(t *testing.T) {
 	now := time.Now()
 	type args struct {
 		phrase      string
+		reading     string
+		romaji      string
+		wordIDs     []int
 		prefix      string
 		suffix      string
 		isFavorited int
@@ -26,6 +29,9 @@ func TestNewHistory(t *testing.T) {
 			name: "positive testing",
 			args: args{
 				phrase:      "prefix test",
+				reading:     "prefix test",
+				romaji:      "prefix test",
+				wordIDs:     []int{1},
 				prefix:      "prefix",
 				suffix:      "",
 				isFavorited: 1,
@@ -34,6 +40,9 @@ func TestNewHistory(t *testing.T) {
 			},
 			want: &History{
 				Phrase:      "prefix test",
+				Reading:     sql.NullString{String: "prefix test", Valid: true},
+				Romaji:      sql.NullString{String: "prefix test", Valid: true},
+				WordIDs:     sql.NullString{String: "1", Valid: true},
 				Prefix:      sql.NullString{String: "prefix", Valid: true},
 				Suffix:      sql.NullString{String: "", Valid: false},
 				IsFavorited: 1,
@@ -41,12 +50,71 @@ func TestNewHistory(t *testing.T) {
 				UpdatedAt:   now,
 			},
 		},
+		{
+			name: "positive testing (without word IDs)",
+			args: args{
+				phrase:      "test",
+				reading:     "",
+				romaji:      "",
+				wordIDs:     nil,
+				prefix:      "",
+				suffix:      "",
+				isFavorited: 0,
+				createdAt:   now,
+				updatedAt:   now,
+			},
+			want: &History{
+				Phrase:      "test",
+				Reading:     sql.NullString{String: "", Valid: false},
+				Romaji:      sql.NullString{String: "", Valid: false},
+				WordIDs:     sql.NullString{String: "", Valid: false},
+				Prefix:      sql.NullString{String: "", Valid: false},
+				Suffix:      sql.NullString{String: "", Valid: false},
+				IsFavorited: 0,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewHistory(tt.args.phrase, tt.args.prefix, tt.args.suffix, tt.args.isFavorited, tt.args.createdAt, tt.args.updatedAt); !reflect.DeepEqual(got, tt.want) {
+			if got := NewHistory(tt.args.phrase, tt.args.reading, tt.args.romaji, tt.args.wordIDs, tt.args.prefix, tt.args.suffix, tt.args.isFavorited, tt.args.createdAt, tt.args.updatedAt); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewHistory() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestHistory_GetWordIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		wordIDs sql.NullString
+		want    []int
+	}{
+		{
+			name:    "positive testing",
+			wordIDs: sql.NullString{String: "1,2,3", Valid: true},
+			want:    []int{1, 2, 3},
+		},
+		{
+			name:    "positive testing (word IDs are null)",
+			wordIDs: sql.NullString{String: "", Valid: false},
+			want:    nil,
+		},
+		{
+			name:    "positive testing (word IDs contain an invalid ID)",
+			wordIDs: sql.NullString{String: "1,test,3", Valid: true},
+			want:    []int{1, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &History{
+				WordIDs: tt.wordIDs,
+			}
+			if got := h.GetWordIDs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("History.GetWordIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
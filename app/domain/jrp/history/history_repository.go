@@ -6,19 +6,36 @@ import (
 
 // HistoryRepository is an interface that provides the repository for the history table in the jrp database.
 type HistoryRepository interface {
+	CountBySpec(ctx context.Context, spec *HistorySpec) (int, error)
 	DeleteAll(ctx context.Context) (int, error)
 	DeleteByIdIn(ctx context.Context, ids []int) (int, error)
 	DeleteByIdInAndIsFavoritedIs(ctx context.Context, ids []int, isFavorited int) (int, error)
 	DeleteByIsFavoritedIs(ctx context.Context, isFavorited int) (int, error)
+	DeleteNoteByIdIs(ctx context.Context, id int) (int, error)
+	DeleteTagByNameIsAndIdIn(ctx context.Context, name string, ids []int) (int, error)
 	FindAll(ctx context.Context) ([]*History, error)
+	FindAllTags(ctx context.Context) ([]*Tag, error)
+	FindByIdIs(ctx context.Context, id int) (*History, error)
 	FindByIsFavoritedIs(ctx context.Context, isFavorited int) ([]*History, error)
 	FindByIsFavoritedIsAndPhraseContains(ctx context.Context, keywords []string, and bool, isFavorited int) ([]*History, error)
 	FindByPhraseContains(ctx context.Context, keywords []string, and bool) ([]*History, error)
+	FindByPhraseIn(ctx context.Context, phrases []string) ([]*History, error)
+	FindBySpec(ctx context.Context, spec *HistorySpec) ([]*History, error)
+	FindNoteByIdIs(ctx context.Context, id int) (*Note, error)
+	FindTagByNameIs(ctx context.Context, name string) ([]*Tag, error)
 	FindTopNByIsFavoritedIsAndByOrderByIdAsc(ctx context.Context, number int, isFavorited int) ([]*History, error)
 	FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(ctx context.Context, keywords []string, and bool, number int, isFavorited int) ([]*History, error)
 	FindTopNByOrderByIdAsc(ctx context.Context, number int) ([]*History, error)
 	FindTopNByPhraseContainsOrderByIdAsc(ctx context.Context, keywords []string, and bool, number int) ([]*History, error)
+	PurgeAll(ctx context.Context) (int, error)
+	PurgeByIdIn(ctx context.Context, ids []int) (int, error)
+	RestoreAll(ctx context.Context) (int, error)
+	RestoreByIdIn(ctx context.Context, ids []int) (int, error)
+	RestoreFavorites(ctx context.Context) (int, error)
 	SaveAll(ctx context.Context, jrps []*History) ([]*History, error)
+	SaveNote(ctx context.Context, note *Note) (int, error)
+	SaveTagByIdIn(ctx context.Context, name string, ids []int) (int, error)
+	UnfavoriteAll(ctx context.Context) (int, error)
 	UpdateIsFavoritedByIdIn(ctx context.Context, isFavorited int, ids []int) (int, error)
 	UpdateIsFavoritedByIsFavoritedIs(ctx context.Context, isFavorited int, isFavoritedIs int) (int, error)
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./app/domain/jrp/history/history_repository.go
+// Source: history_repository.go
 //
 // Generated by this command:
 //
-//	mockgen -source=./app/domain/jrp/history/history_repository.go -destination=./app/domain/jrp/history/history_repository_mock.go -package=history
+//	mockgen -source=history_repository.go -destination=history_repository_mock.go -package=history
 //
 
 // Package history is a generated GoMock package.
@@ -40,6 +40,21 @@ func (m *MockHistoryRepository) EXPECT() *MockHistoryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountBySpec mocks base method.
+func (m *MockHistoryRepository) CountBySpec(ctx context.Context, spec *HistorySpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBySpec", ctx, spec)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBySpec indicates an expected call of CountBySpec.
+func (mr *MockHistoryRepositoryMockRecorder) CountBySpec(ctx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBySpec", reflect.TypeOf((*MockHistoryRepository)(nil).CountBySpec), ctx, spec)
+}
+
 // DeleteAll mocks base method.
 func (m *MockHistoryRepository) DeleteAll(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +115,36 @@ func (mr *MockHistoryRepositoryMockRecorder) DeleteByIsFavoritedIs(ctx, isFavori
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIsFavoritedIs", reflect.TypeOf((*MockHistoryRepository)(nil).DeleteByIsFavoritedIs), ctx, isFavorited)
 }
 
+// DeleteNoteByIdIs mocks base method.
+func (m *MockHistoryRepository) DeleteNoteByIdIs(ctx context.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNoteByIdIs", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNoteByIdIs indicates an expected call of DeleteNoteByIdIs.
+func (mr *MockHistoryRepositoryMockRecorder) DeleteNoteByIdIs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoteByIdIs", reflect.TypeOf((*MockHistoryRepository)(nil).DeleteNoteByIdIs), ctx, id)
+}
+
+// DeleteTagByNameIsAndIdIn mocks base method.
+func (m *MockHistoryRepository) DeleteTagByNameIsAndIdIn(ctx context.Context, name string, ids []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagByNameIsAndIdIn", ctx, name, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagByNameIsAndIdIn indicates an expected call of DeleteTagByNameIsAndIdIn.
+func (mr *MockHistoryRepositoryMockRecorder) DeleteTagByNameIsAndIdIn(ctx, name, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagByNameIsAndIdIn", reflect.TypeOf((*MockHistoryRepository)(nil).DeleteTagByNameIsAndIdIn), ctx, name, ids)
+}
+
 // FindAll mocks base method.
 func (m *MockHistoryRepository) FindAll(ctx context.Context) ([]*History, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +160,36 @@ func (mr *MockHistoryRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockHistoryRepository)(nil).FindAll), ctx)
 }
 
+// FindAllTags mocks base method.
+func (m *MockHistoryRepository) FindAllTags(ctx context.Context) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllTags", ctx)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllTags indicates an expected call of FindAllTags.
+func (mr *MockHistoryRepositoryMockRecorder) FindAllTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTags", reflect.TypeOf((*MockHistoryRepository)(nil).FindAllTags), ctx)
+}
+
+// FindByIdIs mocks base method.
+func (m *MockHistoryRepository) FindByIdIs(ctx context.Context, id int) (*History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdIs", ctx, id)
+	ret0, _ := ret[0].(*History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdIs indicates an expected call of FindByIdIs.
+func (mr *MockHistoryRepositoryMockRecorder) FindByIdIs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdIs", reflect.TypeOf((*MockHistoryRepository)(nil).FindByIdIs), ctx, id)
+}
+
 // FindByIsFavoritedIs mocks base method.
 func (m *MockHistoryRepository) FindByIsFavoritedIs(ctx context.Context, isFavorited int) ([]*History, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +235,66 @@ func (mr *MockHistoryRepositoryMockRecorder) FindByPhraseContains(ctx, keywords,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhraseContains", reflect.TypeOf((*MockHistoryRepository)(nil).FindByPhraseContains), ctx, keywords, and)
 }
 
+// FindByPhraseIn mocks base method.
+func (m *MockHistoryRepository) FindByPhraseIn(ctx context.Context, phrases []string) ([]*History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPhraseIn", ctx, phrases)
+	ret0, _ := ret[0].([]*History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPhraseIn indicates an expected call of FindByPhraseIn.
+func (mr *MockHistoryRepositoryMockRecorder) FindByPhraseIn(ctx, phrases any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhraseIn", reflect.TypeOf((*MockHistoryRepository)(nil).FindByPhraseIn), ctx, phrases)
+}
+
+// FindBySpec mocks base method.
+func (m *MockHistoryRepository) FindBySpec(ctx context.Context, spec *HistorySpec) ([]*History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySpec", ctx, spec)
+	ret0, _ := ret[0].([]*History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySpec indicates an expected call of FindBySpec.
+func (mr *MockHistoryRepositoryMockRecorder) FindBySpec(ctx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySpec", reflect.TypeOf((*MockHistoryRepository)(nil).FindBySpec), ctx, spec)
+}
+
+// FindNoteByIdIs mocks base method.
+func (m *MockHistoryRepository) FindNoteByIdIs(ctx context.Context, id int) (*Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNoteByIdIs", ctx, id)
+	ret0, _ := ret[0].(*Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNoteByIdIs indicates an expected call of FindNoteByIdIs.
+func (mr *MockHistoryRepositoryMockRecorder) FindNoteByIdIs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNoteByIdIs", reflect.TypeOf((*MockHistoryRepository)(nil).FindNoteByIdIs), ctx, id)
+}
+
+// FindTagByNameIs mocks base method.
+func (m *MockHistoryRepository) FindTagByNameIs(ctx context.Context, name string) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTagByNameIs", ctx, name)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTagByNameIs indicates an expected call of FindTagByNameIs.
+func (mr *MockHistoryRepositoryMockRecorder) FindTagByNameIs(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTagByNameIs", reflect.TypeOf((*MockHistoryRepository)(nil).FindTagByNameIs), ctx, name)
+}
+
 // FindTopNByIsFavoritedIsAndByOrderByIdAsc mocks base method.
 func (m *MockHistoryRepository) FindTopNByIsFavoritedIsAndByOrderByIdAsc(ctx context.Context, number, isFavorited int) ([]*History, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +355,81 @@ func (mr *MockHistoryRepositoryMockRecorder) FindTopNByPhraseContainsOrderByIdAs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopNByPhraseContainsOrderByIdAsc", reflect.TypeOf((*MockHistoryRepository)(nil).FindTopNByPhraseContainsOrderByIdAsc), ctx, keywords, and, number)
 }
 
+// PurgeAll mocks base method.
+func (m *MockHistoryRepository) PurgeAll(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAll", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAll indicates an expected call of PurgeAll.
+func (mr *MockHistoryRepositoryMockRecorder) PurgeAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAll", reflect.TypeOf((*MockHistoryRepository)(nil).PurgeAll), ctx)
+}
+
+// PurgeByIdIn mocks base method.
+func (m *MockHistoryRepository) PurgeByIdIn(ctx context.Context, ids []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByIdIn", ctx, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeByIdIn indicates an expected call of PurgeByIdIn.
+func (mr *MockHistoryRepositoryMockRecorder) PurgeByIdIn(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByIdIn", reflect.TypeOf((*MockHistoryRepository)(nil).PurgeByIdIn), ctx, ids)
+}
+
+// RestoreAll mocks base method.
+func (m *MockHistoryRepository) RestoreAll(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAll", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreAll indicates an expected call of RestoreAll.
+func (mr *MockHistoryRepositoryMockRecorder) RestoreAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAll", reflect.TypeOf((*MockHistoryRepository)(nil).RestoreAll), ctx)
+}
+
+// RestoreByIdIn mocks base method.
+func (m *MockHistoryRepository) RestoreByIdIn(ctx context.Context, ids []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByIdIn", ctx, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByIdIn indicates an expected call of RestoreByIdIn.
+func (mr *MockHistoryRepositoryMockRecorder) RestoreByIdIn(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByIdIn", reflect.TypeOf((*MockHistoryRepository)(nil).RestoreByIdIn), ctx, ids)
+}
+
+// RestoreFavorites mocks base method.
+func (m *MockHistoryRepository) RestoreFavorites(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFavorites", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreFavorites indicates an expected call of RestoreFavorites.
+func (mr *MockHistoryRepositoryMockRecorder) RestoreFavorites(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFavorites", reflect.TypeOf((*MockHistoryRepository)(nil).RestoreFavorites), ctx)
+}
+
 // SaveAll mocks base method.
 func (m *MockHistoryRepository) SaveAll(ctx context.Context, jrps []*History) ([]*History, error) {
 	m.ctrl.T.Helper()
@@ -235,6 +445,51 @@ func (mr *MockHistoryRepositoryMockRecorder) SaveAll(ctx, jrps any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAll", reflect.TypeOf((*MockHistoryRepository)(nil).SaveAll), ctx, jrps)
 }
 
+// SaveNote mocks base method.
+func (m *MockHistoryRepository) SaveNote(ctx context.Context, note *Note) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNote", ctx, note)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveNote indicates an expected call of SaveNote.
+func (mr *MockHistoryRepositoryMockRecorder) SaveNote(ctx, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNote", reflect.TypeOf((*MockHistoryRepository)(nil).SaveNote), ctx, note)
+}
+
+// SaveTagByIdIn mocks base method.
+func (m *MockHistoryRepository) SaveTagByIdIn(ctx context.Context, name string, ids []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTagByIdIn", ctx, name, ids)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTagByIdIn indicates an expected call of SaveTagByIdIn.
+func (mr *MockHistoryRepositoryMockRecorder) SaveTagByIdIn(ctx, name, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTagByIdIn", reflect.TypeOf((*MockHistoryRepository)(nil).SaveTagByIdIn), ctx, name, ids)
+}
+
+// UnfavoriteAll mocks base method.
+func (m *MockHistoryRepository) UnfavoriteAll(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfavoriteAll", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfavoriteAll indicates an expected call of UnfavoriteAll.
+func (mr *MockHistoryRepositoryMockRecorder) UnfavoriteAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfavoriteAll", reflect.TypeOf((*MockHistoryRepository)(nil).UnfavoriteAll), ctx)
+}
+
 // UpdateIsFavoritedByIdIn mocks base method.
 func (m *MockHistoryRepository) UpdateIsFavoritedByIdIn(ctx context.Context, isFavorited int, ids []int) (int, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+package history
+
+import (
+	"time"
+)
+
+// SortKey is a type that represents the key to sort the histories by.
+type SortKey string
+
+const (
+	// SortById sorts the histories by the ID.
+	SortById SortKey = "id"
+	// SortByCreatedAt sorts the histories by the timestamp when the phrase was created.
+	SortByCreatedAt SortKey = "created"
+	// SortByPhrase sorts the histories by the phrase.
+	SortByPhrase SortKey = "phrase"
+)
+
+// HistorySpec is a struct that specifies the histories to find in the jrp database.
+type HistorySpec struct {
+	// Keywords is the keywords the phrase contains. All the histories match if it is empty.
+	Keywords []string
+	// And is a flag to indicate whether the phrase must contain all the keywords.
+	And bool
+	// FavoritedOnly is a flag to find only the favorited histories.
+	FavoritedOnly bool
+	// Tag is the tag the histories are tagged with. All the histories match if it is empty.
+	Tag string
+	// Since is the inclusive lower bound of the created timestamp. It is unbounded if it is zero.
+	Since time.Time
+	// Until is the exclusive upper bound of the created timestamp. It is unbounded if it is zero.
+	Until time.Time
+	// Number is the number of the histories to find, the most recent ones whatever the sort key is. All the histories are found if it is zero or less.
+	Number int
+	// Offset is the number of the histories to skip before finding them in the same order as Number. It is ignored if Number is zero or less.
+	Offset int
+	// Cursor is the ID the histories to find are older than. It is used to find the next page after the page of the cursor, only when sorted by the ID. No cursor is used if it is zero or less.
+	Cursor int
+	// SortBy is the key to sort the histories by. The histories are sorted by the ID if it is empty.
+	SortBy SortKey
+	// Desc is a flag to sort the histories in descending order.
+	Desc bool
+	// Trashed is a flag to find the histories in the trash instead of the others.
+	Trashed bool
+}
+
+// NewHistorySpec returns a new instance of the HistorySpec struct.
+func NewHistorySpec(
+	keywords []string,
+	and bool,
+	favoritedOnly bool,
+	tag string,
+	since time.Time,
+	until time.Time,
+	number int,
+	offset int,
+	cursor int,
+	sortBy SortKey,
+	desc bool,
+) *HistorySpec {
+	return &HistorySpec{
+		Keywords:      keywords,
+		And:           and,
+		FavoritedOnly: favoritedOnly,
+		Tag:           tag,
+		Since:         since,
+		Until:         until,
+		Number:        number,
+		Offset:        offset,
+		Cursor:        cursor,
+		SortBy:        sortBy,
+		Desc:          desc,
+	}
+}
+
+// ParseSortKey returns the SortKey of the given string.
+func ParseSortKey(s string) (SortKey, bool) {
+	switch SortKey(s) {
+	case "", SortById:
+		return SortById, true
+	case SortByCreatedAt:
+		return SortByCreatedAt, true
+	case SortByPhrase:
+		return SortByPhrase, true
+	default:
+		return "", false
+	}
+}
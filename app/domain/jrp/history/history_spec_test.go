@@ -0,0 +1,91 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewHistorySpec(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		keywords      []string
+		and           bool
+		favoritedOnly bool
+		tag           string
+		since         time.Time
+		until         time.Time
+		number        int
+		offset        int
+		cursor        int
+		sortBy        SortKey
+		desc          bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want *HistorySpec
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				keywords:      []string{"test"},
+				and:           true,
+				favoritedOnly: true,
+				tag:           "release-names",
+				since:         since,
+				until:         until,
+				number:        10,
+				offset:        20,
+				cursor:        100,
+				sortBy:        SortByPhrase,
+				desc:          true,
+			},
+			want: &HistorySpec{
+				Keywords:      []string{"test"},
+				And:           true,
+				FavoritedOnly: true,
+				Tag:           "release-names",
+				Since:         since,
+				Until:         until,
+				Number:        10,
+				Offset:        20,
+				Cursor:        100,
+				SortBy:        SortByPhrase,
+				Desc:          true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewHistorySpec(tt.args.keywords, tt.args.and, tt.args.favoritedOnly, tt.args.tag, tt.args.since, tt.args.until, tt.args.number, tt.args.offset, tt.args.cursor, tt.args.sortBy, tt.args.desc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewHistorySpec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSortKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   SortKey
+		wantOk bool
+	}{
+		{name: "positive testing (empty)", s: "", want: SortById, wantOk: true},
+		{name: "positive testing (id)", s: "id", want: SortById, wantOk: true},
+		{name: "positive testing (created)", s: "created", want: SortByCreatedAt, wantOk: true},
+		{name: "positive testing (phrase)", s: "phrase", want: SortByPhrase, wantOk: true},
+		{name: "negative testing (unknown)", s: "unknown", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseSortKey(tt.s)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("ParseSortKey() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package history
+
+import (
+	"time"
+)
+
+// Note is a struct that represents history_note table in the jrp database.
+type Note struct {
+	// HistoryID is the ID of the noted history.
+	HistoryID int
+	// Note is the free text of the note.
+	Note string
+	// UpdatedAt is the timestamp when the note is updated.
+	UpdatedAt time.Time
+}
+
+// NewNote returns a new instance of the Note struct.
+func NewNote(
+	historyID int,
+	note string,
+	updatedAt time.Time,
+) *Note {
+	return &Note{
+		HistoryID: historyID,
+		Note:      note,
+		UpdatedAt: updatedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewNote(t *testing.T) {
+	now := time.Now()
+	type args struct {
+		historyID int
+		note      string
+		updatedAt time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Note
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyID: 1,
+				note:      "candidate of the next release name",
+				updatedAt: now,
+			},
+			want: &Note{
+				HistoryID: 1,
+				Note:      "candidate of the next release name",
+				UpdatedAt: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewNote(tt.args.historyID, tt.args.note, tt.args.updatedAt); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package history
+
+// Tag is a struct that represents history_tag table in the jrp database.
+type Tag struct {
+	// HistoryID is the ID of the tagged history.
+	HistoryID int
+	// Name is the name of the tag.
+	Name string
+}
+
+// NewTag returns a new instance of the Tag struct.
+func NewTag(
+	historyID int,
+	name string,
+) *Tag {
+	return &Tag{
+		HistoryID: historyID,
+		Name:      name,
+	}
+}
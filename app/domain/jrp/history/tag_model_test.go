@@ -0,0 +1,37 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewTag(t *testing.T) {
+	type args struct {
+		historyID int
+		name      string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Tag
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				historyID: 1,
+				name:      "release-names",
+			},
+			want: &Tag{
+				HistoryID: 1,
+				Name:      "release-names",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewTag(tt.args.historyID, tt.args.name); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 // DBConnection is an interface that contains the database connection.
 type DBConnection interface {
 	Close() error
+	DBType() DBType
 	Open() (proxy.DB, error)
 }
 
@@ -36,6 +37,11 @@ func (c *dbConnection) Close() error {
 	return nil
 }
 
+// DBType returns the type of the database of the connection.
+func (c *dbConnection) DBType() DBType {
+	return DBType(c.driverName)
+}
+
 // Open opens the database connection.
 func (c *dbConnection) Open() (proxy.DB, error) {
 	c.mutex.Lock()
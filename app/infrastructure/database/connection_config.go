@@ -8,6 +8,10 @@ type DBType string
 const (
 	// SQLite is a type of database.
 	SQLite DBType = "sqlite"
+	// PostgreSQL is a type of database.
+	PostgreSQL DBType = "postgres"
+	// MySQL is a type of database.
+	MySQL DBType = "mysql"
 )
 
 // DBName is a type that represents the name of the database.
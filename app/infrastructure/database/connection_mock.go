@@ -54,6 +54,20 @@ func (mr *MockDBConnectionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDBConnection)(nil).Close))
 }
 
+// DBType mocks base method.
+func (m *MockDBConnection) DBType() DBType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DBType")
+	ret0, _ := ret[0].(DBType)
+	return ret0
+}
+
+// DBType indicates an expected call of DBType.
+func (mr *MockDBConnectionMockRecorder) DBType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBType", reflect.TypeOf((*MockDBConnection)(nil).DBType))
+}
+
 // Open mocks base method.
 func (m *MockDBConnection) Open() (proxy.DB, error) {
 	m.ctrl.T.Helper()
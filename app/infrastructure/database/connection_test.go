@@ -93,6 +93,53 @@ func Test_dbConnection_Close(t *testing.T) {
 	}
 }
 
+func Test_dbConnection_DBType(t *testing.T) {
+	type fields struct {
+		driverName string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   DBType
+	}{
+		{
+			name: "positive testing (sqlite)",
+			fields: fields{
+				driverName: "sqlite",
+			},
+			want: SQLite,
+		},
+		{
+			name: "positive testing (postgres)",
+			fields: fields{
+				driverName: "postgres",
+			},
+			want: PostgreSQL,
+		},
+		{
+			name: "positive testing (mysql)",
+			fields: fields{
+				driverName: "mysql",
+			},
+			want: MySQL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &dbConnection{
+				sql:            proxy.NewSql(),
+				db:             nil,
+				driverName:     tt.fields.driverName,
+				dataSourceName: "",
+				mutex:          &sync.RWMutex{},
+			}
+			if got := c.DBType(); got != tt.want {
+				t.Errorf("dbConnection.DBType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_dbConnection_Open(t *testing.T) {
 	type fields struct {
 		sql            proxy.Sql
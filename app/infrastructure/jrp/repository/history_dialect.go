@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+)
+
+// historyDialect is a struct that contains the queries of the history table which differ by the type of the database.
+type historyDialect struct {
+	// insertQuery is a query that inserts records into the history table.
+	insertQuery string
+	// returning is whether the insert query returns the IDs of the inserted records.
+	returning bool
+	// firstInsertId is whether the last insert ID is the ID of the first record inserted by the insert query.
+	firstInsertId bool
+	// numberedPlaceholders is whether the placeholders of the queries are numbered like $1, $2 instead of ?.
+	numberedPlaceholders bool
+	// timestampExpression is the expression that makes the timestamp column comparable by the points in time. %[1]s is replaced with the column.
+	timestampExpression string
+	// timestampPlaceholder is the placeholder of the timestamp compared with the timestamp expression.
+	timestampPlaceholder string
+	// timestampLayout is the layout to format the timestamp bound to the placeholder, or empty to bind the time as it is.
+	timestampLayout string
+	// fullTextSearchCondition is the condition that matches the phrase by the full-text search, or empty if the full-text search is not available.
+	fullTextSearchCondition string
+	// countColumnQuery is a query that counts the columns of the history table by the name.
+	countColumnQuery string
+}
+
+var (
+	// historyDialects is the dialects of the history table by the type of the database.
+	historyDialects = map[database.DBType]*historyDialect{
+		database.SQLite: {
+			insertQuery:             InsertQuery,
+			returning:               false,
+			firstInsertId:           false,
+			numberedPlaceholders:    false,
+			timestampExpression:     SQLiteTimestampExpression,
+			timestampPlaceholder:    "julianday(?)",
+			timestampLayout:         "2006-01-02 15:04:05.999999999-07:00",
+			fullTextSearchCondition: SQLiteFullTextSearchCondition,
+			countColumnQuery:        CountColumnByNameIsQuery,
+		},
+		database.PostgreSQL: {
+			insertQuery:             PostgreSQLInsertQuery,
+			returning:               true,
+			firstInsertId:           false,
+			numberedPlaceholders:    true,
+			timestampExpression:     "%[1]s",
+			timestampPlaceholder:    "?",
+			timestampLayout:         "",
+			fullTextSearchCondition: "",
+			countColumnQuery:        PostgreSQLCountColumnByNameIsQuery,
+		},
+		database.MySQL: {
+			insertQuery:             InsertQuery,
+			returning:               false,
+			firstInsertId:           true,
+			numberedPlaceholders:    false,
+			timestampExpression:     "%[1]s",
+			timestampPlaceholder:    "?",
+			timestampLayout:         "",
+			fullTextSearchCondition: "",
+			countColumnQuery:        MySQLCountColumnByNameIsQuery,
+		},
+	}
+)
+
+// getHistoryDialect returns the dialect of the history table for the type of the database.
+func getHistoryDialect(dbType database.DBType) (*historyDialect, error) {
+	dialect, ok := historyDialects[dbType]
+	if !ok {
+		return nil, errors.New("unsupported database type : " + string(dbType))
+	}
+
+	return dialect, nil
+}
+
+// rebind rewrites the ? placeholders of the query into the placeholders of the dialect.
+func (d *historyDialect) rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// timestamp returns the expression that makes the timestamp column comparable by the points in time.
+func (d *historyDialect) timestamp(column string) string {
+	return fmt.Sprintf(d.timestampExpression, column)
+}
+
+// timestampArg returns the argument of the time bound to the timestamp placeholder.
+func (d *historyDialect) timestampArg(t time.Time) interface{} {
+	if d.timestampLayout == "" {
+		return t
+	}
+
+	return t.Format(d.timestampLayout)
+}
+
+// keywordCondition returns the condition that matches the phrase containing the keyword and the argument of it.
+// the full-text search is used if it is available and the keyword is long enough for the trigram tokenizer,
+// otherwise the phrase is matched by LIKE.
+func (d *historyDialect) keywordCondition(keyword string) (string, interface{}) {
+	if d.fullTextSearchCondition == "" || utf8.RuneCountInString(keyword) < 3 {
+		return "Phrase LIKE ?", "%" + keyword + "%"
+	}
+
+	return d.fullTextSearchCondition, `"` + strings.ReplaceAll(keyword, `"`, `""`) + `"`
+}
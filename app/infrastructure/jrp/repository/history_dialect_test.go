@@ -0,0 +1,390 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func Test_getHistoryDialect(t *testing.T) {
+	type args struct {
+		dbType database.DBType
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *historyDialect
+		wantErr bool
+	}{
+		{
+			name: "positive testing (SQLite)",
+			args: args{
+				dbType: database.SQLite,
+			},
+			want:    historyDialects[database.SQLite],
+			wantErr: false,
+		},
+		{
+			name: "positive testing (PostgreSQL)",
+			args: args{
+				dbType: database.PostgreSQL,
+			},
+			want:    historyDialects[database.PostgreSQL],
+			wantErr: false,
+		},
+		{
+			name: "positive testing (MySQL)",
+			args: args{
+				dbType: database.MySQL,
+			},
+			want:    historyDialects[database.MySQL],
+			wantErr: false,
+		},
+		{
+			name: "negative testing (unsupported database type)",
+			args: args{
+				dbType: database.DBType("test"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getHistoryDialect(tt.args.dbType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getHistoryDialect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getHistoryDialect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyDialect_rebind(t *testing.T) {
+	type args struct {
+		query string
+	}
+	tests := []struct {
+		name    string
+		dialect *historyDialect
+		args    args
+		want    string
+	}{
+		{
+			name:    "positive testing (SQLite)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				query: "SELECT * FROM history WHERE ID IN (?,?) AND IsFavorited = ?;",
+			},
+			want: "SELECT * FROM history WHERE ID IN (?,?) AND IsFavorited = ?;",
+		},
+		{
+			name:    "positive testing (PostgreSQL)",
+			dialect: historyDialects[database.PostgreSQL],
+			args: args{
+				query: "SELECT * FROM history WHERE ID IN (?,?) AND IsFavorited = ?;",
+			},
+			want: "SELECT * FROM history WHERE ID IN ($1,$2) AND IsFavorited = $3;",
+		},
+		{
+			name:    "positive testing (MySQL)",
+			dialect: historyDialects[database.MySQL],
+			args: args{
+				query: "SELECT * FROM history WHERE ID IN (?,?) AND IsFavorited = ?;",
+			},
+			want: "SELECT * FROM history WHERE ID IN (?,?) AND IsFavorited = ?;",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.rebind(tt.args.query); got != tt.want {
+				t.Errorf("historyDialect.rebind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyDialect_timestamp(t *testing.T) {
+	type args struct {
+		column string
+	}
+	tests := []struct {
+		name    string
+		dialect *historyDialect
+		args    args
+		want    string
+	}{
+		{
+			name:    "positive testing (SQLite)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				column: "history.CreatedAt",
+			},
+			want: strings.ReplaceAll(SQLiteTimestampExpression, "%[1]s", "history.CreatedAt"),
+		},
+		{
+			name:    "positive testing (PostgreSQL)",
+			dialect: historyDialects[database.PostgreSQL],
+			args: args{
+				column: "history.CreatedAt",
+			},
+			want: "history.CreatedAt",
+		},
+		{
+			name:    "positive testing (MySQL)",
+			dialect: historyDialects[database.MySQL],
+			args: args{
+				column: "history.CreatedAt",
+			},
+			want: "history.CreatedAt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.timestamp(tt.args.column); got != tt.want {
+				t.Errorf("historyDialect.timestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyDialect_timestampArg(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 10, 0, 0, 500, time.FixedZone("JST", 9*60*60))
+
+	type args struct {
+		t time.Time
+	}
+	tests := []struct {
+		name    string
+		dialect *historyDialect
+		args    args
+		want    interface{}
+	}{
+		{
+			name:    "positive testing (SQLite)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				t: ts,
+			},
+			want: "2026-01-01 10:00:00.0000005+09:00",
+		},
+		{
+			name:    "positive testing (PostgreSQL)",
+			dialect: historyDialects[database.PostgreSQL],
+			args: args{
+				t: ts,
+			},
+			want: ts,
+		},
+		{
+			name:    "positive testing (MySQL)",
+			dialect: historyDialects[database.MySQL],
+			args: args{
+				t: ts,
+			},
+			want: ts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dialect.timestampArg(tt.args.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("historyDialect.timestampArg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_historyDialect_keywordCondition checks the condition and the args to search the phrases by the keyword per dialect.
+func Test_historyDialect_keywordCondition(t *testing.T) {
+	type args struct {
+		keyword string
+	}
+	tests := []struct {
+		name          string
+		dialect       *historyDialect
+		args          args
+		wantCondition string
+		wantArg       interface{}
+	}{
+		{
+			name:    "positive testing (SQLite, the keyword has 3 characters)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				keyword: "静かな",
+			},
+			wantCondition: SQLiteFullTextSearchCondition,
+			wantArg:       `"静かな"`,
+		},
+		{
+			name:    "positive testing (SQLite, the keyword has double quotes)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				keyword: `a"b"c`,
+			},
+			wantCondition: SQLiteFullTextSearchCondition,
+			wantArg:       `"a""b""c"`,
+		},
+		{
+			name:    "positive testing (SQLite, the keyword has 2 characters)",
+			dialect: historyDialects[database.SQLite],
+			args: args{
+				keyword: "静か",
+			},
+			wantCondition: "Phrase LIKE ?",
+			wantArg:       "%静か%",
+		},
+		{
+			name:    "positive testing (PostgreSQL)",
+			dialect: historyDialects[database.PostgreSQL],
+			args: args{
+				keyword: "静かな",
+			},
+			wantCondition: "Phrase LIKE ?",
+			wantArg:       "%静かな%",
+		},
+		{
+			name:    "positive testing (MySQL)",
+			dialect: historyDialects[database.MySQL],
+			args: args{
+				keyword: "静かな",
+			},
+			wantCondition: "Phrase LIKE ?",
+			wantArg:       "%静かな%",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCondition, gotArg := tt.dialect.keywordCondition(tt.args.keyword)
+			if gotCondition != tt.wantCondition {
+				t.Errorf("historyDialect.keywordCondition() condition = %v, want %v", gotCondition, tt.wantCondition)
+			}
+			if !reflect.DeepEqual(gotArg, tt.wantArg) {
+				t.Errorf("historyDialect.keywordCondition() arg = %v, want %v", gotArg, tt.wantArg)
+			}
+		})
+	}
+}
+
+// Test_historyRepository_dialects runs the history repository against the real databases.
+// set JRP_TEST_POSTGRES_DSN or JRP_TEST_MYSQL_DSN to run it. (e.g. : docker compose up)
+func Test_historyRepository_dialects(t *testing.T) {
+	tests := []struct {
+		name   string
+		dbType database.DBType
+		env    string
+	}{
+		{
+			name:   "positive testing (PostgreSQL)",
+			dbType: database.PostgreSQL,
+			env:    "JRP_TEST_POSTGRES_DSN",
+		},
+		{
+			name:   "positive testing (MySQL)",
+			dbType: database.MySQL,
+			env:    "JRP_TEST_MYSQL_DSN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsn := os.Getenv(tt.env)
+			if dsn == "" {
+				t.Skipf("%s is not set", tt.env)
+			}
+			if err := database.ResetConnectionManager(); err != nil {
+				t.Fatalf("ResetConnectionManager() : error = %v", err)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("ResetConnectionManager() : error = %v", err)
+				}
+			}()
+			if err := database.NewConnectionManager(proxy.NewSql()).InitializeConnection(
+				database.ConnectionConfig{
+					DBName: database.JrpDB,
+					DBType: tt.dbType,
+					DSN:    dsn,
+				},
+			); err != nil {
+				t.Fatalf("InitializeConnection() : error = %v", err)
+			}
+			ctx := context.Background()
+			repo := NewHistoryRepository()
+			cleanup := func() {
+				if _, err := repo.DeleteAll(ctx); err != nil {
+					t.Fatalf("DeleteAll() : error = %v", err)
+				}
+				if _, err := repo.PurgeAll(ctx); err != nil {
+					t.Fatalf("PurgeAll() : error = %v", err)
+				}
+			}
+			cleanup()
+			defer cleanup()
+			now := time.Now()
+			saved, err := repo.SaveAll(ctx, []*history.History{
+				history.NewHistory("test1", "", "", []int{1, 2}, "", "", 0, now, now),
+				history.NewHistory("test2", "", "", nil, "", "", 0, now, now),
+			})
+			if err != nil {
+				t.Fatalf("SaveAll() : error = %v", err)
+			}
+			// the sequences of the IDs are not reset by purging, so the IDs are checked relatively.
+			if len(saved) != 2 || saved[0].ID <= 0 || saved[1].ID != saved[0].ID+1 {
+				t.Fatalf("SaveAll() : got IDs = %v, want the sequential IDs", saved)
+			}
+			id1, id2 := saved[0].ID, saved[1].ID
+			if got, err := repo.UpdateIsFavoritedByIdIn(ctx, 1, []int{id1}); err != nil || got != 1 {
+				t.Errorf("UpdateIsFavoritedByIdIn() : got = %v, error = %v, want 1", got, err)
+			}
+			got, err := repo.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(ctx, []string{"test"}, false, 10, 1)
+			if err != nil {
+				t.Fatalf("FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() : error = %v", err)
+			}
+			if len(got) != 1 || got[0].Phrase != "test1" || !reflect.DeepEqual(got[0].GetWordIDs(), []int{1, 2}) {
+				t.Errorf("FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() : got = %v", got)
+			}
+			got, err = repo.FindBySpec(ctx, history.NewHistorySpec(nil, false, false, "", now.Add(-time.Hour), now.Add(time.Hour), 0, 0, 0, history.SortByPhrase, true))
+			if err != nil {
+				t.Fatalf("FindBySpec() : error = %v", err)
+			}
+			if len(got) != 2 || got[0].Phrase != "test2" || got[1].Phrase != "test1" {
+				t.Errorf("FindBySpec() : got = %v", got)
+			}
+			if got, err := repo.DeleteByIdInAndIsFavoritedIs(ctx, []int{id1, id2}, 0); err != nil || got != 1 {
+				t.Errorf("DeleteByIdInAndIsFavoritedIs() : got = %v, error = %v, want 1", got, err)
+			}
+			got, err = repo.FindBySpec(ctx, history.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 0, 0, 0, history.SortById, false))
+			if err != nil {
+				t.Fatalf("FindBySpec() : error = %v", err)
+			}
+			if len(got) != 1 || got[0].ID != id1 {
+				t.Errorf("FindBySpec() : got = %v, want only the history not in the trash %v", got, id1)
+			}
+			trashed := history.NewHistorySpec(nil, false, false, "", time.Time{}, time.Time{}, 0, 0, 0, history.SortById, false)
+			trashed.Trashed = true
+			got, err = repo.FindBySpec(ctx, trashed)
+			if err != nil {
+				t.Fatalf("FindBySpec() : error = %v", err)
+			}
+			if len(got) != 1 || got[0].ID != id2 {
+				t.Errorf("FindBySpec() : got = %v, want only the history in the trash %v", got, id2)
+			}
+			if got, err := repo.DeleteAll(ctx); err != nil || got != 1 {
+				t.Errorf("DeleteAll() : got = %v, error = %v, want 1", got, err)
+			}
+			if got, err := repo.PurgeAll(ctx); err != nil || got != 2 {
+				t.Errorf("PurgeAll() : got = %v, error = %v, want 2", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// historyMigration is a struct that represents a versioned migration of the schema of the jrp database.
+type historyMigration struct {
+	// version is the version of the schema the migration migrates to.
+	version int
+	// description is the description of the migration.
+	description string
+	// queries are the queries of the migration by the type of the database.
+	queries map[database.DBType][]*historyMigrationQuery
+}
+
+// historyMigrationQuery is a struct that represents a query of a migration.
+type historyMigrationQuery struct {
+	// query is the query to execute.
+	query string
+	// column is the column of the history table the query adds, or empty if the query adds no column.
+	// the query is skipped if the column already exists, because the tables created before the migrations were introduced may already have it.
+	column string
+}
+
+// historyMigrationOnce is a struct that guards the migrations of a connection to be applied only once.
+type historyMigrationOnce struct {
+	// once is the guard of the migrations.
+	once sync.Once
+	// err is the error of the migrations.
+	err error
+}
+
+var (
+	// historyMigrations is the migrations of the schema of the jrp database in the order to be applied.
+	// never modify the applied ones, append a new one to change the schema.
+	historyMigrations = []*historyMigration{
+		{
+			version:     1,
+			description: "create the history table",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite:     {{query: CreateQuery}},
+				database.PostgreSQL: {{query: PostgreSQLCreateQuery}},
+				database.MySQL:      {{query: MySQLCreateQuery}},
+			},
+		},
+		{
+			version:     2,
+			description: "add the reading columns to the history table",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite: {
+					{query: AddReadingColumnQuery, column: "Reading"},
+					{query: AddRomajiColumnQuery, column: "Romaji"},
+				},
+			},
+		},
+		{
+			version:     3,
+			description: "add the word ids column to the history table",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite: {{query: AddWordIDsColumnQuery, column: "WordIDs"}},
+			},
+		},
+		{
+			version:     4,
+			description: "create the tag and the note tables",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite:     {{query: CreateTagQuery}, {query: CreateNoteQuery}},
+				database.PostgreSQL: {{query: CreateTagQuery}, {query: PostgreSQLCreateNoteQuery}},
+				database.MySQL:      {{query: MySQLCreateTagQuery}, {query: MySQLCreateNoteQuery}},
+			},
+		},
+		{
+			version:     5,
+			description: "create the full-text search table of the phrases",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite: {
+					{query: CreateFullTextSearchQuery},
+					{query: CreateFullTextSearchInsertTriggerQuery},
+					{query: CreateFullTextSearchDeleteTriggerQuery},
+					{query: CreateFullTextSearchUpdateTriggerQuery},
+					{query: RebuildFullTextSearchQuery},
+				},
+			},
+		},
+		{
+			version:     6,
+			description: "add the deleted at column to the history table",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite:     {{query: AddDeletedAtColumnQuery, column: "DeletedAt"}},
+				database.PostgreSQL: {{query: PostgreSQLAddDeletedAtColumnQuery, column: "DeletedAt"}},
+				database.MySQL:      {{query: MySQLAddDeletedAtColumnQuery, column: "DeletedAt"}},
+			},
+		},
+		{
+			version:     7,
+			description: "create the table of the histories unfavorited at once",
+			queries: map[database.DBType][]*historyMigrationQuery{
+				database.SQLite:     {{query: CreateUnfavoritedQuery}},
+				database.PostgreSQL: {{query: CreateUnfavoritedQuery}},
+				database.MySQL:      {{query: CreateUnfavoritedQuery}},
+			},
+		},
+	}
+	// historyMigrationOnces is the guards of the migrations by the connection to the jrp database.
+	historyMigrationOnces sync.Map
+)
+
+// schemaMigrator is a struct that implements the SchemaMigrator interface.
+type schemaMigrator struct {
+	connManager database.ConnectionManager
+}
+
+// NewSchemaMigrator returns a new instance of the SchemaMigrator struct.
+func NewSchemaMigrator() jrpApp.SchemaMigrator {
+	return &schemaMigrator{
+		connManager: database.GetConnectionManager(),
+	}
+}
+
+// FindAll returns all the migrations with whether they are applied or not.
+func (s *schemaMigrator) FindAll(ctx context.Context) ([]*jrpApp.SchemaMigrationDto, error) {
+	db, _, dialect, err := openJrpDB(s.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := findAppliedMigrations(ctx, db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*jrpApp.SchemaMigrationDto
+	for _, m := range historyMigrations {
+		migration := &jrpApp.SchemaMigrationDto{
+			Version:     m.version,
+			Description: m.description,
+			IsApplied:   false,
+			AppliedAt:   time.Time{},
+		}
+		if a, ok := applied[m.version]; ok {
+			migration.IsApplied = true
+			migration.AppliedAt = a.AppliedAt
+		}
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// Migrate applies the migrations not applied yet and returns them.
+func (s *schemaMigrator) Migrate(ctx context.Context) ([]*jrpApp.SchemaMigrationDto, error) {
+	db, dbType, dialect, err := openJrpDB(s.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate(ctx, db, dbType, dialect)
+}
+
+// MigrateJrpDB applies the migrations not applied yet to the jrp database.
+// the migrations are applied only once for the connection even if it is called concurrently,
+// so call it at the startup before the repositories use the database.
+func MigrateJrpDB(ctx context.Context, connManager database.ConnectionManager) error {
+	_, _, err := getJrpDB(ctx, connManager)
+	return err
+}
+
+// migrateOnce applies the migrations not applied yet to the jrp database only once for the connection.
+// the callers wait until the migrations are applied by the first caller, and get the error of them.
+func migrateOnce(
+	ctx context.Context,
+	conn database.DBConnection,
+	db proxy.DB,
+	dbType database.DBType,
+	dialect *historyDialect,
+) error {
+	v, _ := historyMigrationOnces.LoadOrStore(conn, &historyMigrationOnce{})
+	o := v.(*historyMigrationOnce)
+	o.once.Do(func() {
+		_, o.err = migrate(ctx, db, dbType, dialect)
+	})
+
+	return o.err
+}
+
+// migrate applies the migrations not applied yet to the jrp database in order and returns them.
+func migrate(
+	ctx context.Context,
+	db proxy.DB,
+	dbType database.DBType,
+	dialect *historyDialect,
+) ([]*jrpApp.SchemaMigrationDto, error) {
+	applied, err := findAppliedMigrations(ctx, db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*jrpApp.SchemaMigrationDto
+	for _, m := range historyMigrations {
+		if _, ok := applied[m.version]; ok {
+			continue
+		}
+		migration, err := applyMigration(ctx, db, dbType, dialect, m)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// findAppliedMigrations creates the schema_migrations table if not exists and returns the applied migrations by the version.
+func findAppliedMigrations(
+	ctx context.Context,
+	db proxy.DB,
+	dialect *historyDialect,
+) (map[int]*jrpApp.SchemaMigrationDto, error) {
+	var deferErr error
+	if _, err := db.ExecContext(ctx, CreateSchemaMigrationsQuery); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindAllSchemaMigrationsQuery))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	applied := make(map[int]*jrpApp.SchemaMigrationDto)
+	for rows.Next() {
+		migration := &jrpApp.SchemaMigrationDto{
+			IsApplied: true,
+		}
+		if err := rows.Scan(
+			&migration.Version,
+			&migration.Description,
+			&migration.AppliedAt,
+		); err != nil {
+			return nil, err
+		}
+		applied[migration.Version] = migration
+	}
+
+	return applied, deferErr
+}
+
+// applyMigration applies the migration and records it to the schema_migrations table in a transaction.
+func applyMigration(
+	ctx context.Context,
+	db proxy.DB,
+	dbType database.DBType,
+	dialect *historyDialect,
+	m *historyMigration,
+) (*jrpApp.SchemaMigrationDto, error) {
+	var deferErr error
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = tx.Rollback()
+	}()
+
+	for _, q := range m.queries[dbType] {
+		if q.column != "" {
+			exists, err := hasColumn(ctx, tx, dialect, q.column)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				continue
+			}
+		}
+		if _, err := tx.ExecContext(ctx, q.query); err != nil {
+			return nil, err
+		}
+	}
+
+	appliedAt := time.Now()
+	if _, err := tx.ExecContext(
+		ctx,
+		dialect.rebind(InsertSchemaMigrationQuery),
+		m.version,
+		m.description,
+		appliedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &jrpApp.SchemaMigrationDto{
+		Version:     m.version,
+		Description: m.description,
+		IsApplied:   true,
+		AppliedAt:   appliedAt,
+	}, deferErr
+}
+
+// hasColumn returns whether the history table has the column or not.
+func hasColumn(
+	ctx context.Context,
+	tx proxy.Tx,
+	dialect *historyDialect,
+	column string,
+) (bool, error) {
+	var deferErr error
+	rows, err := tx.QueryContext(ctx, dialect.rebind(dialect.countColumnQuery), column)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	count := 0
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return false, err
+		}
+	}
+
+	return count > 0, deferErr
+}
@@ -0,0 +1,880 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+// expectMigrated sets the expectations to the mock of the jrp database that all the migrations are already applied.
+func expectMigrated(mockCtrl *gomock.Controller, mockDB *proxy.MockDB) {
+	version := 0
+	mockRows := proxy.NewMockRows(mockCtrl)
+	mockRows.EXPECT().Next().Return(true).Times(len(historyMigrations))
+	mockRows.EXPECT().Next().Return(false)
+	mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+		version++
+		*dest[0].(*int) = version
+		return nil
+	}).Times(len(historyMigrations))
+	mockRows.EXPECT().Close().Return(nil)
+	mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, nil)
+	mockDB.EXPECT().QueryContext(gomock.Any(), FindAllSchemaMigrationsQuery).Return(mockRows, nil)
+}
+
+// expectColumnCounted sets the expectation of counting the column of the history table to the mock transaction.
+func expectColumnCounted(mockCtrl *gomock.Controller, mockTx *proxy.MockTx, column string, count int) {
+	mockRows := proxy.NewMockRows(mockCtrl)
+	mockRows.EXPECT().Next().Return(true)
+	mockRows.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+		*dest[0].(*int) = count
+		return nil
+	})
+	mockRows.EXPECT().Close().Return(nil)
+	mockTx.EXPECT().QueryContext(gomock.Any(), CountColumnByNameIsQuery, column).Return(mockRows, nil)
+}
+
+// initializeJrpDB initializes the connection to the jrp database in the temporary directory.
+func initializeJrpDB(t *testing.T) database.ConnectionManager {
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+	connManager := database.NewConnectionManager(proxy.NewSql())
+	if err := connManager.InitializeConnection(database.ConnectionConfig{
+		DBType: database.SQLite,
+		DBName: database.JrpDB,
+		DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+	}); err != nil {
+		t.Errorf("Failed to initialize connection: %v", err)
+	}
+
+	return connManager
+}
+
+// cleanupJrpDB resets the connection to the jrp database and removes the database file.
+func cleanupJrpDB(t *testing.T) {
+	if err := database.ResetConnectionManager(); err != nil {
+		t.Errorf("Failed to reset connection manager: %v", err)
+	}
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+}
+
+func TestNewSchemaMigrator(t *testing.T) {
+	tests := []struct {
+		name string
+		want jrpApp.SchemaMigrator
+	}{
+		{
+			name: "positive testing",
+			want: &schemaMigrator{
+				connManager: database.GetConnectionManager(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSchemaMigrator(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSchemaMigrator() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_schemaMigrator_FindAll(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantApplied []bool
+		wantErr     bool
+		setup       func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup     func()
+	}{
+		{
+			name: "positive testing (no migrations applied)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantApplied: []bool{false, false, false, false, false, false, false},
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (all the migrations applied)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantApplied: []bool{true, true, true, true, true, true, true},
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+				if _, _, err := getJrpDB(context.Background(), tt.connManager); err != nil {
+					t.Errorf("Failed to migrate the database: %v", err)
+				}
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (openJrpDB() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantApplied: nil,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (findAppliedMigrations() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantApplied: nil,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, errors.New("DB.ExecContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			s := &schemaMigrator{
+				connManager: tt.fields.connManager,
+			}
+			got, err := s.FindAll(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("schemaMigrator.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var gotApplied []bool
+			for i, migration := range got {
+				if migration.Version != historyMigrations[i].version {
+					t.Errorf("schemaMigrator.FindAll() : got[%d].Version = %v, want %v", i, migration.Version, historyMigrations[i].version)
+				}
+				if migration.IsApplied == migration.AppliedAt.IsZero() {
+					t.Errorf("schemaMigrator.FindAll() : got[%d].AppliedAt = %v, IsApplied %v", i, migration.AppliedAt, migration.IsApplied)
+				}
+				gotApplied = append(gotApplied, migration.IsApplied)
+			}
+			if !reflect.DeepEqual(gotApplied, tt.wantApplied) {
+				t.Errorf("schemaMigrator.FindAll() : applied = %v, want %v", gotApplied, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func Test_schemaMigrator_Migrate(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		args         args
+		wantVersions []int
+		wantErr      bool
+		setup        func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup      func()
+	}{
+		{
+			name: "positive testing (no migrations applied)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantVersions: []int{1, 2, 3, 4, 5, 6, 7},
+			wantErr:      false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (all the migrations applied)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantVersions: nil,
+			wantErr:      false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+				if _, _, err := getJrpDB(context.Background(), tt.connManager); err != nil {
+					t.Errorf("Failed to migrate the database: %v", err)
+				}
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (the history table created before the migrations were introduced)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantVersions: []int{1, 2, 3, 4, 5, 6, 7},
+			wantErr:      false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+				conn, err := tt.connManager.GetConnection(database.JrpDB)
+				if err != nil {
+					t.Errorf("Failed to get connection: %v", err)
+				}
+				db, err := conn.Open()
+				if err != nil {
+					t.Errorf("Failed to open database: %v", err)
+				}
+				if _, err := db.ExecContext(
+					context.Background(),
+					"CREATE TABLE history (ID INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, Phrase TEXT NOT NULL, Reading TEXT, Romaji TEXT, WordIDs TEXT, Prefix TEXT, Suffix TEXT, IsFavorited INTEGER DEFAULT 0, CreatedAt TIMESTAMP, UpdatedAt TIMESTAMP);",
+				); err != nil {
+					t.Errorf("Failed to create the history table: %v", err)
+				}
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (openJrpDB() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantVersions: nil,
+			wantErr:      true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			s := &schemaMigrator{
+				connManager: tt.fields.connManager,
+			}
+			got, err := s.Migrate(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("schemaMigrator.Migrate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var gotVersions []int
+			for _, migration := range got {
+				gotVersions = append(gotVersions, migration.Version)
+			}
+			if !reflect.DeepEqual(gotVersions, tt.wantVersions) {
+				t.Errorf("schemaMigrator.Migrate() : versions = %v, want %v", gotVersions, tt.wantVersions)
+			}
+		})
+	}
+}
+
+func Test_findAppliedMigrations(t *testing.T) {
+	appliedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		ctx     context.Context
+		db      proxy.DB
+		dialect *historyDialect
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[int]*jrpApp.SchemaMigrationDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dialect: historyDialects[database.SQLite],
+			},
+			want: map[int]*jrpApp.SchemaMigrationDto{
+				1: {
+					Version:     1,
+					Description: "create the history table",
+					IsApplied:   true,
+					AppliedAt:   appliedAt,
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Next().Return(false)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+					*dest[0].(*int) = 1
+					*dest[1].(*string) = "create the history table"
+					*dest[2].(*time.Time) = appliedAt
+					return nil
+				})
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, nil)
+				mockDB.EXPECT().QueryContext(gomock.Any(), FindAllSchemaMigrationsQuery).Return(mockRows, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (db.ExecContext(ctx, CreateSchemaMigrationsQuery) failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dialect: historyDialects[database.SQLite],
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, errors.New("DB.ExecContext() failed"))
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindAllSchemaMigrationsQuery) failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dialect: historyDialects[database.SQLite],
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, nil)
+				mockDB.EXPECT().QueryContext(gomock.Any(), FindAllSchemaMigrationsQuery).Return(nil, errors.New("DB.QueryContext() failed"))
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dialect: historyDialects[database.SQLite],
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().ExecContext(gomock.Any(), CreateSchemaMigrationsQuery).Return(nil, nil)
+				mockDB.EXPECT().QueryContext(gomock.Any(), FindAllSchemaMigrationsQuery).Return(mockRows, nil)
+				tt.db = mockDB
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got, err := findAppliedMigrations(tt.args.ctx, tt.args.db, tt.args.dialect)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("findAppliedMigrations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findAppliedMigrations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyMigration(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		db      proxy.DB
+		dbType  database.DBType
+		dialect *historyDialect
+		m       *historyMigration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 0)
+				mockTx.EXPECT().ExecContext(gomock.Any(), AddReadingColumnQuery).Return(nil, nil)
+				expectColumnCounted(mockCtrl, mockTx, "Romaji", 0)
+				mockTx.EXPECT().ExecContext(gomock.Any(), AddRomajiColumnQuery).Return(nil, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), InsertSchemaMigrationQuery, 2, gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().Commit().Return(nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "positive testing (the columns already exist)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 1)
+				expectColumnCounted(mockCtrl, mockTx, "Romaji", 1)
+				mockTx.EXPECT().ExecContext(gomock.Any(), InsertSchemaMigrationQuery, 2, gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().Commit().Return(nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "positive testing (no queries for the type of the database)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.PostgreSQL,
+				dialect: historyDialects[database.PostgreSQL],
+				m:       historyMigrations[1],
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), historyDialects[database.PostgreSQL].rebind(InsertSchemaMigrationQuery), 2, gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().Commit().Return(nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (db.BeginTx() failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (hasColumn(ctx, tx, dialect, q.column) failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), CountColumnByNameIsQuery, "Reading").Return(nil, errors.New("Tx.QueryContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, q.query) failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 0)
+				mockTx.EXPECT().ExecContext(gomock.Any(), AddReadingColumnQuery).Return(nil, errors.New("Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, InsertSchemaMigrationQuery) failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 1)
+				expectColumnCounted(mockCtrl, mockTx, "Romaji", 1)
+				mockTx.EXPECT().ExecContext(gomock.Any(), InsertSchemaMigrationQuery, 2, gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+		{
+			name: "negative testing (tx.Commit() failed)",
+			args: args{
+				ctx:     context.Background(),
+				db:      nil,
+				dbType:  database.SQLite,
+				dialect: historyDialects[database.SQLite],
+				m:       historyMigrations[1],
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 1)
+				expectColumnCounted(mockCtrl, mockTx, "Romaji", 1)
+				mockTx.EXPECT().ExecContext(gomock.Any(), InsertSchemaMigrationQuery, 2, gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().Commit().Return(errors.New("Tx.Commit() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				tt.db = mockDB
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got, err := applyMigration(tt.args.ctx, tt.args.db, tt.args.dbType, tt.args.dialect, tt.args.m)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("applyMigration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.Version != tt.args.m.version || !got.IsApplied || got.AppliedAt.IsZero()) {
+				t.Errorf("applyMigration() = %v", got)
+			}
+		})
+	}
+}
+
+func Test_hasColumn(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		tx      proxy.Tx
+		dialect *historyDialect
+		column  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing (the column exists)",
+			args: args{
+				ctx:     context.Background(),
+				tx:      nil,
+				dialect: historyDialects[database.SQLite],
+				column:  "Reading",
+			},
+			want:    true,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 1)
+				tt.tx = mockTx
+			},
+		},
+		{
+			name: "positive testing (the column does not exist)",
+			args: args{
+				ctx:     context.Background(),
+				tx:      nil,
+				dialect: historyDialects[database.SQLite],
+				column:  "Reading",
+			},
+			want:    false,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				expectColumnCounted(mockCtrl, mockTx, "Reading", 0)
+				tt.tx = mockTx
+			},
+		},
+		{
+			name: "positive testing (PostgreSQL)",
+			args: args{
+				ctx:     context.Background(),
+				tx:      nil,
+				dialect: historyDialects[database.PostgreSQL],
+				column:  "DeletedAt",
+			},
+			want:    true,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+					*dest[0].(*int) = 1
+					return nil
+				})
+				mockRows.EXPECT().Close().Return(nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), historyDialects[database.PostgreSQL].rebind(PostgreSQLCountColumnByNameIsQuery), "DeletedAt").Return(mockRows, nil)
+				tt.tx = mockTx
+			},
+		},
+		{
+			name: "negative testing (tx.QueryContext() failed)",
+			args: args{
+				ctx:     context.Background(),
+				tx:      nil,
+				dialect: historyDialects[database.SQLite],
+				column:  "Reading",
+			},
+			want:    false,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), CountColumnByNameIsQuery, "Reading").Return(nil, errors.New("Tx.QueryContext() failed"))
+				tt.tx = mockTx
+			},
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			args: args{
+				ctx:     context.Background(),
+				tx:      nil,
+				dialect: historyDialects[database.SQLite],
+				column:  "Reading",
+			},
+			want:    false,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), CountColumnByNameIsQuery, "Reading").Return(mockRows, nil)
+				tt.tx = mockTx
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got, err := hasColumn(tt.args.ctx, tt.args.tx, tt.args.dialect, tt.args.column)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("hasColumn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("hasColumn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrateJrpDB(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		connManager database.ConnectionManager
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (called concurrently)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, connManager) failed)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed")).Times(3)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			errs := make(chan error, 3)
+			for i := 0; i < cap(errs); i++ {
+				go func() {
+					errs <- MigrateJrpDB(tt.args.ctx, tt.args.connManager)
+				}()
+			}
+			for i := 0; i < cap(errs); i++ {
+				if err := <-errs; (err != nil) != tt.wantErr {
+					t.Errorf("MigrateJrpDB() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+			if !tt.wantErr {
+				s := &schemaMigrator{
+					connManager: tt.args.connManager,
+				}
+				migrations, err := s.FindAll(tt.args.ctx)
+				if err != nil {
+					t.Errorf("Failed to find the migrations: %v", err)
+				}
+				for _, migration := range migrations {
+					if !migration.IsApplied {
+						t.Errorf("MigrateJrpDB() : migration %d is not applied", migration.Version)
+					}
+				}
+			}
+		})
+	}
+}
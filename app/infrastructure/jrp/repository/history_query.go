@@ -16,50 +16,100 @@ CREATE TABLE IF NOT EXISTS
     , UpdatedAt TIMESTAMP
   );
 `
-	// DeleteAllQuery is a query that deletes all from the history table.
-	DeleteAllQuery = `
-DELETE
+	// AddReadingColumnQuery is a query that adds the column of the reading to the history table.
+	AddReadingColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  Reading TEXT;
+`
+	// AddRomajiColumnQuery is a query that adds the column of the romaji of the reading to the history table.
+	AddRomajiColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  Romaji TEXT;
+`
+	// CountColumnByNameIsQuery is a query that counts the columns of the history table by the name.
+	CountColumnByNameIsQuery = `
+SELECT
+  COUNT(*)
 FROM
-  history;
+  pragma_table_info('history')
+WHERE
+  LOWER(name) = LOWER(?);
 `
-	// DeleteByIdInQuery is a query that deletes the records from the history table by ID in.
-	DeleteByIdInQuery = `
-DELETE
+	// AddWordIDsColumnQuery is a query that adds the column of the word IDs to the history table.
+	AddWordIDsColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  WordIDs TEXT;
+`
+	// AddDeletedAtColumnQuery is a query that adds the column of the timestamp when the record is moved to the trash to the history table.
+	AddDeletedAtColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  DeletedAt TIMESTAMP;
+`
+	// CountBySpecQuery is a query that counts the records in the history table by the conditions of the spec.
+	CountBySpecQuery = `
+SELECT
+  COUNT(*)
 FROM
   history
 WHERE
-  history.ID IN (%s);
+  %s;
 `
-	// DeleteByIdInAndIsFavoritedIsQuery is a query that deletes the records from the history table by ID in and is favorited is.
-	DeleteByIdInAndIsFavoritedIsQuery = `
-DELETE
-FROM
+	// DeleteAllQuery is a query that moves all the records in the history table to the trash.
+	DeleteAllQuery = `
+UPDATE
+  history
+SET
+  DeletedAt = ?
+WHERE
+  history.DeletedAt IS NULL;
+`
+	// DeleteByIdInQuery is a query that moves the records in the history table to the trash by ID in.
+	DeleteByIdInQuery = `
+UPDATE
   history
+SET
+  DeletedAt = ?
 WHERE
   history.ID IN (%s)
-  AND history.IsFavorited = ?;
+  AND history.DeletedAt IS NULL;
 `
-	// DeleteByIdInQuery is a query that deletes the records from the history table by ID in.
-	DeleteByIsFavoritedIsQuery = `
-DELETE
-FROM
+	// DeleteByIdInAndIsFavoritedIsQuery is a query that moves the records in the history table to the trash by ID in and is favorited is.
+	DeleteByIdInAndIsFavoritedIsQuery = `
+UPDATE
   history
+SET
+  DeletedAt = ?
 WHERE
-  history.IsFavorited = ?;
+  history.ID IN (%s)
+  AND history.IsFavorited = ?
+  AND history.DeletedAt IS NULL;
 `
-	// DeleteByIsFavoritedIsQuery is a query that deletes the records from the history table by is favorited.
-	DeleteSequenceQuery = `
-DELETE
-FROM
-  sqlite_sequence
+	// DeleteByIsFavoritedIsQuery is a query that moves the records in the history table to the trash by is favorited.
+	DeleteByIsFavoritedIsQuery = `
+UPDATE
+  history
+SET
+  DeletedAt = ?
 WHERE
-  sqlite_sequence.name = 'history';
+  history.IsFavorited = ?
+  AND history.DeletedAt IS NULL;
 `
 	// FindAllQuery is a query that finds all from the history table.
 	FindAllQuery = `
 SELECT
   history.ID
   , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
   , history.Prefix
   , history.Suffix
   , history.IsFavorited
@@ -67,14 +117,38 @@ SELECT
   , history.UpdatedAt
 FROM
   history
+WHERE
+  history.DeletedAt IS NULL
 ORDER BY
   history.ID ASC;
+`
+	// FindByIdIsQuery is a query that finds the record from the history table by ID is.
+	FindByIdIsQuery = `
+SELECT
+  history.ID
+  , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
+  , history.Prefix
+  , history.Suffix
+  , history.IsFavorited
+  , history.CreatedAt
+  , history.UpdatedAt
+FROM
+  history
+WHERE
+  history.ID = ?
+  AND history.DeletedAt IS NULL;
 `
 	// FindByIsFavoritedIsQuery is a query that finds the records from the history table by is favorited.
 	FindByIsFavoritedIsQuery = `
 SELECT
   history.ID
   , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
   , history.Prefix
   , history.Suffix
   , history.IsFavorited
@@ -84,6 +158,7 @@ FROM
   history
 WHERE
   history.IsFavorited = ?
+  AND history.DeletedAt IS NULL
 ORDER BY
   history.ID ASC;
 `
@@ -92,6 +167,9 @@ ORDER BY
 SELECT
   history.ID
   , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
   , history.Prefix
   , history.Suffix
   , history.IsFavorited
@@ -102,6 +180,7 @@ FROM
 WHERE
   (%s)
   AND history.IsFavorited = ?
+  AND history.DeletedAt IS NULL
 ORDER BY
   history.ID ASC;
 `
@@ -110,6 +189,9 @@ ORDER BY
 SELECT
   history.ID
   , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
   , history.Prefix
   , history.Suffix
   , history.IsFavorited
@@ -119,9 +201,70 @@ FROM
   history
 WHERE
   (%s)
+  AND history.DeletedAt IS NULL
 ORDER BY
   history.ID ASC;
 `
+	// FindByPhraseInQuery is a query that finds the records from the history table by phrase in.
+	FindByPhraseInQuery = `
+SELECT
+  history.ID
+  , history.Phrase
+  , history.Reading
+  , history.Romaji
+  , history.WordIDs
+  , history.Prefix
+  , history.Suffix
+  , history.IsFavorited
+  , history.CreatedAt
+  , history.UpdatedAt
+FROM
+  history
+WHERE
+  history.Phrase IN (%s)
+  AND history.DeletedAt IS NULL
+ORDER BY
+  history.ID ASC;
+`
+	// FindBySpecQuery is a query that finds the records from the history table by the conditions, the limit and the order of the spec.
+	// the limit is applied to the most recent records, and the order of the result is applied to them after that.
+	FindBySpecQuery = `
+SELECT
+  *
+FROM (
+  SELECT
+    history.ID
+    , history.Phrase
+    , history.Reading
+    , history.Romaji
+    , history.WordIDs
+    , history.Prefix
+    , history.Suffix
+    , history.IsFavorited
+    , history.CreatedAt
+    , history.UpdatedAt
+  FROM
+    history
+  WHERE
+    %s
+  ORDER BY
+    history.ID DESC%s
+) AS latest_records
+ORDER BY
+  %s;
+`
+	// SQLiteTimestampExpression is an expression that converts the timestamp column of SQLite to the julian day.
+	// the timestamps are stored like "2006-01-02 15:04:05.999999999 -0700 MST", so the offset is moved next to the time before the conversion.
+	SQLiteTimestampExpression = `julianday(
+      CASE
+        WHEN instr(substr(%[1]s, 20), ' ') > 0
+        THEN substr(%[1]s, 1, 18 + instr(substr(%[1]s, 20), ' '))
+          || substr(%[1]s, 20 + instr(substr(%[1]s, 20), ' '), 3)
+          || ':'
+          || substr(%[1]s, 23 + instr(substr(%[1]s, 20), ' '), 2)
+        ELSE %[1]s
+      END
+    )`
 	// FindTopNByIsFavoritedIsAndByOrderByIdAscQuery is a query that finds the top N records from the history table by is favorited order by ID ascending.
 	FindTopNByIsFavoritedIsAndByOrderByIdAscQuery = `
 SELECT
@@ -130,6 +273,9 @@ FROM (
   SELECT
     history.ID
     , history.Phrase
+    , history.Reading
+    , history.Romaji
+    , history.WordIDs
     , history.Prefix
     , history.Suffix
     , history.IsFavorited
@@ -139,6 +285,7 @@ FROM (
     history
   WHERE
     history.IsFavorited = ?
+    AND history.DeletedAt IS NULL
   ORDER BY
     history.ID DESC
   LIMIT ?
@@ -154,6 +301,9 @@ FROM (
   SELECT
     history.ID
     , history.Phrase
+    , history.Reading
+    , history.Romaji
+    , history.WordIDs
     , history.Prefix
     , history.Suffix
     , history.IsFavorited
@@ -164,6 +314,7 @@ FROM (
   WHERE
     (%s)
     AND history.IsFavorited = ?
+    AND history.DeletedAt IS NULL
   ORDER BY
     history.ID DESC
   LIMIT ?
@@ -179,6 +330,9 @@ FROM (
   SELECT
     history.ID
     , history.Phrase
+    , history.Reading
+    , history.Romaji
+    , history.WordIDs
     , history.Prefix
     , history.Suffix
     , history.IsFavorited
@@ -186,6 +340,8 @@ FROM (
     , history.UpdatedAt
   FROM
     history
+  WHERE
+    history.DeletedAt IS NULL
   ORDER BY
     history.ID DESC
   LIMIT ?
@@ -201,6 +357,9 @@ FROM (
   SELECT
     history.ID
     , history.Phrase
+    , history.Reading
+    , history.Romaji
+    , history.WordIDs
     , history.Prefix
     , history.Suffix
     , history.IsFavorited
@@ -210,6 +369,7 @@ FROM (
     history
   WHERE
     (%s)
+    AND history.DeletedAt IS NULL
   ORDER BY
     history.ID DESC
   LIMIT ?
@@ -222,6 +382,9 @@ ORDER BY
 INSERT INTO
   history (
     Phrase
+    , Reading
+    , Romaji
+    , WordIDs
     , Prefix
     , Suffix
     , IsFavorited
@@ -236,7 +399,8 @@ UPDATE
 SET
   IsFavorited = ?
 WHERE
-  history.ID IN (%s);
+  history.ID IN (%s)
+  AND history.DeletedAt IS NULL;
 `
 	// UpdateIsFavoritedByIsFavoritedIsQuery is a query that updates the is favorited by is favorited.
 	UpdateIsFavoritedByIsFavoritedIsQuery = `
@@ -245,6 +409,445 @@ UPDATE
 SET
   IsFavorited = ?
 WHERE
-  history.IsFavorited = ?;
+  history.IsFavorited = ?
+  AND history.DeletedAt IS NULL;
+`
+	// RestoreAllQuery is a query that restores all the records in the trash of the history table.
+	RestoreAllQuery = `
+UPDATE
+  history
+SET
+  DeletedAt = NULL
+WHERE
+  history.DeletedAt IS NOT NULL;
+`
+	// RestoreByIdInQuery is a query that restores the records in the trash of the history table by ID in.
+	RestoreByIdInQuery = `
+UPDATE
+  history
+SET
+  DeletedAt = NULL
+WHERE
+  history.ID IN (%s)
+  AND history.DeletedAt IS NOT NULL;
+`
+	// PurgeAllQuery is a query that deletes all the records in the trash of the history table.
+	PurgeAllQuery = `
+DELETE
+FROM
+  history
+WHERE
+  history.DeletedAt IS NOT NULL;
+`
+	// PurgeByIdInQuery is a query that deletes the records in the trash of the history table by ID in.
+	PurgeByIdInQuery = `
+DELETE
+FROM
+  history
+WHERE
+  history.ID IN (%s)
+  AND history.DeletedAt IS NOT NULL;
+`
+	// PostgreSQLCreateQuery is a query that creates a table history in PostgreSQL.
+	PostgreSQLCreateQuery = `
+CREATE TABLE IF NOT EXISTS
+  history (
+    ID SERIAL NOT NULL PRIMARY KEY
+    , Phrase TEXT NOT NULL
+    , Reading TEXT
+    , Romaji TEXT
+    , WordIDs TEXT
+    , Prefix TEXT
+    , Suffix TEXT
+    , IsFavorited INTEGER DEFAULT 0
+    , CreatedAt TIMESTAMPTZ
+    , UpdatedAt TIMESTAMPTZ
+  );
+`
+	// PostgreSQLAddDeletedAtColumnQuery is a query that adds the column of the timestamp when the record is moved to the trash to the history table in PostgreSQL.
+	PostgreSQLAddDeletedAtColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  DeletedAt TIMESTAMPTZ;
+`
+	// PostgreSQLCountColumnByNameIsQuery is a query that counts the columns of the history table by the name in PostgreSQL.
+	PostgreSQLCountColumnByNameIsQuery = `
+SELECT
+  COUNT(*)
+FROM
+  information_schema.columns
+WHERE
+  table_schema = current_schema()
+  AND table_name = 'history'
+  AND LOWER(column_name) = LOWER(?);
+`
+	// PostgreSQLInsertQuery is a query that inserts records into the history table and returns their IDs in PostgreSQL.
+	PostgreSQLInsertQuery = `
+INSERT INTO
+  history (
+    Phrase
+    , Reading
+    , Romaji
+    , WordIDs
+    , Prefix
+    , Suffix
+    , IsFavorited
+    , CreatedAt
+    , UpdatedAt
+  ) VALUES %s
+RETURNING
+  ID;
+`
+	// MySQLCreateQuery is a query that creates a table history in MySQL.
+	MySQLCreateQuery = `
+CREATE TABLE IF NOT EXISTS
+  history (
+    ID INTEGER NOT NULL PRIMARY KEY AUTO_INCREMENT
+    , Phrase TEXT NOT NULL
+    , Reading TEXT
+    , Romaji TEXT
+    , WordIDs TEXT
+    , Prefix TEXT
+    , Suffix TEXT
+    , IsFavorited INTEGER DEFAULT 0
+    , CreatedAt DATETIME(6)
+    , UpdatedAt DATETIME(6)
+  );
+`
+	// MySQLAddDeletedAtColumnQuery is a query that adds the column of the timestamp when the record is moved to the trash to the history table in MySQL.
+	MySQLAddDeletedAtColumnQuery = `
+ALTER TABLE
+  history
+ADD COLUMN
+  DeletedAt DATETIME(6);
+`
+	// MySQLCountColumnByNameIsQuery is a query that counts the columns of the history table by the name in MySQL.
+	MySQLCountColumnByNameIsQuery = `
+SELECT
+  COUNT(*)
+FROM
+  information_schema.columns
+WHERE
+  table_schema = DATABASE()
+  AND table_name = 'history'
+  AND LOWER(column_name) = LOWER(?);
+`
+	// CreateTagQuery is a query that creates a table history_tag.
+	CreateTagQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_tag (
+    HistoryID INTEGER NOT NULL
+    , Name TEXT NOT NULL
+    , PRIMARY KEY (HistoryID, Name)
+  );
+`
+	// CreateNoteQuery is a query that creates a table history_note.
+	CreateNoteQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_note (
+    HistoryID INTEGER NOT NULL PRIMARY KEY
+    , Note TEXT NOT NULL
+    , UpdatedAt TIMESTAMP
+  );
+`
+	// DeleteOrphanTagsQuery is a query that deletes the records from the history_tag table tagging no existing histories.
+	DeleteOrphanTagsQuery = `
+DELETE
+FROM
+  history_tag
+WHERE
+  history_tag.HistoryID NOT IN (
+    SELECT
+      history.ID
+    FROM
+      history
+  );
+`
+	// DeleteOrphanNotesQuery is a query that deletes the records from the history_note table written on no existing histories.
+	DeleteOrphanNotesQuery = `
+DELETE
+FROM
+  history_note
+WHERE
+  history_note.HistoryID NOT IN (
+    SELECT
+      history.ID
+    FROM
+      history
+  );
+`
+	// DeleteNoteByIdIsQuery is a query that deletes the record from the history_note table by history ID is.
+	DeleteNoteByIdIsQuery = `
+DELETE
+FROM
+  history_note
+WHERE
+  history_note.HistoryID = ?;
+`
+	// DeleteTagByNameIsAndIdInQuery is a query that deletes the records from the history_tag table by name is and history ID in.
+	DeleteTagByNameIsAndIdInQuery = `
+DELETE
+FROM
+  history_tag
+WHERE
+  history_tag.Name = ?
+  AND history_tag.HistoryID IN (%s);
+`
+	// FindAllTagsQuery is a query that finds all from the history_tag table tagging the existing histories.
+	FindAllTagsQuery = `
+SELECT
+  history_tag.HistoryID
+  , history_tag.Name
+FROM
+  history_tag
+  INNER JOIN history
+    ON history.ID = history_tag.HistoryID
+WHERE
+  history.DeletedAt IS NULL
+ORDER BY
+  history_tag.Name ASC
+  , history_tag.HistoryID ASC;
+`
+	// FindNoteByIdIsQuery is a query that finds the record from the history_note table by history ID is.
+	FindNoteByIdIsQuery = `
+SELECT
+  history_note.HistoryID
+  , history_note.Note
+  , history_note.UpdatedAt
+FROM
+  history_note
+  INNER JOIN history
+    ON history.ID = history_note.HistoryID
+WHERE
+  history_note.HistoryID = ?
+  AND history.DeletedAt IS NULL;
+`
+	// FindTagByNameIsQuery is a query that finds the records from the history_tag table by name is.
+	FindTagByNameIsQuery = `
+SELECT
+  history_tag.HistoryID
+  , history_tag.Name
+FROM
+  history_tag
+  INNER JOIN history
+    ON history.ID = history_tag.HistoryID
+WHERE
+  history_tag.Name = ?
+  AND history.DeletedAt IS NULL
+ORDER BY
+  history_tag.HistoryID ASC;
+`
+	// InsertNoteByIdIsQuery is a query that inserts the record into the history_note table if the history exists.
+	InsertNoteByIdIsQuery = `
+INSERT INTO
+  history_note (
+    HistoryID
+    , Note
+    , UpdatedAt
+  )
+SELECT
+  history.ID
+  , ?
+  , ?
+FROM
+  history
+WHERE
+  history.ID = ?
+  AND history.DeletedAt IS NULL;
+`
+	// InsertTagByIdInQuery is a query that inserts the records into the history_tag table for the existing histories not tagged yet.
+	InsertTagByIdInQuery = `
+INSERT INTO
+  history_tag (
+    HistoryID
+    , Name
+  )
+SELECT
+  history.ID
+  , ?
+FROM
+  history
+WHERE
+  history.ID IN (%s)
+  AND history.DeletedAt IS NULL
+  AND NOT EXISTS (
+    SELECT
+      1
+    FROM
+      history_tag
+    WHERE
+      history_tag.HistoryID = history.ID
+      AND history_tag.Name = ?
+  );
+`
+	// PostgreSQLCreateNoteQuery is a query that creates a table history_note in PostgreSQL.
+	PostgreSQLCreateNoteQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_note (
+    HistoryID INTEGER NOT NULL PRIMARY KEY
+    , Note TEXT NOT NULL
+    , UpdatedAt TIMESTAMPTZ
+  );
+`
+	// MySQLCreateTagQuery is a query that creates a table history_tag in MySQL.
+	MySQLCreateTagQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_tag (
+    HistoryID INTEGER NOT NULL
+    , Name VARCHAR(255) NOT NULL
+    , PRIMARY KEY (HistoryID, Name)
+  );
+`
+	// MySQLCreateNoteQuery is a query that creates a table history_note in MySQL.
+	MySQLCreateNoteQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_note (
+    HistoryID INTEGER NOT NULL PRIMARY KEY
+    , Note TEXT NOT NULL
+    , UpdatedAt DATETIME(6)
+  );
+`
+	// CreateFullTextSearchQuery is a query that creates a full-text search table history_fts of the phrases in the history table with the trigram tokenizer in SQLite.
+	CreateFullTextSearchQuery = `
+CREATE VIRTUAL TABLE IF NOT EXISTS
+  history_fts
+USING fts5 (
+  Phrase
+  , content = 'history'
+  , content_rowid = 'ID'
+  , tokenize = 'trigram'
+);
+`
+	// CreateFullTextSearchInsertTriggerQuery is a query that creates a trigger adding the inserted phrase into the table history_fts in SQLite.
+	CreateFullTextSearchInsertTriggerQuery = `
+CREATE TRIGGER IF NOT EXISTS
+  history_fts_insert
+AFTER INSERT ON
+  history
+BEGIN
+  INSERT INTO
+    history_fts (
+      rowid
+      , Phrase
+    ) VALUES (new.ID, new.Phrase);
+END;
+`
+	// CreateFullTextSearchDeleteTriggerQuery is a query that creates a trigger removing the deleted phrase from the table history_fts in SQLite.
+	CreateFullTextSearchDeleteTriggerQuery = `
+CREATE TRIGGER IF NOT EXISTS
+  history_fts_delete
+AFTER DELETE ON
+  history
+BEGIN
+  INSERT INTO
+    history_fts (
+      history_fts
+      , rowid
+      , Phrase
+    ) VALUES ('delete', old.ID, old.Phrase);
+END;
+`
+	// CreateFullTextSearchUpdateTriggerQuery is a query that creates a trigger replacing the updated phrase in the table history_fts in SQLite.
+	CreateFullTextSearchUpdateTriggerQuery = `
+CREATE TRIGGER IF NOT EXISTS
+  history_fts_update
+AFTER UPDATE OF Phrase ON
+  history
+BEGIN
+  INSERT INTO
+    history_fts (
+      history_fts
+      , rowid
+      , Phrase
+    ) VALUES ('delete', old.ID, old.Phrase);
+  INSERT INTO
+    history_fts (
+      rowid
+      , Phrase
+    ) VALUES (new.ID, new.Phrase);
+END;
+`
+	// RebuildFullTextSearchQuery is a query that rebuilds the table history_fts from the phrases already in the history table in SQLite.
+	RebuildFullTextSearchQuery = `
+INSERT INTO
+  history_fts (
+    history_fts
+  ) VALUES ('rebuild');
+`
+	// SQLiteFullTextSearchCondition is a condition that matches the histories by the full-text search table history_fts in SQLite.
+	SQLiteFullTextSearchCondition = `history.ID IN (
+  SELECT
+    history_fts.rowid
+  FROM
+    history_fts
+  WHERE
+    history_fts MATCH ?
+)`
+	// CreateUnfavoritedQuery is a query that creates a table history_unfavorited recording the histories unfavorited at once to undo it.
+	CreateUnfavoritedQuery = `
+CREATE TABLE IF NOT EXISTS
+  history_unfavorited (
+    HistoryID INTEGER NOT NULL PRIMARY KEY
+  );
+`
+	// DeleteAllUnfavoritedQuery is a query that deletes all the records from the history_unfavorited table.
+	DeleteAllUnfavoritedQuery = `
+DELETE
+FROM
+  history_unfavorited;
+`
+	// InsertUnfavoritedByIsFavoritedIsQuery is a query that inserts the IDs of the favorited records of the history table into the history_unfavorited table.
+	InsertUnfavoritedByIsFavoritedIsQuery = `
+INSERT INTO
+  history_unfavorited (
+    HistoryID
+  )
+SELECT
+  history.ID
+FROM
+  history
+WHERE
+  history.IsFavorited = 1
+  AND history.DeletedAt IS NULL;
+`
+	// UpdateIsFavoritedByUnfavoritedQuery is a query that favorites the records of the history table recorded in the history_unfavorited table.
+	UpdateIsFavoritedByUnfavoritedQuery = `
+UPDATE
+  history
+SET
+  IsFavorited = 1
+WHERE
+  history.ID IN (SELECT history_unfavorited.HistoryID FROM history_unfavorited)
+  AND history.DeletedAt IS NULL;
+`
+	// CreateSchemaMigrationsQuery is a query that creates a table schema_migrations recording the applied migrations.
+	CreateSchemaMigrationsQuery = `
+CREATE TABLE IF NOT EXISTS
+  schema_migrations (
+    Version INTEGER NOT NULL PRIMARY KEY
+    , Description TEXT
+    , AppliedAt TIMESTAMP
+  );
+`
+	// FindAllSchemaMigrationsQuery is a query that finds all from the schema_migrations table.
+	FindAllSchemaMigrationsQuery = `
+SELECT
+  schema_migrations.Version
+  , schema_migrations.Description
+  , schema_migrations.AppliedAt
+FROM
+  schema_migrations
+ORDER BY
+  schema_migrations.Version ASC;
+`
+	// InsertSchemaMigrationQuery is a query that inserts a record into the schema_migrations table.
+	InsertSchemaMigrationQuery = `
+INSERT INTO
+  schema_migrations (
+    Version
+    , Description
+    , AppliedAt
+  ) VALUES (?, ?, ?);
 `
 )
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/yanosea/jrp/v2/app/domain/jrp/history"
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
@@ -12,6 +14,11 @@ import (
 	"github.com/yanosea/jrp/v2/pkg/proxy"
 )
 
+const (
+	// saveAllBatchSize is the number of the histories inserted with one query not to exceed the limit of the placeholders.
+	saveAllBatchSize = 500
+)
+
 // HistoryRepository is a struct that implements the HistoryRepository interface.
 type historyRepository struct {
 	connManager database.ConnectionManager
@@ -24,68 +31,78 @@ func NewHistoryRepository() history.HistoryRepository {
 	}
 }
 
-// DeleteAll is a method that removes all the jrps from the history table.
-func (h *historyRepository) DeleteAll(ctx context.Context) (int, error) {
+// CountBySpec is a method that counts the jrps in the history table matching the conditions of the spec.
+// the number, the offset and the cursor of the spec are ignored.
+func (h *historyRepository) CountBySpec(ctx context.Context, spec *history.HistorySpec) (int, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
 
-	tx, err := db.BeginTx(
-		ctx,
-		&sql.TxOptions{
-			Isolation: sql.LevelSerializable,
-			ReadOnly:  false,
-		},
-	)
+	conditions, args := specConditions(dialect, spec)
+	query := fmt.Sprintf(CountBySpecQuery, strings.Join(conditions, "\n  AND "))
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
 	if err != nil {
 		return 0, err
 	}
 	defer func() {
-		deferErr = tx.Rollback()
+		deferErr = rows.Close()
 	}()
 
-	var result proxy.Result
-	if result, err = tx.ExecContext(ctx, DeleteAllQuery); err != nil {
-		return 0, err
+	count := 0
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, err
+		}
 	}
-	if _, err := tx.ExecContext(ctx, DeleteSequenceQuery); err != nil {
+
+	return count, deferErr
+}
+
+// DeleteAll is a method that moves all the jrps in the history table to the trash.
+func (h *historyRepository) DeleteAll(ctx context.Context) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
 		return 0, err
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(DeleteAllQuery), time.Now()); err != nil {
 		return 0, err
 	}
 
-	if err := tx.Commit(); err != nil {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
 		return 0, err
 	}
 
 	return int(rowsAffected), deferErr
 }
 
-// DeleteByIdIn is a method that removes the jrps from the history table by ID in.
+// DeleteByIdIn is a method that moves the jrps in the history table to the trash by ID in.
 func (h *historyRepository) DeleteByIdIn(ctx context.Context, ids []int) (int, error) {
 	var deferErr error
 	if len(ids) == 0 {
 		return 0, nil
 	}
 
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
 
-	args := make([]interface{}, 0, len(ids))
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, time.Now())
 	for _, id := range ids {
 		args = append(args, id)
 	}
 	query := fmt.Sprintf(DeleteByIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
 
 	var result proxy.Result
-	if result, err = db.ExecContext(ctx, query, args...); err != nil {
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
 		return 0, err
 	}
 
@@ -97,7 +114,7 @@ func (h *historyRepository) DeleteByIdIn(ctx context.Context, ids []int) (int, e
 	return int(rowsAffected), deferErr
 }
 
-// DeleteByIdInAndIsFavoritedIs is a method that removes the jrps from the history table by ID in and is favorited is.
+// DeleteByIdInAndIsFavoritedIs is a method that moves the jrps in the history table to the trash by ID in and is favorited is.
 func (h *historyRepository) DeleteByIdInAndIsFavoritedIs(
 	ctx context.Context,
 	ids []int,
@@ -108,7 +125,7 @@ func (h *historyRepository) DeleteByIdInAndIsFavoritedIs(
 		return 0, nil
 	}
 
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
@@ -118,14 +135,15 @@ func (h *historyRepository) DeleteByIdInAndIsFavoritedIs(
 		placeholders[i] = "?"
 	}
 	query := fmt.Sprintf(DeleteByIdInAndIsFavoritedIsQuery, strings.Join(placeholders, ","))
-	args := make([]interface{}, len(ids)+1)
+	args := make([]interface{}, len(ids)+2)
+	args[0] = time.Now()
 	for i, id := range ids {
-		args[i] = id
+		args[i+1] = id
 	}
-	args[len(ids)] = isFavorited
+	args[len(ids)+1] = isFavorited
 
 	var result proxy.Result
-	if result, err = db.ExecContext(ctx, query, args...); err != nil {
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
 		return 0, err
 	}
 
@@ -137,16 +155,73 @@ func (h *historyRepository) DeleteByIdInAndIsFavoritedIs(
 	return int(rowsAffected), deferErr
 }
 
-// DeleteByIsFavoritedIs is a method that removes the jrps from the history table by is favorited.
+// DeleteByIsFavoritedIs is a method that moves the jrps in the history table to the trash by is favorited.
 func (h *historyRepository) DeleteByIsFavoritedIs(ctx context.Context, isFavorited int) (int, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(DeleteByIsFavoritedIsQuery), time.Now(), isFavorited); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// DeleteNoteByIdIs is a method that removes the note from the history_note table by history ID is.
+func (h *historyRepository) DeleteNoteByIdIs(ctx context.Context, id int) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(DeleteNoteByIdIsQuery), id); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// DeleteTagByNameIsAndIdIn is a method that removes the tag from the history_tag table by name is and history ID in.
+func (h *historyRepository) DeleteTagByNameIsAndIdIn(
+	ctx context.Context,
+	name string,
+	ids []int,
+) (int, error) {
+	var deferErr error
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
 
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, name)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf(DeleteTagByNameIsAndIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
+
 	var result proxy.Result
-	if result, err = db.ExecContext(ctx, DeleteByIsFavoritedIsQuery, isFavorited); err != nil {
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
 		return 0, err
 	}
 
@@ -161,12 +236,12 @@ func (h *historyRepository) DeleteByIsFavoritedIs(ctx context.Context, isFavorit
 // FindAll is a method that finds all the jrps from the history table.
 func (h *historyRepository) FindAll(ctx context.Context) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.QueryContext(ctx, FindAllQuery)
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindAllQuery))
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +255,9 @@ func (h *historyRepository) FindAll(ctx context.Context) ([]*history.History, er
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -194,15 +272,85 @@ func (h *historyRepository) FindAll(ctx context.Context) ([]*history.History, er
 	return histories, deferErr
 }
 
+// FindAllTags is a method that finds all the tags of the histories from the history_tag table.
+func (h *historyRepository) FindAllTags(ctx context.Context) ([]*history.Tag, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindAllTagsQuery))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	tags := []*history.Tag{}
+	for rows.Next() {
+		tag := &history.Tag{}
+		if err := rows.Scan(
+			&tag.HistoryID,
+			&tag.Name,
+		); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, deferErr
+}
+
+// FindByIdIs is a method that finds the jrp from the history table by ID is.
+func (h *historyRepository) FindByIdIs(ctx context.Context, id int) (*history.History, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindByIdIsQuery), id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	var found *history.History
+	for rows.Next() {
+		history := &history.History{}
+		if err := rows.Scan(
+			&history.ID,
+			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
+			&history.Prefix,
+			&history.Suffix,
+			&history.IsFavorited,
+			&history.CreatedAt,
+			&history.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		found = history
+	}
+
+	return found, deferErr
+}
+
 // FindByIsFavoritedIs is a method that finds the jrps from the history table by is favorited.
 func (h *historyRepository) FindByIsFavoritedIs(ctx context.Context, isFavorited int) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.QueryContext(ctx, FindByIsFavoritedIsQuery, isFavorited)
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindByIsFavoritedIsQuery), isFavorited)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +364,9 @@ func (h *historyRepository) FindByIsFavoritedIs(ctx context.Context, isFavorited
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -238,7 +389,7 @@ func (h *historyRepository) FindByIsFavoritedIsAndPhraseContains(
 	isFavorited int,
 ) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +411,7 @@ func (h *historyRepository) FindByIsFavoritedIsAndPhraseContains(
 	args = append(args, isFavorited)
 	query := fmt.Sprintf(FindByIsFavoritedIsAndPhraseContainsQuery, whereClause)
 
-	rows, err := db.QueryContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -274,6 +425,9 @@ func (h *historyRepository) FindByIsFavoritedIsAndPhraseContains(
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -295,7 +449,7 @@ func (h *historyRepository) FindByPhraseContains(
 	and bool,
 ) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +470,132 @@ func (h *historyRepository) FindByPhraseContains(
 	}
 	query := fmt.Sprintf(FindByPhraseContainsQuery, whereClause)
 
-	rows, err := db.QueryContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	histories := []*history.History{}
+	for rows.Next() {
+		history := &history.History{}
+		if err := rows.Scan(
+			&history.ID,
+			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
+			&history.Prefix,
+			&history.Suffix,
+			&history.IsFavorited,
+			&history.CreatedAt,
+			&history.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, deferErr
+}
+
+// FindByPhraseIn is a method that finds the jrps from the history table by phrase in.
+func (h *historyRepository) FindByPhraseIn(ctx context.Context, phrases []string) ([]*history.History, error) {
+	var deferErr error
+	if len(phrases) == 0 {
+		return []*history.History{}, nil
+	}
+
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]interface{}, 0, len(phrases))
+	for _, phrase := range phrases {
+		args = append(args, phrase)
+	}
+	query := fmt.Sprintf(FindByPhraseInQuery, strings.Trim(strings.Repeat("?,", len(phrases)), ","))
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	histories := []*history.History{}
+	for rows.Next() {
+		history := &history.History{}
+		if err := rows.Scan(
+			&history.ID,
+			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
+			&history.Prefix,
+			&history.Suffix,
+			&history.IsFavorited,
+			&history.CreatedAt,
+			&history.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, deferErr
+}
+
+// FindBySpec is a method that finds the jrps from the history table by the spec.
+func (h *historyRepository) FindBySpec(ctx context.Context, spec *history.HistorySpec) ([]*history.History, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	direction := "ASC"
+	if spec.Desc {
+		direction = "DESC"
+	}
+	// the histories are taken from the most recent ones whatever the sort key is, and they are sorted after that.
+	var orderClause string
+	switch spec.SortBy {
+	case "", history.SortById:
+		orderClause = fmt.Sprintf("latest_records.ID %s", direction)
+	case history.SortByCreatedAt:
+		orderClause = fmt.Sprintf("%s %[2]s, latest_records.ID %[2]s", dialect.timestamp("latest_records.CreatedAt"), direction)
+	case history.SortByPhrase:
+		orderClause = fmt.Sprintf("latest_records.Phrase %[1]s, latest_records.ID %[1]s", direction)
+	default:
+		return nil, errors.New("unsupported sort key : " + string(spec.SortBy))
+	}
+
+	conditions, args := specConditions(dialect, spec)
+	if spec.Cursor > 0 {
+		if spec.SortBy != "" && spec.SortBy != history.SortById {
+			return nil, errors.New("cursor is not supported for sort key : " + string(spec.SortBy))
+		}
+		conditions = append(conditions, "history.ID < ?")
+		args = append(args, spec.Cursor)
+	}
+	whereClause := strings.Join(conditions, "\n    AND ")
+	limitClause := ""
+	if spec.Number > 0 {
+		limitClause = "\n  LIMIT ?"
+		args = append(args, spec.Number)
+		if spec.Offset > 0 {
+			limitClause += " OFFSET ?"
+			args = append(args, spec.Offset)
+		}
+	}
+	query := fmt.Sprintf(FindBySpecQuery, whereClause, limitClause, orderClause)
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -330,6 +609,9 @@ func (h *historyRepository) FindByPhraseContains(
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -344,6 +626,69 @@ func (h *historyRepository) FindByPhraseContains(
 	return histories, deferErr
 }
 
+// FindNoteByIdIs is a method that finds the note from the history_note table by history ID is.
+func (h *historyRepository) FindNoteByIdIs(ctx context.Context, id int) (*history.Note, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindNoteByIdIsQuery), id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	var found *history.Note
+	for rows.Next() {
+		note := &history.Note{}
+		if err := rows.Scan(
+			&note.HistoryID,
+			&note.Note,
+			&note.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		found = note
+	}
+
+	return found, deferErr
+}
+
+// FindTagByNameIs is a method that finds the tags from the history_tag table by name is.
+func (h *historyRepository) FindTagByNameIs(ctx context.Context, name string) ([]*history.Tag, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindTagByNameIsQuery), name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	tags := []*history.Tag{}
+	for rows.Next() {
+		tag := &history.Tag{}
+		if err := rows.Scan(
+			&tag.HistoryID,
+			&tag.Name,
+		); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, deferErr
+}
+
 // FindTopNByIsFavoritedIsAndByOrderByIdAsc is a method that finds the top N jrps from the history table by is favorited order by ID ascending.
 func (h *historyRepository) FindTopNByIsFavoritedIsAndByOrderByIdAsc(
 	ctx context.Context,
@@ -351,12 +696,12 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByOrderByIdAsc(
 	isFavorited int,
 ) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.QueryContext(ctx, FindTopNByIsFavoritedIsAndByOrderByIdAscQuery, isFavorited, number)
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindTopNByIsFavoritedIsAndByOrderByIdAscQuery), isFavorited, number)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +715,9 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByOrderByIdAsc(
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -393,7 +741,7 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 	isFavorited int,
 ) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +763,7 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 	args = append(args, isFavorited, number)
 	query := fmt.Sprintf(FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAscQuery, whereClause)
 
-	rows, err := db.QueryContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -429,6 +777,9 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -446,12 +797,12 @@ func (h *historyRepository) FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 // FindTopNByOrderByIdAsc is a method that finds the top N jrps from the history table by order by ID ascending.
 func (h *historyRepository) FindTopNByOrderByIdAsc(ctx context.Context, number int) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.QueryContext(ctx, FindTopNByOrderByIdAscQuery, number)
+	rows, err := db.QueryContext(ctx, dialect.rebind(FindTopNByOrderByIdAscQuery), number)
 	if err != nil {
 		return nil, err
 	}
@@ -465,6 +816,9 @@ func (h *historyRepository) FindTopNByOrderByIdAsc(ctx context.Context, number i
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -487,7 +841,7 @@ func (h *historyRepository) FindTopNByPhraseContainsOrderByIdAsc(
 	number int,
 ) ([]*history.History, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
@@ -509,7 +863,7 @@ func (h *historyRepository) FindTopNByPhraseContainsOrderByIdAsc(
 	args = append(args, number)
 	query := fmt.Sprintf(FindTopNByPhraseContainsOrderByIdAscQuery, whereClause)
 
-	rows, err := db.QueryContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, dialect.rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -523,6 +877,9 @@ func (h *historyRepository) FindTopNByPhraseContainsOrderByIdAsc(
 		if err := rows.Scan(
 			&history.ID,
 			&history.Phrase,
+			&history.Reading,
+			&history.Romaji,
+			&history.WordIDs,
 			&history.Prefix,
 			&history.Suffix,
 			&history.IsFavorited,
@@ -537,31 +894,85 @@ func (h *historyRepository) FindTopNByPhraseContainsOrderByIdAsc(
 	return histories, deferErr
 }
 
-// SaveAll is a method that saves all the jrp to the history table.
-func (h *historyRepository) SaveAll(ctx context.Context, jrps []*history.History) ([]*history.History, error) {
-	if len(jrps) == 0 {
-		return jrps, nil
+// PurgeAll is a method that deletes all the jrps in the trash of the history table with their tags and notes.
+func (h *historyRepository) PurgeAll(ctx context.Context) (int, error) {
+	return h.purge(ctx, PurgeAllQuery, nil)
+}
+
+// PurgeByIdIn is a method that deletes the jrps in the trash of the history table by ID in with their tags and notes.
+func (h *historyRepository) PurgeByIdIn(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
 	}
 
-	valueStrings := make([]string, 0, len(jrps))
-	valueArgs := make([]interface{}, 0, len(jrps)*6)
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf(PurgeByIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
 
-	for _, jrp := range jrps {
-		valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?)")
-		valueArgs = append(valueArgs,
-			jrp.Phrase,
-			jrp.Prefix,
-			jrp.Suffix,
-			jrp.IsFavorited,
-			jrp.CreatedAt,
-			jrp.UpdatedAt,
-		)
+	return h.purge(ctx, query, args)
+}
+
+// RestoreAll is a method that restores all the jrps in the trash of the history table.
+func (h *historyRepository) RestoreAll(ctx context.Context) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(RestoreAllQuery)); err != nil {
+		return 0, err
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// RestoreByIdIn is a method that restores the jrps in the trash of the history table by ID in.
+func (h *historyRepository) RestoreByIdIn(ctx context.Context, ids []int) (int, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf(RestoreByIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
+
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// RestoreFavorites is a method that favorites again the jrps unfavorited by UnfavoriteAll last time.
+// the record of them is cleared, so they can be restored only once.
+func (h *historyRepository) RestoreFavorites(ctx context.Context) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
 	}
 
 	tx, err := db.BeginTx(
@@ -572,26 +983,64 @@ func (h *historyRepository) SaveAll(ctx context.Context, jrps []*history.History
 		},
 	)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 	defer func() {
 		deferErr = tx.Rollback()
 	}()
 
-	query := fmt.Sprintf(InsertQuery, strings.Join(valueStrings, ","))
-	result, err := tx.ExecContext(ctx, query, valueArgs...)
+	var result proxy.Result
+	if result, err = tx.ExecContext(ctx, dialect.rebind(UpdateIsFavoritedByUnfavoritedQuery)); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, DeleteAllUnfavoritedQuery); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// SaveAll is a method that saves all the jrp to the history table.
+// the jrps are inserted in the batches not to exceed the limit of the placeholders, but all in one transaction.
+func (h *historyRepository) SaveAll(ctx context.Context, jrps []*history.History) ([]*history.History, error) {
+	if len(jrps) == 0 {
+		return jrps, nil
+	}
+
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return nil, err
 	}
 
-	lastID, err := result.LastInsertId()
+	tx, err := db.BeginTx(
+		ctx,
+		&sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+			ReadOnly:  false,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
-	firstID := lastID - int64(len(jrps)) + 1
+	defer func() {
+		deferErr = tx.Rollback()
+	}()
 
-	for i, jrp := range jrps {
-		jrp.ID = int(firstID) + i
+	for start := 0; start < len(jrps); start += saveAllBatchSize {
+		end := min(start+saveAllBatchSize, len(jrps))
+		if err := insertHistories(ctx, tx, dialect, jrps[start:end]); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -601,6 +1050,143 @@ func (h *historyRepository) SaveAll(ctx context.Context, jrps []*history.History
 	return jrps, deferErr
 }
 
+// SaveNote is a method that saves the note to the history_note table replacing the existing one.
+// it returns 0 if the history to note does not exist.
+func (h *historyRepository) SaveNote(ctx context.Context, note *history.Note) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(
+		ctx,
+		&sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+			ReadOnly:  false,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		deferErr = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, dialect.rebind(DeleteNoteByIdIsQuery), note.HistoryID); err != nil {
+		return 0, err
+	}
+
+	var result proxy.Result
+	if result, err = tx.ExecContext(
+		ctx,
+		dialect.rebind(InsertNoteByIdIsQuery),
+		note.Note,
+		note.UpdatedAt,
+		note.HistoryID,
+	); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// SaveTagByIdIn is a method that tags the histories by ID in with the name.
+// the histories which do not exist or are already tagged with the name are skipped.
+func (h *historyRepository) SaveTagByIdIn(
+	ctx context.Context,
+	name string,
+	ids []int,
+) (int, error) {
+	var deferErr error
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	args := make([]interface{}, 0, len(ids)+2)
+	args = append(args, name)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	args = append(args, name)
+	query := fmt.Sprintf(InsertTagByIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
+
+	var result proxy.Result
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// UnfavoriteAll is a method that unfavorites all the favorited jrps of the history table recording their IDs to restore them.
+// the record of the last time is kept if there are no favorited jrps.
+func (h *historyRepository) UnfavoriteAll(ctx context.Context) (int, error) {
+	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(
+		ctx,
+		&sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+			ReadOnly:  false,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		deferErr = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, DeleteAllUnfavoritedQuery); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, InsertUnfavoritedByIsFavoritedIsQuery); err != nil {
+		return 0, err
+	}
+	var result proxy.Result
+	if result, err = tx.ExecContext(ctx, dialect.rebind(UpdateIsFavoritedByIsFavoritedIsQuery), 0, 1); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if rowsAffected == 0 {
+		return 0, deferErr
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
 // UpdateIsFavoritedByIdIn is a method that updates the is favorited of the jrps from the history table by ID in.
 func (h *historyRepository) UpdateIsFavoritedByIdIn(
 	ctx context.Context,
@@ -608,7 +1194,7 @@ func (h *historyRepository) UpdateIsFavoritedByIdIn(
 	ids []int,
 ) (int, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
@@ -620,7 +1206,7 @@ func (h *historyRepository) UpdateIsFavoritedByIdIn(
 	query := fmt.Sprintf(UpdateIsFavoritedByIdInQuery, strings.Trim(strings.Repeat("?,", len(ids)), ","))
 
 	var result proxy.Result
-	if result, err = db.ExecContext(ctx, query, append([]interface{}{isFavorited}, args...)...); err != nil {
+	if result, err = db.ExecContext(ctx, dialect.rebind(query), append([]interface{}{isFavorited}, args...)...); err != nil {
 		return 0, err
 	}
 
@@ -639,13 +1225,13 @@ func (h *historyRepository) UpdateIsFavoritedByIsFavoritedIs(
 	isFavoritedIs int,
 ) (int, error) {
 	var deferErr error
-	db, err := getJrpDB(ctx, h.connManager)
+	db, dialect, err := getJrpDB(ctx, h.connManager)
 	if err != nil {
 		return 0, err
 	}
 
 	var result proxy.Result
-	if result, err = db.ExecContext(ctx, UpdateIsFavoritedByIsFavoritedIsQuery, isFavorited, isFavoritedIs); err != nil {
+	if result, err = db.ExecContext(ctx, dialect.rebind(UpdateIsFavoritedByIsFavoritedIsQuery), isFavorited, isFavoritedIs); err != nil {
 		return 0, err
 	}
 
@@ -657,22 +1243,201 @@ func (h *historyRepository) UpdateIsFavoritedByIsFavoritedIs(
 	return int(rowsAffected), deferErr
 }
 
-// getJrpDB is a function that returns the jrp database connection.
-func getJrpDB(ctx context.Context, connManager database.ConnectionManager) (proxy.DB, error) {
+// purge deletes the jrps in the trash by the query and the tags and the notes of them in a transaction.
+func (h *historyRepository) purge(ctx context.Context, query string, args []interface{}) (int, error) {
 	var deferErr error
+	db, dialect, err := getJrpDB(ctx, h.connManager)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(
+		ctx,
+		&sql.TxOptions{
+			Isolation: sql.LevelSerializable,
+			ReadOnly:  false,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		deferErr = tx.Rollback()
+	}()
+
+	var result proxy.Result
+	if result, err = tx.ExecContext(ctx, dialect.rebind(query), args...); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, DeleteOrphanTagsQuery); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, DeleteOrphanNotesQuery); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), deferErr
+}
+
+// specConditions returns the conditions of the spec to find the histories and the arguments of them.
+func specConditions(dialect *historyDialect, spec *history.HistorySpec) ([]string, []interface{}) {
+	args := make([]interface{}, 0, len(spec.Keywords)+7)
+	conditions := []string{"history.DeletedAt IS NULL"}
+	if spec.Trashed {
+		conditions = []string{"history.DeletedAt IS NOT NULL"}
+	}
+	if len(spec.Keywords) > 0 {
+		keywordClause := ""
+		for i, keyword := range spec.Keywords {
+			condition, arg := dialect.keywordCondition(keyword)
+			if i == 0 {
+				keywordClause += condition
+			} else {
+				if spec.And {
+					keywordClause += " AND " + condition
+				} else {
+					keywordClause += " OR " + condition
+				}
+			}
+			args = append(args, arg)
+		}
+		conditions = append(conditions, "("+keywordClause+")")
+	}
+	if spec.FavoritedOnly {
+		conditions = append(conditions, "history.IsFavorited = ?")
+		args = append(args, 1)
+	}
+	if spec.Tag != "" {
+		conditions = append(conditions, "history.ID IN (SELECT history_tag.HistoryID FROM history_tag WHERE history_tag.Name = ?)")
+		args = append(args, spec.Tag)
+	}
+	if !spec.Since.IsZero() {
+		conditions = append(conditions, dialect.timestamp("history.CreatedAt")+" >= "+dialect.timestampPlaceholder)
+		args = append(args, dialect.timestampArg(spec.Since))
+	}
+	if !spec.Until.IsZero() {
+		conditions = append(conditions, dialect.timestamp("history.CreatedAt")+" < "+dialect.timestampPlaceholder)
+		args = append(args, dialect.timestampArg(spec.Until))
+	}
+
+	return conditions, args
+}
+
+// insertHistories is a function that inserts the jrps with one query in the transaction and sets the IDs to them.
+func insertHistories(ctx context.Context, tx proxy.Tx, dialect *historyDialect, jrps []*history.History) error {
+	valueStrings := make([]string, 0, len(jrps))
+	valueArgs := make([]interface{}, 0, len(jrps)*9)
+
+	for _, jrp := range jrps {
+		valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		valueArgs = append(valueArgs,
+			jrp.Phrase,
+			jrp.Reading,
+			jrp.Romaji,
+			jrp.WordIDs,
+			jrp.Prefix,
+			jrp.Suffix,
+			jrp.IsFavorited,
+			jrp.CreatedAt,
+			jrp.UpdatedAt,
+		)
+	}
+
+	query := dialect.rebind(fmt.Sprintf(dialect.insertQuery, strings.Join(valueStrings, ",")))
+	if dialect.returning {
+		return scanInsertedIds(ctx, tx, query, valueArgs, jrps)
+	}
+
+	result, err := tx.ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return err
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	firstID := lastID - int64(len(jrps)) + 1
+	if dialect.firstInsertId {
+		firstID = lastID
+	}
+
+	for i, jrp := range jrps {
+		jrp.ID = int(firstID) + i
+	}
+
+	return nil
+}
+
+// scanInsertedIds is a function that inserts the jrps with the query returning their IDs and sets the IDs to them.
+func scanInsertedIds(ctx context.Context, tx proxy.Tx, query string, args []interface{}, jrps []*history.History) error {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	i := 0
+	for rows.Next() && i < len(jrps) {
+		if err := rows.Scan(&jrps[i].ID); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		i++
+	}
+
+	return rows.Close()
+}
+
+// getJrpDB gets the connection to the jrp database after the migrations are applied once for the connection.
+func getJrpDB(ctx context.Context, connManager database.ConnectionManager) (proxy.DB, *historyDialect, error) {
 	conn, err := connManager.GetConnection(database.JrpDB)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	db, err := conn.Open()
+	db, dbType, dialect, err := openJrpConnection(conn)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if _, err := db.ExecContext(ctx, CreateQuery); err != nil {
-		return nil, err
+	if err := migrateOnce(ctx, conn, db, dbType, dialect); err != nil {
+		return nil, nil, err
+	}
+
+	return db, dialect, nil
+}
+
+// openJrpDB opens the connection to the jrp database and returns it with the type and the dialect of the database.
+func openJrpDB(connManager database.ConnectionManager) (proxy.DB, database.DBType, *historyDialect, error) {
+	conn, err := connManager.GetConnection(database.JrpDB)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return openJrpConnection(conn)
+}
+
+// openJrpConnection opens the connection and returns it with the type and the dialect of the database.
+func openJrpConnection(conn database.DBConnection) (proxy.DB, database.DBType, *historyDialect, error) {
+	dbType := conn.DBType()
+	dialect, err := getHistoryDialect(dbType)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	db, err := conn.Open()
+	if err != nil {
+		return nil, "", nil, err
 	}
 
-	return db, deferErr
+	return db, dbType, dialect, nil
 }
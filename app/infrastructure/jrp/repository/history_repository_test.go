@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -48,6 +49,215 @@ func TestNewHistoryRepository(t *testing.T) {
 	}
 }
 
+func Test_historyRepository_CountBySpec(t *testing.T) {
+	testData := []*historyDomain.History{
+		{
+			Phrase:      "test-b",
+			IsFavorited: 1,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "test-c",
+			IsFavorited: 0,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "other",
+			IsFavorited: 1,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx  context.Context
+		spec *historyDomain.HistorySpec
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     int
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
+			},
+			testData: nil,
+			want:     0,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (empty spec, the number, the offset and the cursor are ignored)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Number: 1,
+					Offset: 1,
+					Cursor: 1,
+				},
+			},
+			testData: testData,
+			want:     3,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (keywords and favorited only)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Keywords:      []string{"test"},
+					FavoritedOnly: true,
+				},
+			},
+			testData: testData,
+			want:     1,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
+			},
+			testData: nil,
+			want:     0,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, query, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
+			},
+			testData: nil,
+			want:     0,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
+			},
+			testData: nil,
+			want:     0,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.CountBySpec(tt.args.ctx, tt.args.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.CountBySpec() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.CountBySpec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_historyRepository_DeleteAll(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
@@ -227,56 +437,7 @@ func Test_historyRepository_DeleteAll(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false}) failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx: context.Background(),
-			},
-			testData: nil,
-			want:     0,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (tx.ExecContext(ctx, DeleteAllQuery) failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx: context.Background(),
-			},
-			testData: nil,
-			want:     0,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
-				mockTx.EXPECT().Rollback().Return(nil)
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (tx.ExecContext(ctx, DeleteSequenceQuery) failed)",
+			name: "negative testing (db.ExecContext(ctx, DeleteAllQuery, time.Now()) failed)",
 			fields: fields{
 				connManager: nil,
 			},
@@ -287,14 +448,11 @@ func Test_historyRepository_DeleteAll(t *testing.T) {
 			want:     0,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
-				mockTx.EXPECT().Rollback().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -316,44 +474,11 @@ func Test_historyRepository_DeleteAll(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockTx.EXPECT().Rollback().Return(nil)
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (tx.Commit() failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx: context.Background(),
-			},
-			testData: nil,
-			want:     0,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockResult := proxy.NewMockResult(mockCtrl)
-				mockResult.EXPECT().RowsAffected().Return(int64(0), nil)
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockTx.EXPECT().Commit().Return(errors.New("proxy.Tx.Commit() failed"))
-				mockTx.EXPECT().Rollback().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -800,9 +925,10 @@ func Test_historyRepository_DeleteByIdIn(t *testing.T) {
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -826,9 +952,10 @@ func Test_historyRepository_DeleteByIdIn(t *testing.T) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -1667,9 +1794,10 @@ func Test_historyRepository_DeleteByIdInAndIsFavoritedIs(t *testing.T) {
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -1693,9 +1821,10 @@ func Test_historyRepository_DeleteByIdInAndIsFavoritedIs(t *testing.T) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2045,9 +2174,10 @@ func Test_historyRepository_DeleteByIsFavoritedIs(t *testing.T) {
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2071,9 +2201,10 @@ func Test_historyRepository_DeleteByIsFavoritedIs(t *testing.T) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2114,193 +2245,77 @@ func Test_historyRepository_DeleteByIsFavoritedIs(t *testing.T) {
 	}
 }
 
-func Test_historyRepository_FindAll(t *testing.T) {
+func Test_historyRepository_DeleteNoteByIdIs(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
 		ctx context.Context
+		id  int
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		notes    []*historyDomain.Note
+		want     int
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
 	}{
 		{
-			name: "positive testing (no histories in the database)",
+			name: "positive testing (no notes in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
+				id:  1,
 			},
 			testData: nil,
-			want:     nil,
+			notes:    nil,
+			want:     0,
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-			},
-		},
-		{
-			name: "positive testing (1 history in the database)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx: context.Background(),
-			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database)",
+			name: "positive testing (the note of the history removed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
+				id:  1,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 				{
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix2",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix2",
-						Valid:  true,
-					},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix2", Valid: true},
-					Suffix:      sql.NullString{String: "suffix2", Valid: true},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			notes: []*historyDomain.Note{
+				historyDomain.NewNote(1, "note", now),
+				historyDomain.NewNote(2, "note2", now),
 			},
+			want:    1,
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
@@ -2310,9 +2325,11 @@ func Test_historyRepository_FindAll(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
+				id:  1,
 			},
 			testData: nil,
-			want:     nil,
+			notes:    nil,
+			want:     0,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
@@ -2322,21 +2339,24 @@ func Test_historyRepository_FindAll(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext(ctx, FindAllQuery) failed)",
+			name: "negative testing (db.ExecContext(ctx, DeleteNoteByIdIsQuery, id) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
+				id:  1,
 			},
 			testData: nil,
-			want:     nil,
+			notes:    nil,
+			want:     0,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2345,25 +2365,26 @@ func Test_historyRepository_FindAll(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (rows.Scan() failed)",
+			name: "negative testing (result.RowsAffected() failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
+				id:  1,
 			},
 			testData: nil,
-			want:     nil,
+			notes:    nil,
+			want:     0,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockRows := proxy.NewMockRows(mockCtrl)
-				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
-				mockRows.EXPECT().Close().Return(nil)
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2392,247 +2413,272 @@ func Test_historyRepository_FindAll(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindAll(tt.args.ctx)
+			for _, note := range tt.notes {
+				if _, err := h.SaveNote(tt.args.ctx, note); err != nil {
+					t.Errorf("Failed to save test note: %v", err)
+				}
+			}
+			got, err := h.DeleteNoteByIdIs(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.DeleteNoteByIdIs() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindAll() returned %d items, want %d", len(got), len(tt.want))
+			if got != tt.want {
+				t.Errorf("historyRepository.DeleteNoteByIdIs() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
 				return
 			}
-			for i := range got {
-				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindAll()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
-				}
-				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindAll()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
-				}
-				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindAll()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
-				}
-				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindAll()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
-				}
-				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindAll()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
-				}
+			note, err := h.FindNoteByIdIs(tt.args.ctx, tt.args.id)
+			if err != nil {
+				t.Errorf("Failed to find the note: %v", err)
+			}
+			if note != nil {
+				t.Errorf("historyRepository.DeleteNoteByIdIs() : note = %v, want nil", note)
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
+func Test_historyRepository_DeleteTagByNameIsAndIdIn(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx         context.Context
-		isFavorited int
+		ctx  context.Context
+		name string
+		ids  []int
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		tags     []*historyDomain.Tag
+		want     int
+		wantTags []*historyDomain.Tag
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
 	}{
 		{
-			name: "positive testing (no histories in the database)",
+			name: "positive testing (no ids specified)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 0,
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			want:     0,
+			wantTags: []*historyDomain.Tag{},
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited matches)",
+			name: "positive testing (the tag removed from the histories)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 0,
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1, 3},
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
-			},
-			want: []*historyDomain.History{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+				historyDomain.NewTag(2, "tag"),
+				historyDomain.NewTag(1, "tag2"),
+			},
+			want: 1,
+			wantTags: []*historyDomain.Tag{
+				historyDomain.NewTag(2, "tag"),
+				historyDomain.NewTag(1, "tag2"),
+			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited does not match)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 1,
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
 			},
-			want:    nil,
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.ExecContext(ctx, query, args...) failed)",
+			fields: fields{
+				connManager: nil,
 			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
+			},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
 			},
+			cleanup: nil,
 		},
 		{
-			name: "positive testing (2 histories in the database (isFavorited = 0, 1), isFavorited matches one)",
+			name: "negative testing (result.RowsAffected() failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 1,
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, tag := range tt.tags {
+				if _, err := h.SaveTagByIdIn(tt.args.ctx, tag.Name, []int{tag.HistoryID}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+			}
+			got, err := h.DeleteTagByNameIsAndIdIn(tt.args.ctx, tt.args.name, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.DeleteTagByNameIsAndIdIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.DeleteTagByNameIsAndIdIn() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			tags, err := h.FindAllTags(tt.args.ctx)
+			if err != nil {
+				t.Errorf("Failed to find all tags: %v", err)
+			}
+			if !reflect.DeepEqual(tags, tt.wantTags) {
+				t.Errorf("historyRepository.DeleteTagByNameIsAndIdIn() : tags = %v, want %v", tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindAll(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
 			},
-			wantErr: false,
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
@@ -2656,13 +2702,12 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited does not match)",
+			name: "positive testing (1 history in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 1,
+				ctx: context.Background(),
 			},
 			testData: []*historyDomain.History{
 				{
@@ -2680,7 +2725,17 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    nil,
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -2705,13 +2760,12 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database (isFavorited = 0, 1), isFavorited matches one)",
+			name: "positive testing (2 histories in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 1,
+				ctx: context.Background(),
 			},
 			testData: []*historyDomain.History{
 				{
@@ -2731,11 +2785,11 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 				{
 					Phrase: "test2",
 					Prefix: sql.NullString{
-						String: "prefix",
+						String: "prefix2",
 						Valid:  true,
 					},
 					Suffix: sql.NullString{
-						String: "suffix",
+						String: "suffix2",
 						Valid:  true,
 					},
 					IsFavorited: 1,
@@ -2745,10 +2799,19 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 			},
 			want: []*historyDomain.History{
 				{
-					ID:          2,
-					Phrase:      "test2",
+					ID:          1,
+					Phrase:      "test",
 					Prefix:      sql.NullString{String: "prefix", Valid: true},
 					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix2", Valid: true},
+					Suffix:      sql.NullString{String: "suffix2", Valid: true},
 					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
@@ -2783,8 +2846,7 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 0,
+				ctx: context.Background(),
 			},
 			testData: nil,
 			want:     nil,
@@ -2797,22 +2859,22 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext(ctx, FindByIsFavoritedIsQuery, isFavorited) failed)",
+			name: "negative testing (db.QueryContext(ctx, FindAllQuery) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 0,
+				ctx: context.Background(),
 			},
 			testData: nil,
 			want:     nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2826,8 +2888,7 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				isFavorited: 0,
+				ctx: context.Background(),
 			},
 			testData: nil,
 			want:     nil,
@@ -2835,12 +2896,13 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -2869,131 +2931,252 @@ func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindByIsFavoritedIs(tt.args.ctx, tt.args.isFavorited)
+			got, err := h.FindAll(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindByIsFavoritedIs() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindByIsFavoritedIs() returned %d items, want %d", len(got), len(tt.want))
+				t.Errorf("historyRepository.FindAll() returned %d items, want %d", len(got), len(tt.want))
 				return
 			}
 			for i := range got {
 				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+					t.Errorf("historyRepository.FindAll()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
 				}
 				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+					t.Errorf("historyRepository.FindAll()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
 				}
 				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+					t.Errorf("historyRepository.FindAll()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
 				}
 				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+					t.Errorf("historyRepository.FindAll()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
 				}
 				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+					t.Errorf("historyRepository.FindAll()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
 				}
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
+func Test_historyRepository_FindAllTags(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx         context.Context
-		keywords    []string
-		and         bool
-		isFavorited int
+		ctx context.Context
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		tags     []*historyDomain.Tag
+		want     []*historyDomain.Tag
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
 	}{
 		{
-			name: "positive testing (no histories in the database)",
+			name: "positive testing (no tags in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				isFavorited: 0,
+				ctx: context.Background(),
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			want:     []*historyDomain.Tag{},
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (1 history in the database (isFavorited = 0), keyword and isFavorited match)",
+			name: "positive testing (3 tags in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				isFavorited: 0,
+				ctx: context.Background(),
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
-			},
-			want: []*historyDomain.History{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(2, "b"),
+				historyDomain.NewTag(2, "a"),
+				historyDomain.NewTag(1, "b"),
+			},
+			want: []*historyDomain.Tag{
+				historyDomain.NewTag(2, "a"),
+				historyDomain.NewTag(1, "b"),
+				historyDomain.NewTag(2, "b"),
+			},
 			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindAllTagsQuery) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, tag := range tt.tags {
+				if _, err := h.SaveTagByIdIn(tt.args.ctx, tag.Name, []int{tag.HistoryID}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+			}
+			got, err := h.FindAllTags(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindAllTags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("historyRepository.FindAllTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindByIdIs(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     *historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
@@ -3017,57 +3200,38 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database (isFavorited = 0, 1), keyword matches both but isFavorited matches one)",
+			name: "positive testing (2 histories in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				isFavorited: 1,
+				ctx: context.Background(),
+				id:  2,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test1",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
+					Phrase:      "test",
+					WordIDs:     sql.NullString{String: "1,2", Valid: true},
 					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
 					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					WordIDs:     sql.NullString{String: "3,4", Valid: true},
 					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 			},
+			want: &historyDomain.History{
+				ID:          2,
+				Phrase:      "test2",
+				WordIDs:     sql.NullString{String: "3,4", Valid: true},
+				IsFavorited: 1,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -3092,58 +3256,157 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database (isFavorited = 0), AND search with multiple keywords)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test", "match"},
-				and:         true,
-				isFavorited: 0,
+				ctx: context.Background(),
+				id:  1,
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test only",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test match both",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test match both",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindByIdIsQuery, id) failed)",
+			fields: fields{
+				connManager: nil,
 			},
-			wantErr: false,
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.FindByIdIs(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindByIdIs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("historyRepository.FindByIdIs() = %v, want %v", got, tt.want)
+				return
+			}
+			if got == nil {
+				return
+			}
+			if got.ID != tt.want.ID {
+				t.Errorf("historyRepository.FindByIdIs().ID = %v, want %v", got.ID, tt.want.ID)
+			}
+			if got.Phrase != tt.want.Phrase {
+				t.Errorf("historyRepository.FindByIdIs().Phrase = %v, want %v", got.Phrase, tt.want.Phrase)
+			}
+			if got.WordIDs != tt.want.WordIDs {
+				t.Errorf("historyRepository.FindByIdIs().WordIDs = %v, want %v", got.WordIDs, tt.want.WordIDs)
+			}
+			if got.IsFavorited != tt.want.IsFavorited {
+				t.Errorf("historyRepository.FindByIdIs().IsFavorited = %v, want %v", got.IsFavorited, tt.want.IsFavorited)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindByIsFavoritedIs(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx         context.Context
+		isFavorited int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
@@ -3167,19 +3430,17 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database (isFavorited = 0), OR search with multiple keywords)",
+			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited matches)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx:         context.Background(),
-				keywords:    []string{"test", "new"},
-				and:         false,
 				isFavorited: 0,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test only",
+					Phrase: "test",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -3192,22 +3453,53 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
+			},
+			want: []*historyDomain.History{
 				{
-					Phrase: "new content",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
+					ID:          1,
+					Phrase:      "test",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
 					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited does not match)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				isFavorited: 1,
+			},
+			testData: []*historyDomain.History{
 				{
-					Phrase: "other content",
+					Phrase: "test",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -3221,26 +3513,7 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test only",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          2,
-					Phrase:      "new content",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
+			want:    nil,
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -3265,19 +3538,17 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database (isFavorited = 0), AND search with multiple keywords)",
+			name: "positive testing (2 histories in the database (isFavorited = 0, 1), isFavorited matches one)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx:         context.Background(),
-				keywords:    []string{"test", "match"},
-				and:         true,
-				isFavorited: 0,
+				isFavorited: 1,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test only",
+					Phrase: "test",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -3291,7 +3562,7 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "test match both",
+					Phrase: "test2",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -3300,7 +3571,7 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 0,
+					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
@@ -3308,10 +3579,10 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			want: []*historyDomain.History{
 				{
 					ID:          2,
-					Phrase:      "test match both",
+					Phrase:      "test2",
 					Prefix:      sql.NullString{String: "prefix", Valid: true},
 					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
+					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
@@ -3340,45 +3611,164 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited does not match)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				isFavorited: 0,
-			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (db.QueryContext(ctx, FindByIsFavoritedIsAndPhraseContainsQuery, args...) failed)",
-			fields: fields{
-				connManager: nil,
+				isFavorited: 1,
 			},
-			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				isFavorited: 0,
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (2 histories in the database (isFavorited = 0, 1), isFavorited matches one)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				isFavorited: 1,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindByIsFavoritedIsQuery, isFavorited) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				isFavorited: 0,
 			},
 			testData: nil,
 			want:     nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -3393,8 +3783,6 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			},
 			args: args{
 				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
 				isFavorited: 0,
 			},
 			testData: nil,
@@ -3403,12 +3791,13 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -3437,44 +3826,45 @@ func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindByIsFavoritedIsAndPhraseContains(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.isFavorited)
+			got, err := h.FindByIsFavoritedIs(tt.args.ctx, tt.args.isFavorited)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContainsAndPhraseContains() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindByIsFavoritedIs() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains() returned %d items, want %d", len(got), len(tt.want))
+				t.Errorf("historyRepository.FindByIsFavoritedIs() returned %d items, want %d", len(got), len(tt.want))
 				return
 			}
 			for i := range got {
 				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
 				}
 				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
 				}
 				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
 				}
 				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
 				}
 				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+					t.Errorf("historyRepository.FindByIsFavoritedIs()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
 				}
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindByPhraseContains(t *testing.T) {
+func Test_historyRepository_FindByIsFavoritedIsAndPhraseContains(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx      context.Context
-		keywords []string
-		and      bool
+		ctx         context.Context
+		keywords    []string
+		and         bool
+		isFavorited int
 	}
 	tests := []struct {
 		name     string
@@ -3492,9 +3882,10 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: nil,
 			want:     nil,
@@ -3522,14 +3913,15 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (1 history in the database, keyword matches)",
+			name: "positive testing (1 history in the database (isFavorited = 0), keyword and isFavorited match)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -3549,16 +3941,10 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 			want: []*historyDomain.History{
 				{
-					ID:     1,
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
+					ID:          1,
+					Phrase:      "test",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
 					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
@@ -3588,14 +3974,15 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database, keyword matches one)",
+			name: "positive testing (2 histories in the database (isFavorited = 0, 1), keyword matches both but isFavorited matches one)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test1"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 1,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -3622,24 +4009,18 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 0,
+					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 			},
 			want: []*historyDomain.History{
 				{
-					ID:     1,
-					Phrase: "test1",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 1,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
@@ -3668,14 +4049,15 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (2 histories in the database, AND search with multiple keywords)",
+			name: "positive testing (2 histories in the database (isFavorited = 0), AND search with multiple keywords)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test", "match"},
-				and:      true,
+				ctx:         context.Background(),
+				keywords:    []string{"test", "match"},
+				and:         true,
+				isFavorited: 0,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -3709,16 +4091,10 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 			want: []*historyDomain.History{
 				{
-					ID:     2,
-					Phrase: "test match both",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
+					ID:          2,
+					Phrase:      "test match both",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
 					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
@@ -3748,14 +4124,15 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database, OR search with multiple keywords)",
+			name: "positive testing (3 histories in the database (isFavorited = 0), OR search with multiple keywords)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test", "new"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test", "new"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -3803,7 +4180,60 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			},
 			want: []*historyDomain.History{
 				{
-					ID:     1,
+					ID:          1,
+					Phrase:      "test only",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          2,
+					Phrase:      "new content",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (2 histories in the database (isFavorited = 0), AND search with multiple keywords)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test", "match"},
+				and:         true,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
 					Phrase: "test only",
 					Prefix: sql.NullString{
 						String: "prefix",
@@ -3818,8 +4248,7 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 					UpdatedAt:   now,
 				},
 				{
-					ID:     2,
-					Phrase: "new content",
+					Phrase: "test match both",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -3833,6 +4262,17 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test match both",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -3862,9 +4302,10 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: nil,
 			want:     nil,
@@ -3877,23 +4318,25 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext(ctx, FindByPhraseContainsQuery, args...) failed)",
+			name: "negative testing (db.QueryContext(ctx, FindByIsFavoritedIsAndPhraseContainsQuery, args...) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: nil,
 			want:     nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -3907,9 +4350,10 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				isFavorited: 0,
 			},
 			testData: nil,
 			want:     nil,
@@ -3917,12 +4361,13 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -3951,44 +4396,44 @@ func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindByPhraseContains(tt.args.ctx, tt.args.keywords, tt.args.and)
+			got, err := h.FindByIsFavoritedIsAndPhraseContains(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.isFavorited)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindByPhraseContains() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContainsAndPhraseContains() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindByPhraseContains() returned %d items, want %d", len(got), len(tt.want))
+				t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains() returned %d items, want %d", len(got), len(tt.want))
 				return
 			}
 			for i := range got {
 				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindByPhraseContains()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
 				}
 				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindByPhraseContains()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
 				}
 				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindByPhraseContains()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
 				}
 				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindByPhraseContains()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
 				}
 				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindByPhraseContains()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+					t.Errorf("historyRepository.FindByIsFavoritedIsAndPhraseContains()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
 				}
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.T) {
+func Test_historyRepository_FindByPhraseContains(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx         context.Context
-		number      int
-		isFavorited int
+		ctx      context.Context
+		keywords []string
+		and      bool
 	}
 	tests := []struct {
 		name     string
@@ -4006,9 +4451,9 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
 			},
 			testData: nil,
 			want:     nil,
@@ -4036,14 +4481,14 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 			},
 		},
 		{
-			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited matches)",
+			name: "positive testing (1 history in the database, keyword matches)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -4063,10 +4508,16 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 			},
 			want: []*historyDomain.History{
 				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
 					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
@@ -4096,14 +4547,14 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database (isFavorited = 0, 1), isFavorited matches two and number limits to one)",
+			name: "positive testing (2 histories in the database, keyword matches one)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				number:      1,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test1"},
+				and:      false,
 			},
 			testData: []*historyDomain.History{
 				{
@@ -4130,12 +4581,15 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 1,
+					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
+			},
+			want: []*historyDomain.History{
 				{
-					Phrase: "test3",
+					ID:     1,
+					Phrase: "test1",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4149,17 +4603,6 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 					UpdatedAt:   now,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          3,
-					Phrase:      "test3",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -4184,18 +4627,18 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database (isFavorited = 0, 1), isFavorited matches two and number matches)",
+			name: "positive testing (2 histories in the database, AND search with multiple keywords)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test", "match"},
+				and:      true,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test1",
+					Phrase: "test only",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4209,7 +4652,7 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "test2",
+					Phrase: "test match both",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4218,12 +4661,15 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 1,
+					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
+			},
+			want: []*historyDomain.History{
 				{
-					Phrase: "test3",
+					ID:     2,
+					Phrase: "test match both",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4237,26 +4683,6 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 					UpdatedAt:   now,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test1",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          3,
-					Phrase:      "test3",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -4281,203 +4707,18 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.
 			},
 		},
 		{
-			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
-			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (db.QueryContext(ctx, FindTopNByIsFavoritedIsAndByOrderByIdAscQuery, isFavorited, number) failed)",
+			name: "positive testing (3 histories in the database, OR search with multiple keywords)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
-			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-		{
-			name: "negative testing (rows.Scan() failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				number:      2,
-				isFavorited: 0,
-			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockRows := proxy.NewMockRows(mockCtrl)
-				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
-				mockRows.EXPECT().Close().Return(nil)
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
-			},
-			cleanup: nil,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			mockCtrl := gomock.NewController(t)
-			defer mockCtrl.Finish()
-			if tt.setup != nil {
-				tt.setup(mockCtrl, &tt.fields)
-			}
-			defer func() {
-				if tt.cleanup != nil {
-					tt.cleanup()
-				}
-			}()
-			h := &historyRepository{
-				connManager: tt.fields.connManager,
-			}
-			if len(tt.testData) > 0 {
-				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
-					t.Errorf("Failed to save test data: %v", err)
-				}
-			}
-			got, err := h.FindTopNByIsFavoritedIsAndByOrderByIdAsc(tt.args.ctx, tt.args.number, tt.args.isFavorited)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
-				return
-			}
-			for i := range got {
-				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
-				}
-				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
-				}
-				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
-				}
-				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
-				}
-				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
-				}
-			}
-		})
-	}
-}
-
-func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(t *testing.T) {
-	type fields struct {
-		connManager database.ConnectionManager
-	}
-	type args struct {
-		ctx         context.Context
-		keywords    []string
-		and         bool
-		number      int
-		isFavorited int
-	}
-	tests := []struct {
-		name     string
-		fields   fields
-		args     args
-		testData []*historyDomain.History
-		want     []*historyDomain.History
-		wantErr  bool
-		setup    func(mockCtrl *gomock.Controller, tt *fields)
-		cleanup  func()
-	}{
-		{
-			name: "positive testing (no histories in the database)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				number:      2,
-				isFavorited: 0,
-			},
-			testData: nil,
-			want:     nil,
-			wantErr:  false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-			},
-		},
-		{
-			name: "positive testing (3 histories in the database, match 2 by keywords and favorited, limit to 1)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				number:      1,
-				isFavorited: 1,
+				ctx:      context.Background(),
+				keywords: []string{"test", "new"},
+				and:      false,
 			},
 			testData: []*historyDomain.History{
 				{
-					Phrase: "test1",
+					Phrase: "test only",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4486,12 +4727,12 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 1,
+					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "test2",
+					Phrase: "new content",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4500,12 +4741,12 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 1,
+					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "other",
+					Phrase: "other content",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4514,59 +4755,14 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 						String: "suffix",
 						Valid:  true,
 					},
-					IsFavorited: 1,
+					IsFavorited: 0,
 					CreatedAt:   now,
 					UpdatedAt:   now,
 				},
 			},
 			want: []*historyDomain.History{
 				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-			},
-		},
-		{
-			name: "positive testing (3 histories, AND search with multiple keywords, limit 2)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test", "match"},
-				and:         true,
-				number:      2,
-				isFavorited: 0,
-			},
-			testData: []*historyDomain.History{
-				{
+					ID:     1,
 					Phrase: "test only",
 					Prefix: sql.NullString{
 						String: "prefix",
@@ -4581,21 +4777,8 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 					UpdatedAt:   now,
 				},
 				{
-					Phrase: "test match both",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test match also",
+					ID:     2,
+					Phrase: "new content",
 					Prefix: sql.NullString{
 						String: "prefix",
 						Valid:  true,
@@ -4609,26 +4792,6 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 					UpdatedAt:   now,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test match both",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          3,
-					Phrase:      "test match also",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
@@ -4653,118 +4816,18 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database, OR search with multiple keywords, limit 2)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test", "new"},
-				and:         false,
-				number:      2,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test first",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "new second",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "other content",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test first",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          2,
-					Phrase:      "new second",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-			},
-		},
-		{
-			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				number:      1,
-				isFavorited: 0,
-			},
-			want:    nil,
-			wantErr: true,
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
@@ -4773,25 +4836,24 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext(ctx, FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAscQuery, args...) failed)",
+			name: "negative testing (db.QueryContext(ctx, FindByPhraseContainsQuery, args...) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				number:      1,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
 			},
 			testData: nil,
 			want:     nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -4805,11 +4867,9 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 				connManager: nil,
 			},
 			args: args{
-				ctx:         context.Background(),
-				keywords:    []string{"test"},
-				and:         false,
-				number:      1,
-				isFavorited: 0,
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
 			},
 			testData: nil,
 			want:     nil,
@@ -4817,12 +4877,13 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -4851,254 +4912,136 @@ func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdA
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.number, tt.args.isFavorited)
+			got, err := h.FindByPhraseContains(tt.args.ctx, tt.args.keywords, tt.args.and)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindByPhraseContains() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+				t.Errorf("historyRepository.FindByPhraseContains() returned %d items, want %d", len(got), len(tt.want))
 				return
 			}
 			for i := range got {
 				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+					t.Errorf("historyRepository.FindByPhraseContains()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
 				}
 				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+					t.Errorf("historyRepository.FindByPhraseContains()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
 				}
 				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+					t.Errorf("historyRepository.FindByPhraseContains()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
 				}
 				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+					t.Errorf("historyRepository.FindByPhraseContains()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
 				}
 				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+					t.Errorf("historyRepository.FindByPhraseContains()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
 				}
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindTopNByOrderByIdAsc(t *testing.T) {
+func Test_historyRepository_FindByPhraseIn(t *testing.T) {
+	testData := []*historyDomain.History{
+		{
+			Phrase:    "静かな山",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		{
+			Phrase:    "遊ぶ猫",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		{
+			Phrase:    "静かな山",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx    context.Context
-		number int
+		ctx     context.Context
+		phrases []string
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		wantIDs  []int
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
 	}{
+		{
+			name: "positive testing (phrases are empty)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				phrases: []string{},
+			},
+			testData: nil,
+			wantIDs:  []int{},
+			wantErr:  false,
+			setup:    nil,
+			cleanup:  nil,
+		},
 		{
 			name: "positive testing (no histories in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:    context.Background(),
-				number: 2,
+				ctx:     context.Background(),
+				phrases: []string{"静かな山"},
 			},
 			testData: nil,
-			want:     nil,
+			wantIDs:  []int{},
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (1 history in the database, limit=2)",
+			name: "positive testing (histories in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:    context.Background(),
-				number: 2,
-			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+				ctx:     context.Background(),
+				phrases: []string{"静かな山", "静かな猫"},
 			},
-			wantErr: false,
+			testData: testData,
+			wantIDs:  []int{1, 3},
+			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database, limit=2)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:    context.Background(),
-				number: 2,
-			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test1",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test3",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:          3,
-					Phrase:      "test3",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-			},
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-			},
-		},
-		{
-			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
-			fields: fields{
-				connManager: nil,
-			},
-			args: args{
-				ctx:    context.Background(),
-				number: 2,
+				ctx:     context.Background(),
+				phrases: []string{"静かな山"},
 			},
 			testData: nil,
-			want:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
@@ -5108,22 +5051,23 @@ func Test_historyRepository_FindTopNByOrderByIdAsc(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext() failed)",
+			name: "negative testing (db.QueryContext(ctx, query, args...) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:    context.Background(),
-				number: 2,
+				ctx:     context.Background(),
+				phrases: []string{"静かな山"},
 			},
 			testData: nil,
-			want:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -5137,21 +5081,22 @@ func Test_historyRepository_FindTopNByOrderByIdAsc(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:    context.Background(),
-				number: 2,
+				ctx:     context.Background(),
+				phrases: []string{"静かな山"},
 			},
 			testData: nil,
-			want:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -5180,52 +5125,69 @@ func Test_historyRepository_FindTopNByOrderByIdAsc(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindTopNByOrderByIdAsc(tt.args.ctx, tt.args.number)
+			got, err := h.FindByPhraseIn(tt.args.ctx, tt.args.phrases)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindTopNByOrderByIdAs() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindByPhraseIn() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindTopNByOrderByIdAs() returned %d items, want %d", len(got), len(tt.want))
+			if tt.wantErr {
 				return
 			}
-			for i := range got {
-				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
-				}
-				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
-				}
-				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
-				}
-				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
-				}
-				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
-				}
+			gotIDs := []int{}
+			for _, h := range got {
+				gotIDs = append(gotIDs, h.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("historyRepository.FindByPhraseIn() = %v, want %v", gotIDs, tt.wantIDs)
 			}
 		})
 	}
 }
 
-func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
+func Test_historyRepository_FindBySpec(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+	testData := []*historyDomain.History{
+		{
+			Phrase:      "test-b",
+			IsFavorited: 1,
+			CreatedAt:   time.Date(2026, 1, 1, 10, 0, 0, 0, jst),
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "test-c",
+			IsFavorited: 0,
+			CreatedAt:   time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC),
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "test-a",
+			IsFavorited: 1,
+			CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, est),
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "other",
+			IsFavorited: 0,
+			CreatedAt:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   now,
+		},
+	}
+
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx      context.Context
-		keywords []string
-		and      bool
-		number   int
+		ctx  context.Context
+		spec *historyDomain.HistorySpec
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		tags     []*historyDomain.Tag
+		wantIDs  []int
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
@@ -5236,319 +5198,276 @@ func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
-				number:   2,
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			wantIDs:  []int{},
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database, match 2 by keywords, limit to 1)",
+			name: "positive testing (empty spec)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
-				number:   1,
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test1",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{1, 2, 3, 4},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (number, sort by id desc)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Number: 2,
+					SortBy: historyDomain.SortById,
+					Desc:   true,
 				},
-				{
-					Phrase: "other",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			},
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{4, 3},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (number and offset)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Number: 2,
+					Offset: 1,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test2",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{2, 3},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (number and cursor)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Number: 2,
+					Cursor: 2,
 				},
 			},
-			wantErr: false,
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{1},
+			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (3 histories, AND search with multiple keywords, limit 2)",
+			name: "positive testing (keywords or, sort by phrase)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test", "match"},
-				and:      true,
-				number:   2,
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Keywords: []string{"test", "other"},
+					And:      false,
+					SortBy:   historyDomain.SortByPhrase,
+				},
 			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test only",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test match both",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "test match also",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{4, 3, 1, 2},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          2,
-					Phrase:      "test match both",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (keywords and, favorited only)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Keywords:      []string{"test", "-a"},
+					And:           true,
+					FavoritedOnly: true,
 				},
-				{
-					ID:          3,
-					Phrase:      "test match also",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			},
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{3},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (tag, number)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Tag:    "tag",
+					Number: 2,
 				},
 			},
+			testData: testData,
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+				historyDomain.NewTag(2, "tag"),
+				historyDomain.NewTag(3, "tag"),
+				historyDomain.NewTag(4, "tag2"),
+			},
+			wantIDs: []int{2, 3},
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (3 histories in the database, OR search with multiple keywords, limit 2)",
+			name: "positive testing (number, sort by created takes the most recent histories)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test", "new"},
-				and:      false,
-				number:   2,
-			},
-			testData: []*historyDomain.History{
-				{
-					Phrase: "test first",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					Phrase: "new second",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Number: 3,
+					SortBy: historyDomain.SortByCreatedAt,
 				},
-				{
-					Phrase: "other content",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			},
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{2, 3, 4},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (sort by created in different time zones)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					SortBy: historyDomain.SortByCreatedAt,
 				},
 			},
-			want: []*historyDomain.History{
-				{
-					ID:          1,
-					Phrase:      "test first",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{2, 1, 3, 4},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (since and until in different time zones, sort by created desc)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					Since:  time.Date(2026, 1, 1, 0, 45, 0, 0, time.UTC),
+					Until:  time.Date(2026, 1, 1, 19, 0, 0, 0, jst),
+					SortBy: historyDomain.SortByCreatedAt,
+					Desc:   true,
 				},
-				{
-					ID:          2,
-					Phrase:      "new second",
-					Prefix:      sql.NullString{String: "prefix", Valid: true},
-					Suffix:      sql.NullString{String: "suffix", Valid: true},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+			},
+			testData: testData,
+			tags:     nil,
+			wantIDs:  []int{3, 1},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (unsupported sort key)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				spec: &historyDomain.HistorySpec{
+					SortBy: historyDomain.SortKey("test"),
 				},
 			},
-			wantErr: false,
+			testData: nil,
+			tags:     nil,
+			wantIDs:  nil,
+			wantErr:  true,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
@@ -5557,13 +5476,12 @@ func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
-				number:   1,
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
@@ -5573,24 +5491,24 @@ func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.QueryContext(ctx, FindTopNByPhraseContainsOrderByIdAscQuery, args...) failed)",
+			name: "negative testing (db.QueryContext(ctx, query, args...) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
-				number:   1,
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -5604,23 +5522,23 @@ func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
 				connManager: nil,
 			},
 			args: args{
-				ctx:      context.Background(),
-				keywords: []string{"test"},
-				and:      false,
-				number:   1,
+				ctx:  context.Background(),
+				spec: &historyDomain.HistorySpec{},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			wantIDs:  nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockRows := proxy.NewMockRows(mockCtrl)
 				mockRows.EXPECT().Next().Return(true)
-				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
 				mockRows.EXPECT().Close().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any()).Return(mockRows, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -5649,230 +5567,3818 @@ func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
 					t.Errorf("Failed to save test data: %v", err)
 				}
 			}
-			got, err := h.FindTopNByPhraseContainsOrderByIdAsc(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.number)
+			for _, tag := range tt.tags {
+				if _, err := h.SaveTagByIdIn(tt.args.ctx, tag.Name, []int{tag.HistoryID}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+			}
+			got, err := h.FindBySpec(tt.args.ctx, tt.args.spec)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.FindBySpec() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+			if tt.wantErr {
 				return
 			}
-			for i := range got {
-				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
-				}
-				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
-				}
-				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
-				}
-				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
-				}
-				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
-				}
+			gotIDs := []int{}
+			for _, h := range got {
+				gotIDs = append(gotIDs, h.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("historyRepository.FindBySpec() = %v, want %v", gotIDs, tt.wantIDs)
 			}
 		})
 	}
 }
 
-func Test_historyRepository_SaveAll(t *testing.T) {
+func Test_historyRepository_FindBySpec_fullTextSearch(t *testing.T) {
+	connManager := initializeJrpDB(t)
+	defer cleanupJrpDB(t)
+	conn, err := connManager.GetConnection(database.JrpDB)
+	if err != nil {
+		t.Fatalf("Failed to get connection: %v", err)
+	}
+	db, err := conn.Open()
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	// the history created before the full-text search table is indexed by the migration.
+	if _, err := db.ExecContext(
+		context.Background(),
+		"CREATE TABLE history (ID INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, Phrase TEXT NOT NULL, Reading TEXT, Romaji TEXT, WordIDs TEXT, Prefix TEXT, Suffix TEXT, IsFavorited INTEGER DEFAULT 0, CreatedAt TIMESTAMP, UpdatedAt TIMESTAMP);",
+	); err != nil {
+		t.Fatalf("Failed to create the history table: %v", err)
+	}
+	if _, err := db.ExecContext(context.Background(), "INSERT INTO history (Phrase, CreatedAt, UpdatedAt) VALUES ('静かな山', ?, ?);", now, now); err != nil {
+		t.Fatalf("Failed to insert the history: %v", err)
+	}
+
+	h := &historyRepository{
+		connManager: connManager,
+	}
+	// the histories saved or deleted after the migration are synchronized by the triggers.
+	if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+		{Phrase: "静かな猫", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "静かな犬", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "遊ぶ猫", CreatedAt: now, UpdatedAt: now},
+	}); err != nil {
+		t.Fatalf("Failed to save test data: %v", err)
+	}
+	if _, err := h.DeleteByIdIn(context.Background(), []int{3}); err != nil {
+		t.Fatalf("Failed to delete test data: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		spec    *historyDomain.HistorySpec
+		wantIDs []int
+	}{
+		{
+			name:    "positive testing (full-text search)",
+			spec:    &historyDomain.HistorySpec{Keywords: []string{"静かな"}},
+			wantIDs: []int{1, 2},
+		},
+		{
+			name:    "positive testing (full-text search and LIKE by AND condition)",
+			spec:    &historyDomain.HistorySpec{Keywords: []string{"静かな", "猫"}, And: true},
+			wantIDs: []int{2},
+		},
+		{
+			name:    "positive testing (full-text search and LIKE by OR condition)",
+			spec:    &historyDomain.HistorySpec{Keywords: []string{"かな山", "遊ぶ"}, And: false},
+			wantIDs: []int{1, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := h.FindBySpec(context.Background(), tt.spec)
+			if err != nil {
+				t.Errorf("historyRepository.FindBySpec() error = %v", err)
+				return
+			}
+			gotIDs := []int{}
+			for _, history := range got {
+				gotIDs = append(gotIDs, history.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("historyRepository.FindBySpec() = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindBySpec_trashed(t *testing.T) {
+	connManager := initializeJrpDB(t)
+	defer cleanupJrpDB(t)
+	ctx := context.Background()
+	h := &historyRepository{
+		connManager: connManager,
+	}
+	if _, err := h.SaveAll(ctx, []*historyDomain.History{
+		{Phrase: "test", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test2", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test3", CreatedAt: now, UpdatedAt: now},
+	}); err != nil {
+		t.Errorf("Failed to save test data: %v", err)
+	}
+	if _, err := h.DeleteByIdIn(ctx, []int{2}); err != nil {
+		t.Errorf("Failed to move test data to the trash: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		spec      *historyDomain.HistorySpec
+		want      []int
+		wantCount int
+	}{
+		{
+			name:      "positive testing (the histories not in the trash)",
+			spec:      &historyDomain.HistorySpec{},
+			want:      []int{1, 3},
+			wantCount: 2,
+		},
+		{
+			name:      "positive testing (the histories in the trash)",
+			spec:      &historyDomain.HistorySpec{Trashed: true},
+			want:      []int{2},
+			wantCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histories, err := h.FindBySpec(ctx, tt.spec)
+			if err != nil {
+				t.Errorf("historyRepository.FindBySpec() error = %v", err)
+				return
+			}
+			var got []int
+			for _, history := range histories {
+				got = append(got, history.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("historyRepository.FindBySpec() = %v, want %v", got, tt.want)
+			}
+			if count, err := h.CountBySpec(ctx, tt.spec); err != nil || count != tt.wantCount {
+				t.Errorf("historyRepository.CountBySpec() = %v, %v, want %v", count, err, tt.wantCount)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindBySpec_pagingSortByPhrase(t *testing.T) {
+	connManager := initializeJrpDB(t)
+	defer cleanupJrpDB(t)
+	ctx := context.Background()
+	h := &historyRepository{
+		connManager: connManager,
+	}
+	if _, err := h.SaveAll(ctx, []*historyDomain.History{
+		{Phrase: "test-d", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test-a", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test-e", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test-b", CreatedAt: now, UpdatedAt: now},
+		{Phrase: "test-c", CreatedAt: now, UpdatedAt: now},
+	}); err != nil {
+		t.Errorf("Failed to save test data: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		spec    *historyDomain.HistorySpec
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (the first page)",
+			spec:    &historyDomain.HistorySpec{Number: 2, SortBy: historyDomain.SortByPhrase},
+			want:    []int{4, 5},
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (the second page)",
+			spec:    &historyDomain.HistorySpec{Number: 2, Offset: 2, SortBy: historyDomain.SortByPhrase},
+			want:    []int{2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (the last page)",
+			spec:    &historyDomain.HistorySpec{Number: 2, Offset: 4, SortBy: historyDomain.SortByPhrase},
+			want:    []int{1},
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (the second page in descending order)",
+			spec:    &historyDomain.HistorySpec{Number: 2, Offset: 2, SortBy: historyDomain.SortByPhrase, Desc: true},
+			want:    []int{3, 2},
+			wantErr: false,
+		},
+		{
+			name:    "negative testing (cursor is specified)",
+			spec:    &historyDomain.HistorySpec{Number: 2, Cursor: 3, SortBy: historyDomain.SortByPhrase},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histories, err := h.FindBySpec(ctx, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindBySpec() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var got []int
+			for _, history := range histories {
+				got = append(got, history.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("historyRepository.FindBySpec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindNoteByIdIs(t *testing.T) {
 	type fields struct {
 		connManager database.ConnectionManager
 	}
 	type args struct {
-		ctx  context.Context
-		jrps []*historyDomain.History
+		ctx context.Context
+		id  int
 	}
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
 		testData []*historyDomain.History
-		want     []*historyDomain.History
+		notes    []*historyDomain.Note
+		want     *historyDomain.Note
 		wantErr  bool
 		setup    func(mockCtrl *gomock.Controller, tt *fields)
 		cleanup  func()
 	}{
 		{
-			name: "positive testing (save empty slice)",
+			name: "positive testing (no notes in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx:  context.Background(),
-				jrps: []*historyDomain.History{},
+				ctx: context.Background(),
+				id:  1,
 			},
 			testData: nil,
-			want:     []*historyDomain.History{},
+			notes:    nil,
+			want:     nil,
 			wantErr:  false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (save 1 history)",
+			name: "positive testing (2 notes in the database)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
-				},
+				id:  2,
 			},
-			testData: nil,
-			want: []*historyDomain.History{
+			testData: []*historyDomain.History{
 				{
-					ID:     1,
-					Phrase: "test",
-					Prefix: sql.NullString{
-						String: "prefix",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 			},
+			notes: []*historyDomain.Note{
+				historyDomain.NewNote(1, "note", now),
+				historyDomain.NewNote(2, "note2", now),
+			},
+			want:    historyDomain.NewNote(2, "note2", now),
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
-				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
-					DBType: database.SQLite,
-					DBName: database.JrpDB,
-					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-				}); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+				tt.connManager = initializeJrpDB(t)
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "positive testing (save multiple histories)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test1",
-						Prefix: sql.NullString{
-							String: "prefix1",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix1",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
-					{
-						Phrase: "test2",
-						Prefix: sql.NullString{
-							String: "prefix2",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix2",
-							Valid:  true,
-						},
-						IsFavorited: 1,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
-				},
+				id:  1,
 			},
 			testData: nil,
-			want: []*historyDomain.History{
-				{
-					ID:     1,
-					Phrase: "test1",
-					Prefix: sql.NullString{
-						String: "prefix1",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix1",
-						Valid:  true,
-					},
-					IsFavorited: 0,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
-				{
-					ID:     2,
-					Phrase: "test2",
-					Prefix: sql.NullString{
-						String: "prefix2",
-						Valid:  true,
-					},
-					Suffix: sql.NullString{
-						String: "suffix2",
-						Valid:  true,
-					},
-					IsFavorited: 1,
-					CreatedAt:   now,
-					UpdatedAt:   now,
-				},
+			notes:    nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
 			},
-			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *fields) {
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
-				}
-				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindNoteByIdIsQuery, id) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, note := range tt.notes {
+				if _, err := h.SaveNote(tt.args.ctx, note); err != nil {
+					t.Errorf("Failed to save test note: %v", err)
+				}
+			}
+			got, err := h.FindNoteByIdIs(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindNoteByIdIs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("historyRepository.FindNoteByIdIs() = %v, want %v", got, tt.want)
+				return
+			}
+			if got == nil {
+				return
+			}
+			if got.HistoryID != tt.want.HistoryID {
+				t.Errorf("historyRepository.FindNoteByIdIs().HistoryID = %v, want %v", got.HistoryID, tt.want.HistoryID)
+			}
+			if got.Note != tt.want.Note {
+				t.Errorf("historyRepository.FindNoteByIdIs().Note = %v, want %v", got.Note, tt.want.Note)
+			}
+			if !got.UpdatedAt.Equal(tt.want.UpdatedAt) {
+				t.Errorf("historyRepository.FindNoteByIdIs().UpdatedAt = %v, want %v", got.UpdatedAt, tt.want.UpdatedAt)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindTagByNameIs(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		tags     []*historyDomain.Tag
+		want     []*historyDomain.Tag
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no tags in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+			},
+			testData: nil,
+			tags:     nil,
+			want:     []*historyDomain.Tag{},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (2 of 3 tags matched)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(2, "tag"),
+				historyDomain.NewTag(1, "tag"),
+				historyDomain.NewTag(1, "tag2"),
+			},
+			want: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+				historyDomain.NewTag(2, "tag"),
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindTagByNameIsQuery, name) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+			},
+			testData: nil,
+			tags:     nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, tag := range tt.tags {
+				if _, err := h.SaveTagByIdIn(tt.args.ctx, tag.Name, []int{tag.HistoryID}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+			}
+			got, err := h.FindTagByNameIs(tt.args.ctx, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindTagByNameIs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("historyRepository.FindTagByNameIs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindTopNByIsFavoritedIsAndByOrderByIdAsc(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx         context.Context
+		number      int
+		isFavorited int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (1 history in the database (isFavorited = 0), isFavorited matches)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database (isFavorited = 0, 1), isFavorited matches two and number limits to one)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      1,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test3",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          3,
+					Phrase:      "test3",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database (isFavorited = 0, 1), isFavorited matches two and number matches)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test3",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          3,
+					Phrase:      "test3",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindTopNByIsFavoritedIsAndByOrderByIdAscQuery, isFavorited, number) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.FindTopNByIsFavoritedIsAndByOrderByIdAsc(tt.args.ctx, tt.args.number, tt.args.isFavorited)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+				}
+				if got[i].Prefix != tt.want[i].Prefix {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+				}
+				if got[i].Suffix != tt.want[i].Suffix {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+				}
+				if got[i].IsFavorited != tt.want[i].IsFavorited {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx         context.Context
+		keywords    []string
+		and         bool
+		number      int
+		isFavorited int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database, match 2 by keywords and favorited, limit to 1)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				number:      1,
+				isFavorited: 1,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "other",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories, AND search with multiple keywords, limit 2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test", "match"},
+				and:         true,
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test only",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test match both",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test match also",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test match both",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          3,
+					Phrase:      "test match also",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database, OR search with multiple keywords, limit 2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test", "new"},
+				and:         false,
+				number:      2,
+				isFavorited: 0,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test first",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "new second",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "other content",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test first",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          2,
+					Phrase:      "new second",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				number:      1,
+				isFavorited: 0,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAscQuery, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				number:      1,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:         context.Background(),
+				keywords:    []string{"test"},
+				and:         false,
+				number:      1,
+				isFavorited: 0,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.number, tt.args.isFavorited)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+				}
+				if got[i].Prefix != tt.want[i].Prefix {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+				}
+				if got[i].Suffix != tt.want[i].Suffix {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+				}
+				if got[i].IsFavorited != tt.want[i].IsFavorited {
+					t.Errorf("historyRepository.FindTopNByIsFavoritedIsAndByPhraseContainsOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindTopNByOrderByIdAsc(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx    context.Context
+		number int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (1 history in the database, limit=2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database, limit=2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test3",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          3,
+					Phrase:      "test3",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				number: 2,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.FindTopNByOrderByIdAsc(tt.args.ctx, tt.args.number)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindTopNByOrderByIdAs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("historyRepository.FindTopNByOrderByIdAs() returned %d items, want %d", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+				}
+				if got[i].Prefix != tt.want[i].Prefix {
+					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+				}
+				if got[i].Suffix != tt.want[i].Suffix {
+					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+				}
+				if got[i].IsFavorited != tt.want[i].IsFavorited {
+					t.Errorf("historyRepository.FindTopNByOrderByIdAs()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_FindTopNByPhraseContainsOrderByIdAsc(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx      context.Context
+		keywords []string
+		and      bool
+		number   int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no histories in the database)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
+				number:   2,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database, match 2 by keywords, limit to 1)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
+				number:   1,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "other",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test2",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories, AND search with multiple keywords, limit 2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test", "match"},
+				and:      true,
+				number:   2,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test only",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test match both",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "test match also",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          2,
+					Phrase:      "test match both",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          3,
+					Phrase:      "test match also",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (3 histories in the database, OR search with multiple keywords, limit 2)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test", "new"},
+				and:      false,
+				number:   2,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test first",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "new second",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase: "other content",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test first",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:          2,
+					Phrase:      "new second",
+					Prefix:      sql.NullString{String: "prefix", Valid: true},
+					Suffix:      sql.NullString{String: "suffix", Valid: true},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
+				number:   1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.QueryContext(ctx, FindTopNByPhraseContainsOrderByIdAscQuery, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
+				number:   1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.QueryContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:      context.Background(),
+				keywords: []string{"test"},
+				and:      false,
+				number:   1,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.FindTopNByPhraseContainsOrderByIdAsc(tt.args.ctx, tt.args.keywords, tt.args.and, tt.args.number)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+				}
+				if got[i].Prefix != tt.want[i].Prefix {
+					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+				}
+				if got[i].Suffix != tt.want[i].Suffix {
+					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+				}
+				if got[i].IsFavorited != tt.want[i].IsFavorited {
+					t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_PurgeAll(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		testData      []*historyDomain.History
+		trashIds      []int
+		want          int
+		wantRemaining int
+		wantErr       bool
+		setup         func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup       func()
+	}{
+		{
+			name: "positive testing (no histories in the trash)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 3,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (2 histories in the trash)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:      []int{1, 2},
+			want:          2,
+			wantRemaining: 1,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false}) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, PurgeAllQuery) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, DeleteOrphanTagsQuery) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, DeleteOrphanNotesQuery) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.RowsAffected() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.Commit() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockTx.EXPECT().Commit().Return(errors.New("proxy.Tx.Commit() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.DeleteByIdIn(tt.args.ctx, tt.trashIds); err != nil {
+					t.Errorf("Failed to move test data to the trash: %v", err)
+				}
+			}
+			got, err := h.PurgeAll(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.PurgeAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.PurgeAll() = %v, want %v", got, tt.want)
+			}
+			if len(tt.testData) > 0 {
+				// the histories purged can not be restored any more.
+				if _, err := h.RestoreAll(tt.args.ctx); err != nil {
+					t.Errorf("Failed to restore test data: %v", err)
+				}
+				if histories, err := h.FindAll(tt.args.ctx); err != nil || len(histories) != tt.wantRemaining {
+					t.Errorf("historyRepository.FindAll() = %v, %v, want %v histories", histories, err, tt.wantRemaining)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_PurgeByIdIn(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+		ids []int
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		testData      []*historyDomain.History
+		trashIds      []int
+		want          int
+		wantRemaining int
+		wantErr       bool
+		setup         func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup       func()
+	}{
+		{
+			name: "positive testing (no ids)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: nil,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:      []int{1},
+			want:          0,
+			wantRemaining: 3,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (the history not in the trash is not purged)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1, 2},
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:      []int{1},
+			want:          1,
+			wantRemaining: 2,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, PurgeByIdInQuery, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+			},
+			testData:      nil,
+			trashIds:      nil,
+			want:          0,
+			wantRemaining: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.DeleteByIdIn(tt.args.ctx, tt.trashIds); err != nil {
+					t.Errorf("Failed to move test data to the trash: %v", err)
+				}
+			}
+			got, err := h.PurgeByIdIn(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.PurgeByIdIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.PurgeByIdIn() = %v, want %v", got, tt.want)
+			}
+			if len(tt.testData) > 0 {
+				// the histories purged can not be restored any more.
+				if _, err := h.RestoreAll(tt.args.ctx); err != nil {
+					t.Errorf("Failed to restore test data: %v", err)
+				}
+				if histories, err := h.FindAll(tt.args.ctx); err != nil || len(histories) != tt.wantRemaining {
+					t.Errorf("historyRepository.FindAll() = %v, %v, want %v histories", histories, err, tt.wantRemaining)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_RestoreAll(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		testData    []*historyDomain.History
+		trashIds    []int
+		want        int
+		wantVisible int
+		wantErr     bool
+		setup       func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup     func()
+	}{
+		{
+			name: "positive testing (no histories in the trash)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 3,
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (2 histories in the trash)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:    []int{1, 2},
+			want:        2,
+			wantVisible: 3,
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.ExecContext(ctx, RestoreAllQuery) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.RowsAffected() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.DeleteByIdIn(tt.args.ctx, tt.trashIds); err != nil {
+					t.Errorf("Failed to move test data to the trash: %v", err)
+				}
+			}
+			got, err := h.RestoreAll(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.RestoreAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.RestoreAll() = %v, want %v", got, tt.want)
+			}
+			if len(tt.testData) > 0 {
+				if histories, err := h.FindAll(tt.args.ctx); err != nil || len(histories) != tt.wantVisible {
+					t.Errorf("historyRepository.FindAll() = %v, %v, want %v histories", histories, err, tt.wantVisible)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_RestoreByIdIn(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+		ids []int
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		testData    []*historyDomain.History
+		trashIds    []int
+		want        int
+		wantVisible int
+		wantErr     bool
+		setup       func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup     func()
+	}{
+		{
+			name: "positive testing (no ids)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: nil,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:    []int{1},
+			want:        0,
+			wantVisible: 2,
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (the history not in the trash is not restored)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{2, 3},
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			trashIds:    []int{1, 2},
+			want:        1,
+			wantVisible: 2,
+			wantErr:     false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.ExecContext(ctx, RestoreByIdInQuery, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("proxy.DB.ExecContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.RowsAffected() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []int{1},
+			},
+			testData:    nil,
+			trashIds:    nil,
+			want:        0,
+			wantVisible: 0,
+			wantErr:     true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.DeleteByIdIn(tt.args.ctx, tt.trashIds); err != nil {
+					t.Errorf("Failed to move test data to the trash: %v", err)
+				}
+			}
+			got, err := h.RestoreByIdIn(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.RestoreByIdIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.RestoreByIdIn() = %v, want %v", got, tt.want)
+			}
+			if len(tt.testData) > 0 {
+				if histories, err := h.FindAll(tt.args.ctx); err != nil || len(histories) != tt.wantVisible {
+					t.Errorf("historyRepository.FindAll() = %v, %v, want %v histories", histories, err, tt.wantVisible)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_RestoreFavorites(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		testData      []*historyDomain.History
+		unfavoriteAll bool
+		want          int
+		wantFavorited int
+		wantErr       bool
+		setup         func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup       func()
+	}{
+		{
+			name: "positive testing (no favorites unfavorited at once)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:      "test",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			unfavoriteAll: false,
+			want:          0,
+			wantFavorited: 1,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (2 favorites unfavorited at once)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:      "test",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:      "test3",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			unfavoriteAll: true,
+			want:          2,
+			wantFavorited: 2,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			unfavoriteAll: false,
+			want:          0,
+			wantFavorited: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false}) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			testData:      nil,
+			unfavoriteAll: false,
+			want:          0,
+			wantFavorited: 0,
+			wantErr:       true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if tt.unfavoriteAll {
+					if _, err := h.UnfavoriteAll(tt.args.ctx); err != nil {
+						t.Errorf("Failed to unfavorite test data: %v", err)
+					}
+				}
+			}
+			got, err := h.RestoreFavorites(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.RestoreFavorites() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.RestoreFavorites() = %v, want %v", got, tt.want)
+			}
+			if len(tt.testData) > 0 {
+				if favorites, err := h.FindByIsFavoritedIs(tt.args.ctx, 1); err != nil || len(favorites) != tt.wantFavorited {
+					t.Errorf("historyRepository.FindByIsFavoritedIs() = %v, %v, want %v histories", favorites, err, tt.wantFavorited)
+				}
+				if got, err := h.RestoreFavorites(tt.args.ctx); err != nil || got != 0 {
+					t.Errorf("historyRepository.RestoreFavorites() twice = %v, %v, want 0", got, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_SaveAll(t *testing.T) {
+	var batchJrps, batchWant []*historyDomain.History
+	for i := 1; i <= saveAllBatchSize+1; i++ {
+		batchJrps = append(batchJrps, &historyDomain.History{
+			Phrase:      "test" + strconv.Itoa(i),
+			IsFavorited: 0,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		batchWant = append(batchWant, &historyDomain.History{
+			ID:          i,
+			Phrase:      "test" + strconv.Itoa(i),
+			IsFavorited: 0,
+		})
+	}
+
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx  context.Context
+		jrps []*historyDomain.History
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		want     []*historyDomain.History
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (save empty slice)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				jrps: []*historyDomain.History{},
+			},
+			testData: nil,
+			want:     []*historyDomain.History{},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (save 1 history)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (save multiple histories)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test1",
+						Prefix: sql.NullString{
+							String: "prefix1",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix1",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+					{
+						Phrase: "test2",
+						Prefix: sql.NullString{
+							String: "prefix2",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix2",
+							Valid:  true,
+						},
+						IsFavorited: 1,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test1",
+					Prefix: sql.NullString{
+						String: "prefix1",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix1",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:     2,
+					Phrase: "test2",
+					Prefix: sql.NullString{
+						String: "prefix2",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix2",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.BeginTx() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (save the histories over the batch size)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				jrps: batchJrps,
+			},
+			testData: nil,
+			want:     batchWant,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
 				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
 					DBType: database.SQLite,
 					DBName: database.JrpDB,
@@ -5881,41 +9387,811 @@ func Test_historyRepository_SaveAll(t *testing.T) {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
 			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (tx.ExecContext() failed in the second batch)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				jrps: batchJrps,
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().LastInsertId().Return(int64(saveAllBatchSize), nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				gomock.InOrder(
+					mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil),
+					mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed")),
+				)
+				// the first batch is not committed but rolled back with the second one.
+				mockTx.EXPECT().Commit().Times(0)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.LastInsertId() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().LastInsertId().Return(int64(0), errors.New("Result.LastInsertId() failed"))
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.Commit() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase: "test",
+						Prefix: sql.NullString{
+							String: "prefix",
+							Valid:  true,
+						},
+						Suffix: sql.NullString{
+							String: "suffix",
+							Valid:  true,
+						},
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().LastInsertId().Return(int64(1), nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().Commit().Return(errors.New("Tx.Commit() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (save multiple histories to PostgreSQL)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase:      "test1",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+					{
+						Phrase:      "test2",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want: []*historyDomain.History{
+				{
+					ID:          5,
+					Phrase:      "test1",
+					IsFavorited: 0,
+				},
+				{
+					ID:          6,
+					Phrase:      "test2",
+					IsFavorited: 0,
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				id := 5
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true).Times(2)
+				mockRows.EXPECT().Next().Return(false)
+				mockRows.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...interface{}) error {
+					*dest[0].(*int) = id
+					id++
+					return nil
+				}).Times(2)
+				mockRows.EXPECT().Close().Return(nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockTx.EXPECT().Commit().Return(nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.PostgreSQL)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.QueryContext() failed with PostgreSQL)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase:      "test1",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+					{
+						Phrase:      "test2",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.QueryContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.PostgreSQL)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (rows.Scan() failed with PostgreSQL)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase:      "test1",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+					{
+						Phrase:      "test2",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want:     nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockRows := proxy.NewMockRows(mockCtrl)
+				mockRows.EXPECT().Next().Return(true)
+				mockRows.EXPECT().Scan(gomock.Any()).Return(errors.New("Rows.Scan() failed"))
+				mockRows.EXPECT().Close().Return(nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRows, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.PostgreSQL)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (save multiple histories to MySQL)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				jrps: []*historyDomain.History{
+					{
+						Phrase:      "test1",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+					{
+						Phrase:      "test2",
+						IsFavorited: 0,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				},
+			},
+			testData: nil,
+			want: []*historyDomain.History{
+				{
+					ID:          5,
+					Phrase:      "test1",
+					IsFavorited: 0,
+				},
+				{
+					ID:          6,
+					Phrase:      "test2",
+					IsFavorited: 0,
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().LastInsertId().Return(int64(5), nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().Commit().Return(nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.MySQL)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			got, err := h.SaveAll(tt.args.ctx, tt.args.jrps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.SaveAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
+				return
+			}
+			for i := range got {
+				if got[i].ID != tt.want[i].ID {
+					t.Errorf("historyRepository.SaveAll()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
+				}
+				if got[i].Phrase != tt.want[i].Phrase {
+					t.Errorf("historyRepository.SaveAll()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
+				}
+				if got[i].Prefix != tt.want[i].Prefix {
+					t.Errorf("historyRepository.SaveAll()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
+				}
+				if got[i].Suffix != tt.want[i].Suffix {
+					t.Errorf("historyRepository.SaveAll()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
+				}
+				if got[i].IsFavorited != tt.want[i].IsFavorited {
+					t.Errorf("historyRepository.SaveAll()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+				}
+			}
+		})
+	}
+}
+
+func Test_historyRepository_SaveNote(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx  context.Context
+		note *historyDomain.Note
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		notes    []*historyDomain.Note
+		want     int
+		wantNote *historyDomain.Note
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (the history does not exist)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (the note saved)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes:    nil,
+			want:     1,
+			wantNote: historyDomain.NewNote(1, "note", now),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "positive testing (the note replaced)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "new note", now),
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes: []*historyDomain.Note{
+				historyDomain.NewNote(1, "old note", now),
+			},
+			want:     1,
+			wantNote: historyDomain.NewNote(1, "new note", now),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (db.BeginTx() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, DeleteNoteByIdIsQuery, note.HistoryID) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.ExecContext(ctx, InsertNoteByIdIsQuery, ...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.RowsAffected() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (tx.Commit() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				note: historyDomain.NewNote(1, "note", now),
+			},
+			testData: nil,
+			notes:    nil,
+			want:     0,
+			wantNote: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(1), nil)
+				mockTx := proxy.NewMockTx(mockCtrl)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockTx.EXPECT().Commit().Return(errors.New("proxy.Tx.Commit() failed"))
+				mockTx.EXPECT().Rollback().Return(nil)
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
 				}
-				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
-					t.Errorf("Failed to remove test database: %v", err)
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, note := range tt.notes {
+				if _, err := h.SaveNote(tt.args.ctx, note); err != nil {
+					t.Errorf("Failed to save test note: %v", err)
 				}
+			}
+			got, err := h.SaveNote(tt.args.ctx, tt.args.note)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.SaveNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.SaveNote() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			note, err := h.FindNoteByIdIs(tt.args.ctx, tt.args.note.HistoryID)
+			if err != nil {
+				t.Errorf("Failed to find the note: %v", err)
+			}
+			if (note == nil) != (tt.wantNote == nil) {
+				t.Errorf("historyRepository.SaveNote() : note = %v, want %v", note, tt.wantNote)
+				return
+			}
+			if note != nil && note.Note != tt.wantNote.Note {
+				t.Errorf("historyRepository.SaveNote() : note.Note = %v, want %v", note.Note, tt.wantNote.Note)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_SaveTagByIdIn(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+		ids  []int
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		testData []*historyDomain.History
+		tags     []*historyDomain.Tag
+		want     int
+		wantTags []*historyDomain.Tag
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (no ids specified)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{},
+			},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: []*historyDomain.Tag{},
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
 			},
 		},
 		{
-			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			name: "positive testing (the histories tagged, skipping the already tagged one and the not existing one)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
-				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1, 2, 3},
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+			},
+			want: 1,
+			wantTags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+				historyDomain.NewTag(2, "tag"),
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+		{
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
 			},
 			testData: nil,
-			want:     nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
@@ -5925,168 +10201,228 @@ func Test_historyRepository_SaveAll(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.BeginTx() failed)",
+			name: "negative testing (db.ExecContext(ctx, query, args...) failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
+			},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result.RowsAffected() failed)",
+			fields: fields{
+				connManager: nil,
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "tag",
+				ids:  []int{1},
+			},
+			testData: nil,
+			tags:     nil,
+			want:     0,
+			wantTags: nil,
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockResult := proxy.NewMockResult(mockCtrl)
+				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("proxy.Result.RowsAffected() failed"))
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := &historyRepository{
+				connManager: tt.fields.connManager,
+			}
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, tag := range tt.tags {
+				if _, err := h.SaveTagByIdIn(tt.args.ctx, tag.Name, []int{tag.HistoryID}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+			}
+			got, err := h.SaveTagByIdIn(tt.args.ctx, tt.args.name, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("historyRepository.SaveTagByIdIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("historyRepository.SaveTagByIdIn() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			tags, err := h.FindAllTags(tt.args.ctx)
+			if err != nil {
+				t.Errorf("Failed to find all tags: %v", err)
+			}
+			if !reflect.DeepEqual(tags, tt.wantTags) {
+				t.Errorf("historyRepository.SaveTagByIdIn() : tags = %v, want %v", tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+func Test_historyRepository_UnfavoriteAll(t *testing.T) {
+	type fields struct {
+		connManager database.ConnectionManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		testData      []*historyDomain.History
+		want          int
+		wantFavorited int
+		wantErr       bool
+		setup         func(mockCtrl *gomock.Controller, tt *fields)
+		cleanup       func()
+	}{
+		{
+			name: "positive testing (no favorites)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
 				},
 			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
+			want:          0,
+			wantFavorited: 0,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
 			},
-			cleanup: nil,
 		},
 		{
-			name: "negative testing (tx.ExecContext() failed)",
+			name: "positive testing (2 favorites)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:      "test",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:      "test3",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
 				},
 			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
-			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("Tx.ExecContext() failed"))
-				mockTx.EXPECT().Rollback().Return(nil)
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
-				tt.connManager = mockConnManager
+			want:          2,
+			wantFavorited: 0,
+			wantErr:       false,
+			setup: func(_ *gomock.Controller, tt *fields) {
+				tt.connManager = initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
 			},
-			cleanup: nil,
 		},
 		{
-			name: "negative testing (result.LastInsertId() failed)",
+			name: "negative testing (getJrpDB(ctx, h.connManager) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
-				},
 			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
+			testData:      nil,
+			want:          0,
+			wantFavorited: 0,
+			wantErr:       true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockResult := proxy.NewMockResult(mockCtrl)
-				mockResult.EXPECT().LastInsertId().Return(int64(0), errors.New("Result.LastInsertId() failed"))
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
-				mockTx.EXPECT().Rollback().Return(nil)
-				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
-				mockConnection := database.NewMockDBConnection(mockCtrl)
-				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
 				tt.connManager = mockConnManager
 			},
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (tx.Commit() failed)",
+			name: "negative testing (db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false}) failed)",
 			fields: fields{
 				connManager: nil,
 			},
 			args: args{
 				ctx: context.Background(),
-				jrps: []*historyDomain.History{
-					{
-						Phrase: "test",
-						Prefix: sql.NullString{
-							String: "prefix",
-							Valid:  true,
-						},
-						Suffix: sql.NullString{
-							String: "suffix",
-							Valid:  true,
-						},
-						IsFavorited: 0,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-					},
-				},
 			},
-			testData: nil,
-			want:     nil,
-			wantErr:  true,
+			testData:      nil,
+			want:          0,
+			wantFavorited: 0,
+			wantErr:       true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
-				mockResult := proxy.NewMockResult(mockCtrl)
-				mockResult.EXPECT().LastInsertId().Return(int64(1), nil)
-				mockTx := proxy.NewMockTx(mockCtrl)
-				mockTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
-				mockTx.EXPECT().Commit().Return(errors.New("Tx.Commit() failed"))
-				mockTx.EXPECT().Rollback().Return(nil)
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(mockTx, nil)
+				expectMigrated(mockCtrl, mockDB)
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.BeginTx() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6110,30 +10446,22 @@ func Test_historyRepository_SaveAll(t *testing.T) {
 			h := &historyRepository{
 				connManager: tt.fields.connManager,
 			}
-			got, err := h.SaveAll(tt.args.ctx, tt.args.jrps)
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(tt.args.ctx, tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			got, err := h.UnfavoriteAll(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("historyRepository.SaveAll() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("historyRepository.UnfavoriteAll() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if len(got) != len(tt.want) {
-				t.Errorf("historyRepository.FindTopNByPhraseContainsOrderByIdAsc() returned %d items, want %d", len(got), len(tt.want))
-				return
+			if got != tt.want {
+				t.Errorf("historyRepository.UnfavoriteAll() = %v, want %v", got, tt.want)
 			}
-			for i := range got {
-				if got[i].ID != tt.want[i].ID {
-					t.Errorf("historyRepository.SaveAll()[%d].ID = %v, want %v", i, got[i].ID, tt.want[i].ID)
-				}
-				if got[i].Phrase != tt.want[i].Phrase {
-					t.Errorf("historyRepository.SaveAll()[%d].Phrase = %v, want %v", i, got[i].Phrase, tt.want[i].Phrase)
-				}
-				if got[i].Prefix != tt.want[i].Prefix {
-					t.Errorf("historyRepository.SaveAll()[%d].Prefix = %v, want %v", i, got[i].Prefix, tt.want[i].Prefix)
-				}
-				if got[i].Suffix != tt.want[i].Suffix {
-					t.Errorf("historyRepository.SaveAll()[%d].Suffix = %v, want %v", i, got[i].Suffix, tt.want[i].Suffix)
-				}
-				if got[i].IsFavorited != tt.want[i].IsFavorited {
-					t.Errorf("historyRepository.SaveAll()[%d].IsFavorited = %v, want %v", i, got[i].IsFavorited, tt.want[i].IsFavorited)
+			if len(tt.testData) > 0 {
+				if favorites, err := h.FindByIsFavoritedIs(tt.args.ctx, 1); err != nil || len(favorites) != tt.wantFavorited {
+					t.Errorf("historyRepository.FindByIsFavoritedIs() = %v, %v, want %v histories", favorites, err, tt.wantFavorited)
 				}
 			}
 		})
@@ -6407,9 +10735,10 @@ func Test_historyRepository_UpdateIsFavoritedByIdIn(t *testing.T) {
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6434,9 +10763,10 @@ func Test_historyRepository_UpdateIsFavoritedByIdIn(t *testing.T) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6794,9 +11124,10 @@ func Test_historyRepository_UpdateIsFavoritedByIsFavoritedIs(t *testing.T) {
 			wantErr:  true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6821,9 +11152,10 @@ func Test_historyRepository_UpdateIsFavoritedByIsFavoritedIs(t *testing.T) {
 				mockResult := proxy.NewMockResult(mockCtrl)
 				mockResult.EXPECT().RowsAffected().Return(int64(0), errors.New("Result.RowsAffected() failed"))
 				mockDB := proxy.NewMockDB(mockCtrl)
-				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				expectMigrated(mockCtrl, mockDB)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockResult, nil)
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6928,6 +11260,7 @@ func Test_getJrpDB(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
 				mockConnection.EXPECT().Open().Return(nil, errors.New("DBConnection.Open() failed"))
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6936,7 +11269,7 @@ func Test_getJrpDB(t *testing.T) {
 			cleanup: nil,
 		},
 		{
-			name: "negative testing (db.ExecContext() failed)",
+			name: "negative testing (migrate() failed)",
 			args: args{
 				ctx:         context.Background(),
 				connManager: nil,
@@ -6946,6 +11279,132 @@ func Test_getJrpDB(t *testing.T) {
 				mockDB := proxy.NewMockDB(mockCtrl)
 				mockDB.EXPECT().ExecContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("DB.ExecContext() failed"))
 				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.SQLite)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (the history table does not have the reading columns)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				conn, err := tt.connManager.GetConnection(database.JrpDB)
+				if err != nil {
+					t.Errorf("Failed to get connection: %v", err)
+				}
+				db, err := conn.Open()
+				if err != nil {
+					t.Errorf("Failed to open database: %v", err)
+				}
+				if _, err := db.ExecContext(
+					context.Background(),
+					"CREATE TABLE history (ID INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, Phrase TEXT NOT NULL, Prefix TEXT, Suffix TEXT, IsFavorited INTEGER DEFAULT 0, CreatedAt TIMESTAMP, UpdatedAt TIMESTAMP);",
+				); err != nil {
+					t.Errorf("Failed to create the history table: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (the history table does not have the word IDs column)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tt.connManager = database.NewConnectionManager(proxy.NewSql())
+				if err := tt.connManager.InitializeConnection(database.ConnectionConfig{
+					DBType: database.SQLite,
+					DBName: database.JrpDB,
+					DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+				}); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				conn, err := tt.connManager.GetConnection(database.JrpDB)
+				if err != nil {
+					t.Errorf("Failed to get connection: %v", err)
+				}
+				db, err := conn.Open()
+				if err != nil {
+					t.Errorf("Failed to open database: %v", err)
+				}
+				if _, err := db.ExecContext(
+					context.Background(),
+					"CREATE TABLE history (ID INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, Phrase TEXT NOT NULL, Reading TEXT, Romaji TEXT, Prefix TEXT, Suffix TEXT, IsFavorited INTEGER DEFAULT 0, CreatedAt TIMESTAMP, UpdatedAt TIMESTAMP);",
+				); err != nil {
+					t.Errorf("Failed to create the history table: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+
+		{
+			name: "positive testing (PostgreSQL)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.PostgreSQL)
+				mockConnection.EXPECT().Open().Return(mockDB, nil)
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (MySQL)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockDB := proxy.NewMockDB(mockCtrl)
+				expectMigrated(mockCtrl, mockDB)
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.MySQL)
 				mockConnection.EXPECT().Open().Return(mockDB, nil)
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
@@ -6953,6 +11412,22 @@ func Test_getJrpDB(t *testing.T) {
 			},
 			cleanup: nil,
 		},
+		{
+			name: "negative testing (getHistoryDialect(conn.DBType()) failed)",
+			args: args{
+				ctx:         context.Background(),
+				connManager: nil,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConnection := database.NewMockDBConnection(mockCtrl)
+				mockConnection.EXPECT().DBType().Return(database.DBType("test"))
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.JrpDB).Return(mockConnection, nil)
+				tt.connManager = mockConnManager
+			},
+			cleanup: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -6966,7 +11441,7 @@ func Test_getJrpDB(t *testing.T) {
 					tt.cleanup()
 				}
 			}()
-			_, err := getJrpDB(tt.args.ctx, tt.args.connManager)
+			_, _, err := getJrpDB(tt.args.ctx, tt.args.connManager)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("getJrpDB() error = %v, wantErr %v", err, tt.wantErr)
 				return
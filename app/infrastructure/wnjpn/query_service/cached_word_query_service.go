@@ -0,0 +1,69 @@
+package query_service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/yanosea/jrp/v2/app/application/wnjpn"
+)
+
+// cachedWordQueryService is a struct that implements the WordQueryService interface with the word cache.
+type cachedWordQueryService struct {
+	wordQueryService wnjpn.WordQueryService
+	cache            WordCache
+	version          string
+}
+
+// newCachedWordQueryService returns a new instance of the WordQueryService caching the words.
+func newCachedWordQueryService(
+	wordQueryService wnjpn.WordQueryService,
+	cache WordCache,
+	version string,
+) wnjpn.WordQueryService {
+	return &cachedWordQueryService{
+		wordQueryService: wordQueryService,
+		cache:            cache,
+		version:          version,
+	}
+}
+
+// FindByLangIsAndPosIn is a method that fetches words by lang and pos from the cache.
+// the words are fetched from the database and stored to the cache only if they are not cached.
+func (w *cachedWordQueryService) FindByLangIsAndPosIn(
+	ctx context.Context,
+	lang string,
+	pos []string,
+) ([]*wnjpn.FetchWordsDto, error) {
+	key := wordCacheKey(lang, pos)
+	if words, ok := w.cache.Load(w.version, key); ok {
+		return words, nil
+	}
+
+	words, err := w.wordQueryService.FindByLangIsAndPosIn(ctx, lang, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	// failing to store the cache must not prevent the generation.
+	_ = w.cache.Store(w.version, key, words)
+
+	return words, nil
+}
+
+// FindDefinitionsByWordIdIn is a method that fetches words with the definitions of their synsets by word ID in.
+func (w *cachedWordQueryService) FindDefinitionsByWordIdIn(
+	ctx context.Context,
+	wordIDs []int,
+) ([]*wnjpn.FetchWordDefinitionsDto, error) {
+	return w.wordQueryService.FindDefinitionsByWordIdIn(ctx, wordIDs)
+}
+
+// wordCacheKey returns the key of the word cache by lang and pos.
+func wordCacheKey(lang string, pos []string) string {
+	sorted := slices.Clone(pos)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	return lang + ":" + strings.Join(sorted, ",")
+}
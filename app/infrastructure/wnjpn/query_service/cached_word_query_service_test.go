@@ -0,0 +1,132 @@
+package query_service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/yanosea/jrp/v2/app/application/wnjpn"
+
+	"go.uber.org/mock/gomock"
+)
+
+func Test_cachedWordQueryService_FindByLangIsAndPosIn(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		lang string
+		pos  []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*wnjpn.FetchWordsDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller) (wnjpn.WordQueryService, WordCache)
+	}{
+		{
+			name: "positive testing (cached)",
+			args: args{
+				ctx:  context.Background(),
+				lang: "jpn",
+				pos:  []string{"n", "a", "v"},
+			},
+			want:    testWords,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller) (wnjpn.WordQueryService, WordCache) {
+				cache := NewMemoryWordCache()
+				if err := cache.Store("1-1", "jpn:a,n,v", testWords); err != nil {
+					t.Errorf("memoryWordCache.Store() error = %v", err)
+				}
+				return wnjpn.NewMockWordQueryService(mockCtrl), cache
+			},
+		},
+		{
+			name: "positive testing (not cached)",
+			args: args{
+				ctx:  context.Background(),
+				lang: "jpn",
+				pos:  []string{"a", "v", "n"},
+			},
+			want:    testWords,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller) (wnjpn.WordQueryService, WordCache) {
+				mockWordQueryService := wnjpn.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().FindByLangIsAndPosIn(gomock.Any(), "jpn", []string{"a", "v", "n"}).Return(testWords, nil).Times(1)
+				return mockWordQueryService, NewMemoryWordCache()
+			},
+		},
+		{
+			name: "negative testing (w.wordQueryService.FindByLangIsAndPosIn(ctx, lang, pos) failed)",
+			args: args{
+				ctx:  context.Background(),
+				lang: "jpn",
+				pos:  []string{"a", "v", "n"},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) (wnjpn.WordQueryService, WordCache) {
+				mockWordQueryService := wnjpn.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().FindByLangIsAndPosIn(gomock.Any(), "jpn", []string{"a", "v", "n"}).Return(nil, errors.New("WordQueryService.FindByLangIsAndPosIn() failed"))
+				return mockWordQueryService, NewMemoryWordCache()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			wordQueryService, cache := tt.setup(mockCtrl)
+			w := newCachedWordQueryService(wordQueryService, cache, "1-1")
+			got, err := w.FindByLangIsAndPosIn(tt.args.ctx, tt.args.lang, tt.args.pos)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cachedWordQueryService.FindByLangIsAndPosIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cachedWordQueryService.FindByLangIsAndPosIn() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			// the second call must be served from the cache.
+			got, err = w.FindByLangIsAndPosIn(tt.args.ctx, tt.args.lang, tt.args.pos)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cachedWordQueryService.FindByLangIsAndPosIn() second call = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cachedWordQueryService_FindDefinitionsByWordIdIn(t *testing.T) {
+	tests := []struct {
+		name    string
+		wordIDs []int
+		want    []*wnjpn.FetchWordDefinitionsDto
+		wantErr bool
+	}{
+		{
+			name:    "positive testing",
+			wordIDs: []int{1},
+			want:    []*wnjpn.FetchWordDefinitionsDto{{WordID: 1}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockWordQueryService := wnjpn.NewMockWordQueryService(mockCtrl)
+			mockWordQueryService.EXPECT().FindDefinitionsByWordIdIn(gomock.Any(), tt.wordIDs).Return(tt.want, nil)
+			w := newCachedWordQueryService(mockWordQueryService, NewMemoryWordCache(), "1-1")
+			got, err := w.FindDefinitionsByWordIdIn(context.Background(), tt.wordIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cachedWordQueryService.FindDefinitionsByWordIdIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cachedWordQueryService.FindDefinitionsByWordIdIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package query_service
+
+import (
+	"database/sql"
+	"encoding/gob"
+	"fmt"
+	"sync"
+
+	"github.com/yanosea/jrp/v2/app/application/wnjpn"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+var (
+	// gwc is a global word cache.
+	gwc WordCache
+	// gversion is the version of the WordNet Japan database the global word cache is built for.
+	gversion string
+	// gwcMutex is a global mutex for the word cache.
+	gwcMutex = &sync.Mutex{}
+)
+
+// WordCache is an interface that caches the words fetched from the WordNet Japan database.
+type WordCache interface {
+	Load(version string, key string) ([]*wnjpn.FetchWordsDto, bool)
+	Store(version string, key string, words []*wnjpn.FetchWordsDto) error
+}
+
+// InitializeWordCache initializes the global word cache used by the word query service.
+func InitializeWordCache(cache WordCache, version string) {
+	gwcMutex.Lock()
+	defer gwcMutex.Unlock()
+
+	gwc = cache
+	gversion = version
+}
+
+// ResetWordCache resets the global word cache.
+func ResetWordCache() {
+	gwcMutex.Lock()
+	defer gwcMutex.Unlock()
+
+	gwc = nil
+	gversion = ""
+}
+
+// getWordCache gets the global word cache and the version of the WordNet Japan database it is built for.
+func getWordCache() (WordCache, string) {
+	gwcMutex.Lock()
+	defer gwcMutex.Unlock()
+
+	return gwc, gversion
+}
+
+// GetWordCacheVersion returns the version of the WordNet Japan database file to build the word cache for.
+// the version changes when the file is downloaded again.
+func GetWordCacheVersion(os proxy.Os, dbPath string) (string, error) {
+	fileInfo, err := os.Stat(dbPath)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d-%d", fileInfo.Size(), fileInfo.ModTime().UnixNano()), nil
+}
+
+// memoryWordCache is a struct that implements the WordCache interface in memory.
+type memoryWordCache struct {
+	version string
+	words   map[string][]*wnjpn.FetchWordsDto
+	mutex   *sync.RWMutex
+}
+
+// NewMemoryWordCache returns a new instance of the WordCache caching the words in memory.
+func NewMemoryWordCache() WordCache {
+	return &memoryWordCache{
+		version: "",
+		words:   make(map[string][]*wnjpn.FetchWordsDto),
+		mutex:   &sync.RWMutex{},
+	}
+}
+
+// Load loads the cached words by the version and the key.
+func (c *memoryWordCache) Load(version string, key string) ([]*wnjpn.FetchWordsDto, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.version != version {
+		return nil, false
+	}
+	words, ok := c.words[key]
+
+	return words, ok
+}
+
+// Store stores the words by the version and the key.
+func (c *memoryWordCache) Store(version string, key string, words []*wnjpn.FetchWordsDto) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.version != version {
+		c.version = version
+		c.words = make(map[string][]*wnjpn.FetchWordsDto)
+	}
+	c.words[key] = words
+
+	return nil
+}
+
+// wordIndex is a struct that represents the compact index of the words stored in the file.
+type wordIndex struct {
+	Version string
+	Entries map[string]*wordIndexEntry
+}
+
+// wordIndexEntry is a struct that represents the words of a key stored column by column.
+type wordIndexEntry struct {
+	Lang    string
+	WordIDs []int
+	Lemmas  []string
+	Prons   []string
+	Pos     []string
+}
+
+// fileWordCache is a struct that implements the WordCache interface with the index file.
+type fileWordCache struct {
+	os     proxy.Os
+	path   string
+	index  *wordIndex
+	memory WordCache
+	mutex  *sync.Mutex
+}
+
+// NewFileWordCache returns a new instance of the WordCache caching the words in the index file of the path.
+func NewFileWordCache(os proxy.Os, path string) WordCache {
+	return &fileWordCache{
+		os:     os,
+		path:   path,
+		index:  nil,
+		memory: NewMemoryWordCache(),
+		mutex:  &sync.Mutex{},
+	}
+}
+
+// Load loads the cached words by the version and the key.
+func (c *fileWordCache) Load(version string, key string) ([]*wnjpn.FetchWordsDto, bool) {
+	if words, ok := c.memory.Load(version, key); ok {
+		return words, true
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.index == nil {
+		c.index = c.read()
+	}
+	if c.index.Version != version {
+		return nil, false
+	}
+	entry, ok := c.index.Entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	words := make([]*wnjpn.FetchWordsDto, len(entry.WordIDs))
+	for i := range entry.WordIDs {
+		words[i] = &wnjpn.FetchWordsDto{
+			WordID: entry.WordIDs[i],
+			Lang:   toNullString(entry.Lang),
+			Lemma:  toNullString(entry.Lemmas[i]),
+			Pron:   toNullString(entry.Prons[i]),
+			Pos:    toNullString(entry.Pos[i]),
+		}
+	}
+	if err := c.memory.Store(version, key, words); err != nil {
+		return nil, false
+	}
+
+	return words, true
+}
+
+// Store stores the words by the version and the key and writes them to the index file.
+func (c *fileWordCache) Store(version string, key string, words []*wnjpn.FetchWordsDto) error {
+	if err := c.memory.Store(version, key, words); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.index == nil {
+		c.index = c.read()
+	}
+	if c.index.Version != version {
+		c.index = &wordIndex{
+			Version: version,
+			Entries: make(map[string]*wordIndexEntry),
+		}
+	}
+
+	entry := &wordIndexEntry{
+		WordIDs: make([]int, len(words)),
+		Lemmas:  make([]string, len(words)),
+		Prons:   make([]string, len(words)),
+		Pos:     make([]string, len(words)),
+	}
+	for i, word := range words {
+		entry.Lang = word.Lang.String
+		entry.WordIDs[i] = word.WordID
+		entry.Lemmas[i] = word.Lemma.String
+		entry.Prons[i] = word.Pron.String
+		entry.Pos[i] = word.Pos.String
+	}
+	c.index.Entries[key] = entry
+
+	return c.write()
+}
+
+// read reads the index file and returns an empty index if it can not be read.
+func (c *fileWordCache) read() *wordIndex {
+	index := &wordIndex{
+		Version: "",
+		Entries: make(map[string]*wordIndexEntry),
+	}
+
+	file, err := c.os.Open(c.path)
+	if err != nil {
+		return index
+	}
+
+	var read wordIndex
+	err = gob.NewDecoder(file).Decode(&read)
+	if closeErr := file.Close(); err != nil || closeErr != nil || read.Entries == nil {
+		return index
+	}
+
+	return &read
+}
+
+// write writes the index to the temporary file and replaces the index file with it.
+func (c *fileWordCache) write() error {
+	tempPath := c.path + ".tmp"
+	file, err := c.os.Create(tempPath)
+	if err != nil {
+		return err
+	}
+
+	if err := gob.NewEncoder(file).Encode(c.index); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return c.os.Rename(tempPath, c.path)
+}
+
+// toNullString converts the string into the sql.NullString that is valid if it is not empty.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,335 @@
+package query_service
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yanosea/jrp/v2/app/application/wnjpn"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testWords = []*wnjpn.FetchWordsDto{
+		{
+			WordID: 1,
+			Lang:   sql.NullString{String: "jpn", Valid: true},
+			Lemma:  sql.NullString{String: "美しい", Valid: true},
+			Pron:   sql.NullString{String: "ウツクシイ", Valid: true},
+			Pos:    sql.NullString{String: "a", Valid: true},
+		},
+		{
+			WordID: 2,
+			Lang:   sql.NullString{String: "jpn", Valid: true},
+			Lemma:  sql.NullString{String: "犬", Valid: true},
+			Pron:   sql.NullString{String: "", Valid: false},
+			Pos:    sql.NullString{String: "n", Valid: true},
+		},
+	}
+)
+
+func TestInitializeWordCache(t *testing.T) {
+	type args struct {
+		cache   WordCache
+		version string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cache:   NewMemoryWordCache(),
+				version: "1-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer ResetWordCache()
+			InitializeWordCache(tt.args.cache, tt.args.version)
+			if cache, version := getWordCache(); cache != tt.args.cache || version != tt.args.version {
+				t.Errorf("getWordCache() = %v, %v, want %v, %v", cache, version, tt.args.cache, tt.args.version)
+			}
+			ResetWordCache()
+			if cache, version := getWordCache(); cache != nil || version != "" {
+				t.Errorf("getWordCache() after ResetWordCache() = %v, %v, want nil, \"\"", cache, version)
+			}
+		})
+	}
+}
+
+func TestGetWordCacheVersion(t *testing.T) {
+	dbPath := filepath.Join(os.TempDir(), "jrp_word_cache_version_test.db")
+	if err := os.WriteFile(dbPath, []byte("test"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	defer os.Remove(dbPath)
+
+	type args struct {
+		dbPath string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				dbPath: dbPath,
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (os.Stat(dbPath) failed)",
+			args: args{
+				dbPath: filepath.Join(os.TempDir(), "jrp_word_cache_version_test_not_exist.db"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetWordCacheVersion(proxy.NewOs(), tt.args.dbPath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetWordCacheVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == "" {
+				t.Errorf("GetWordCacheVersion() = %v, want not empty", got)
+			}
+		})
+	}
+}
+
+func Test_memoryWordCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		storeKey string
+		storeVer string
+		loadKey  string
+		loadVer  string
+		want     []*wnjpn.FetchWordsDto
+		wantOk   bool
+	}{
+		{
+			name:     "positive testing (cached)",
+			storeKey: "jpn:a,n,v",
+			storeVer: "1-1",
+			loadKey:  "jpn:a,n,v",
+			loadVer:  "1-1",
+			want:     testWords,
+			wantOk:   true,
+		},
+		{
+			name:     "positive testing (key is not cached)",
+			storeKey: "jpn:a,n,v",
+			storeVer: "1-1",
+			loadKey:  "jpn:n",
+			loadVer:  "1-1",
+			want:     nil,
+			wantOk:   false,
+		},
+		{
+			name:     "positive testing (version is changed)",
+			storeKey: "jpn:a,n,v",
+			storeVer: "1-1",
+			loadKey:  "jpn:a,n,v",
+			loadVer:  "2-2",
+			want:     nil,
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMemoryWordCache()
+			if err := c.Store(tt.storeVer, tt.storeKey, testWords); err != nil {
+				t.Errorf("memoryWordCache.Store() error = %v", err)
+			}
+			got, ok := c.Load(tt.loadVer, tt.loadKey)
+			if ok != tt.wantOk {
+				t.Errorf("memoryWordCache.Load() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("memoryWordCache.Load() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fileWordCache(t *testing.T) {
+	indexPath := filepath.Join(os.TempDir(), "jrp_word_cache_test.idx")
+
+	type args struct {
+		version string
+		key     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*wnjpn.FetchWordsDto
+		wantOk  bool
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing (loaded from the index file written by another cache)",
+			args: args{
+				version: "1-1",
+				key:     "jpn:a,n,v",
+			},
+			want:   testWords,
+			wantOk: true,
+			setup: func() {
+				if err := NewFileWordCache(proxy.NewOs(), indexPath).Store("1-1", "jpn:a,n,v", testWords); err != nil {
+					t.Errorf("fileWordCache.Store() error = %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := os.Remove(indexPath); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove the index file: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (the index file does not exist)",
+			args: args{
+				version: "1-1",
+				key:     "jpn:a,n,v",
+			},
+			want:    nil,
+			wantOk:  false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (the index file is built for another version)",
+			args: args{
+				version: "2-2",
+				key:     "jpn:a,n,v",
+			},
+			want:   nil,
+			wantOk: false,
+			setup: func() {
+				if err := NewFileWordCache(proxy.NewOs(), indexPath).Store("1-1", "jpn:a,n,v", testWords); err != nil {
+					t.Errorf("fileWordCache.Store() error = %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := os.Remove(indexPath); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove the index file: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (the index file is broken)",
+			args: args{
+				version: "1-1",
+				key:     "jpn:a,n,v",
+			},
+			want:   nil,
+			wantOk: false,
+			setup: func() {
+				if err := os.WriteFile(indexPath, []byte("test"), 0644); err != nil {
+					t.Errorf("Failed to write the index file: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := os.Remove(indexPath); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove the index file: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			c := NewFileWordCache(proxy.NewOs(), indexPath)
+			got, ok := c.Load(tt.args.version, tt.args.key)
+			if ok != tt.wantOk {
+				t.Errorf("fileWordCache.Load() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fileWordCache.Load() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_fileWordCache_Store(t *testing.T) {
+	indexPath := filepath.Join(os.TempDir(), "jrp_word_cache_test.idx")
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller) proxy.Os
+	}{
+		{
+			name:    "positive testing",
+			wantErr: false,
+			setup: func(_ *gomock.Controller) proxy.Os {
+				return proxy.NewOs()
+			},
+		},
+		{
+			name:    "negative testing (os.Create() failed)",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) proxy.Os {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().Open(indexPath).Return(nil, errors.New("Os.Open() failed"))
+				mockOs.EXPECT().Create(indexPath+".tmp").Return(nil, errors.New("Os.Create() failed"))
+				return mockOs
+			},
+		},
+		{
+			name:    "negative testing (os.Rename() failed)",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) proxy.Os {
+				file, err := os.Create(indexPath + ".tmp")
+				if err != nil {
+					t.Fatalf("Failed to create the temporary file: %v", err)
+				}
+				mockFile := proxy.NewMockFile(mockCtrl)
+				mockFile.EXPECT().Write(gomock.Any()).DoAndReturn(file.Write).AnyTimes()
+				mockFile.EXPECT().Close().DoAndReturn(file.Close)
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().Open(indexPath).Return(nil, errors.New("Os.Open() failed"))
+				mockOs.EXPECT().Create(indexPath+".tmp").Return(mockFile, nil)
+				mockOs.EXPECT().Rename(indexPath+".tmp", indexPath).Return(errors.New("Os.Rename() failed"))
+				return mockOs
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			defer func() {
+				for _, path := range []string{indexPath, indexPath + ".tmp"} {
+					if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+						t.Errorf("Failed to remove the index file: %v", err)
+					}
+				}
+			}()
+			c := NewFileWordCache(tt.setup(mockCtrl), indexPath)
+			if err := c.Store("1-1", "jpn:a,n,v", testWords); (err != nil) != tt.wantErr {
+				t.Errorf("fileWordCache.Store() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -15,10 +15,17 @@ type wordQueryService struct {
 }
 
 // NewWordQueryService returns a new instance of the WordQueryService struct.
+// it returns the one caching the words if the word cache is initialized.
 func NewWordQueryService() wnjpn.WordQueryService {
-	return &wordQueryService{
+	wordQueryService := &wordQueryService{
 		connManager: database.GetConnectionManager(),
 	}
+
+	if cache, version := getWordCache(); cache != nil {
+		return newCachedWordQueryService(wordQueryService, cache, version)
+	}
+
+	return wordQueryService
 }
 
 // FindByLangAndPosIn is a method that fetches words by lang and pos.
@@ -75,3 +82,57 @@ func (w *wordQueryService) FindByLangIsAndPosIn(
 
 	return words, deferErr
 }
+
+// FindDefinitionsByWordIdIn is a method that fetches words with the definitions of their synsets by word ID in.
+func (w *wordQueryService) FindDefinitionsByWordIdIn(
+	ctx context.Context,
+	wordIDs []int,
+) ([]*wnjpn.FetchWordDefinitionsDto, error) {
+	var deferErr error
+	conn, err := w.connManager.GetConnection(database.WNJpnDB)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := conn.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	placeholders := make([]string, len(wordIDs))
+	params := make([]interface{}, 0, len(wordIDs))
+	for i, wordID := range wordIDs {
+		placeholders[i] = "?"
+		params = append(params, wordID)
+	}
+
+	query := fmt.Sprintf(FindDefinitionsByWordIdInQuery, strings.Join(placeholders, ","))
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		deferErr = rows.Close()
+	}()
+
+	words := make([]*wnjpn.FetchWordDefinitionsDto, 0)
+	for rows.Next() {
+		word := &wnjpn.FetchWordDefinitionsDto{}
+		if err := rows.Scan(
+			&word.WordID,
+			&word.Lang,
+			&word.Lemma,
+			&word.Pron,
+			&word.Pos,
+			&word.Synset,
+			&word.Name,
+			&word.Definition,
+			&word.Gloss,
+		); err != nil {
+			return nil, err
+		}
+		words = append(words, word)
+	}
+
+	return words, deferErr
+}
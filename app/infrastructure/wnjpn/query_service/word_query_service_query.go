@@ -15,6 +15,38 @@ FROM
     word
 WHERE
     word.Lang = ?
-    AND word.Pos IN (%s);
+    AND word.Pos IN (%s)
+ORDER BY
+    word.WordID ASC;
+`
+	// FindDefinitionsByWordIdInQuery is a query that finds the records from the word table with the definitions of their synsets by word ID in.
+	FindDefinitionsByWordIdInQuery = `
+SELECT
+    word.WordID
+    , word.Lang
+    , word.Lemma
+    , word.Pron
+    , word.Pos
+    , sense.Synset
+    , synset.Name
+    , jpn_def.Def
+    , eng_def.Def
+FROM
+    word
+    LEFT JOIN sense
+        ON sense.WordID = word.WordID
+    LEFT JOIN synset
+        ON synset.Synset = sense.Synset
+    LEFT JOIN synset_def AS jpn_def
+        ON jpn_def.Synset = sense.Synset
+        AND jpn_def.Lang = 'jpn'
+    LEFT JOIN synset_def AS eng_def
+        ON eng_def.Synset = sense.Synset
+        AND eng_def.Lang = 'eng'
+WHERE
+    word.WordID IN (%s)
+ORDER BY
+    word.WordID ASC
+    , sense.Synset ASC;
 `
 )
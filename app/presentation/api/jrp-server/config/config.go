@@ -37,12 +37,16 @@ func NewJrpServerConfigurator(
 // JrpServerConfig is a struct that contains the configuration of the Jrp server application.
 type JrpServerConfig struct {
 	baseConfig.JrpConfig
-	JrpPort string
+	JrpDBType database.DBType
+	JrpDBDsn  string
+	JrpPort   string
 }
 
 // envConfig is a struct that contains the environment variables.
 type envConfig struct {
 	JrpPort     string          `envconfig:"JRP_SERVER_PORT" default:"8080"`
+	JrpDBType   database.DBType `envconfig:"JRP_SERVER_DB_TYPE" default:"sqlite"`
+	JrpDBDsn    string          `envconfig:"JRP_SERVER_DB" default:"XDG_DATA_HOME/jrp/jrp.db"`
 	WnJpnDBType database.DBType `envconfig:"JRP_SERVER_WNJPN_DB_TYPE" default:"sqlite"`
 	WnJpnDBDsn  string          `envconfig:"JRP_SERVER_WNJPN_DB" default:"XDG_DATA_HOME/jrp/wnjpn.db"`
 }
@@ -59,25 +63,43 @@ func (c *ServerConfigurator) GetConfig() (*JrpServerConfig, error) {
 			WNJpnDBType: env.WnJpnDBType,
 			WNJpnDBDsn:  env.WnJpnDBDsn,
 		},
-		JrpPort: env.JrpPort,
+		JrpDBType: env.JrpDBType,
+		JrpDBDsn:  env.JrpDBDsn,
+		JrpPort:   env.JrpPort,
 	}
 
-	if config.WNJpnDBType == database.SQLite {
+	if config.JrpDBType == database.SQLite || config.WNJpnDBType == database.SQLite {
 		xdgDataHome, err := c.FileUtil.GetXDGDataHome()
 		if err != nil {
 			return nil, err
 		}
 
-		config.WNJpnDBDsn = strings.Replace(
-			config.WNJpnDBDsn,
-			"XDG_DATA_HOME",
-			xdgDataHome,
-			1,
-		)
-		if err := c.FileUtil.MkdirIfNotExist(
-			filepath.Dir(config.WNJpnDBDsn),
-		); err != nil {
-			return nil, err
+		if config.JrpDBType == database.SQLite {
+			config.JrpDBDsn = strings.Replace(
+				config.JrpDBDsn,
+				"XDG_DATA_HOME",
+				xdgDataHome,
+				1,
+			)
+			if err := c.FileUtil.MkdirIfNotExist(
+				filepath.Dir(config.JrpDBDsn),
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		if config.WNJpnDBType == database.SQLite {
+			config.WNJpnDBDsn = strings.Replace(
+				config.WNJpnDBDsn,
+				"XDG_DATA_HOME",
+				xdgDataHome,
+				1,
+			)
+			if err := c.FileUtil.MkdirIfNotExist(
+				filepath.Dir(config.WNJpnDBDsn),
+			); err != nil {
+				return nil, err
+			}
 		}
 	}
 
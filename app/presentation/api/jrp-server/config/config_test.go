@@ -77,19 +77,25 @@ func TestServerConfigurator_GetConfig(t *testing.T) {
 					WNJpnDBType: database.SQLite,
 					WNJpnDBDsn:  "~/.local/share/jrp/wnjpn.db",
 				},
+				JrpDBType: database.SQLite,
+				JrpDBDsn:  "~/.local/share/jrp/jrp.db",
+				JrpPort:   "8080",
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
 				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
 				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
 					func(_ string, cfg *envConfig) error {
+						cfg.JrpPort = "8080"
+						cfg.JrpDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
 						cfg.WnJpnDBType = database.SQLite
 						cfg.WnJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
 						return nil
 					})
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
-				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil).Times(2)
 				tt.BaseConfigurator.Envconfig = mockEnvconfig
 				tt.BaseConfigurator.FileUtil = mockFileUtil
 			},
@@ -157,6 +163,33 @@ func TestServerConfigurator_GetConfig(t *testing.T) {
 				tt.BaseConfigurator.FileUtil = mockFileUtil
 			},
 		},
+		{
+			name: "negative testing (c.FileUtil.MkdirIfNotExist(filepath.Dir(config.JrpDBDsn)) failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.JrpDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
+						cfg.WnJpnDBType = database.SQLite
+						cfg.WnJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
+						return nil
+					})
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(errors.New("FileUtil.MkdirIfNotExist() failed"))
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
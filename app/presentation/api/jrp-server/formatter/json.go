@@ -2,6 +2,7 @@ package formatter
 
 import (
 	"errors"
+	"time"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
 
@@ -22,6 +23,37 @@ type ResponseOutputDto struct {
 type JrpJsonOutputDto struct {
 	// @Description Generated Japanese phrase
 	Phrase string `json:"phrase"`
+	// @Description Reading of the generated Japanese phrase in hiragana
+	Reading string `json:"reading"`
+	// @Description Reading of the generated Japanese phrase in romaji
+	Romaji string `json:"romaji"`
+	// @Description IDs of the words in WordNet Japan the phrase is generated from
+	WordIDs []int `json:"word_ids"`
+}
+
+// @Description response format for the histories of jrp
+// HistoryJsonOutputDto is a struct that represents the output json of the histories of jrp server.
+type HistoryJsonOutputDto struct {
+	// @Description ID of the history
+	ID int `json:"id"`
+	// @Description Generated Japanese phrase
+	Phrase string `json:"phrase"`
+	// @Description Reading of the generated Japanese phrase in hiragana
+	Reading string `json:"reading"`
+	// @Description Reading of the generated Japanese phrase in romaji
+	Romaji string `json:"romaji"`
+	// @Description IDs of the words in WordNet Japan the phrase is generated from
+	WordIDs []int `json:"word_ids"`
+	// @Description Prefix when the phrase is generated
+	Prefix string `json:"prefix"`
+	// @Description Suffix when the phrase is generated
+	Suffix string `json:"suffix"`
+	// @Description Whether the phrase is favorited
+	IsFavorited bool `json:"is_favorited"`
+	// @Description Timestamp when the phrase is created
+	CreatedAt time.Time `json:"created_at"`
+	// @Description Timestamp when the phrase is updated
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 var (
@@ -40,16 +72,80 @@ func (f *JsonFormatter) Format(result interface{}) ([]byte, error) {
 	var err error
 	switch v := result.(type) {
 	case *jrpApp.GenerateJrpUseCaseOutputDto:
-		jjoDto := JrpJsonOutputDto{Phrase: v.Phrase}
-		gjj, err := Ju.Marshal(jjoDto)
+		gjj, err := Ju.Marshal(toJrpJsonOutputDto(v))
 		if err != nil {
 			return nil, err
 		}
 		gjroDto := ResponseOutputDto{Body: gjj}
 		formatted = gjroDto.Body
+	case []*jrpApp.GenerateJrpUseCaseOutputDto:
+		jjoDtos := make([]JrpJsonOutputDto, 0, len(v))
+		for _, gjoDto := range v {
+			jjoDtos = append(jjoDtos, toJrpJsonOutputDto(gjoDto))
+		}
+		gjj, err := Ju.Marshal(jjoDtos)
+		if err != nil {
+			return nil, err
+		}
+		gjroDto := ResponseOutputDto{Body: gjj}
+		formatted = gjroDto.Body
+	case []*jrpApp.GetHistoryUseCaseOutputDto:
+		hjoDtos := make([]HistoryJsonOutputDto, 0, len(v))
+		for _, ghoDto := range v {
+			hjoDtos = append(hjoDtos, HistoryJsonOutputDto{
+				ID:          ghoDto.ID,
+				Phrase:      ghoDto.Phrase,
+				Reading:     ghoDto.Reading,
+				Romaji:      ghoDto.Romaji,
+				WordIDs:     ghoDto.WordIDs,
+				Prefix:      ghoDto.Prefix,
+				Suffix:      ghoDto.Suffix,
+				IsFavorited: ghoDto.IsFavorited == 1,
+				CreatedAt:   ghoDto.CreatedAt,
+				UpdatedAt:   ghoDto.UpdatedAt,
+			})
+		}
+		hj, err := Ju.Marshal(hjoDtos)
+		if err != nil {
+			return nil, err
+		}
+		hroDto := ResponseOutputDto{Body: hj}
+		formatted = hroDto.Body
+	case []*jrpApp.SearchHistoryUseCaseOutputDto:
+		hjoDtos := make([]HistoryJsonOutputDto, 0, len(v))
+		for _, shoDto := range v {
+			hjoDtos = append(hjoDtos, HistoryJsonOutputDto{
+				ID:          shoDto.ID,
+				Phrase:      shoDto.Phrase,
+				Reading:     shoDto.Reading,
+				Romaji:      shoDto.Romaji,
+				WordIDs:     shoDto.WordIDs,
+				Prefix:      shoDto.Prefix,
+				Suffix:      shoDto.Suffix,
+				IsFavorited: shoDto.IsFavorited == 1,
+				CreatedAt:   shoDto.CreatedAt,
+				UpdatedAt:   shoDto.UpdatedAt,
+			})
+		}
+		hj, err := Ju.Marshal(hjoDtos)
+		if err != nil {
+			return nil, err
+		}
+		hroDto := ResponseOutputDto{Body: hj}
+		formatted = hroDto.Body
 	default:
 		formatted = nil
 		err = errors.New("invalid result type")
 	}
 	return formatted, err
 }
+
+// toJrpJsonOutputDto converts the output of the generate jrp use case into the output json of jrp server.
+func toJrpJsonOutputDto(gjoDto *jrpApp.GenerateJrpUseCaseOutputDto) JrpJsonOutputDto {
+	return JrpJsonOutputDto{
+		Phrase:  gjoDto.Phrase,
+		Reading: gjoDto.Reading,
+		Romaji:  gjoDto.Romaji,
+		WordIDs: gjoDto.WordIDs,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
 
@@ -51,10 +52,13 @@ func TestJsonFormatter_Format(t *testing.T) {
 			name: "positive testing (result is *jrpApp.GenerateJrpUseCaseOutputDto)",
 			args: args{
 				result: &jrpApp.GenerateJrpUseCaseOutputDto{
-					Phrase: "test",
+					Phrase:  "test",
+					Reading: "てすと",
+					Romaji:  "tesuto",
+					WordIDs: []int{1, 2},
 				},
 			},
-			want:    []byte(`{"phrase":"test"}`),
+			want:    []byte(`{"phrase":"test","reading":"てすと","romaji":"tesuto","word_ids":[1,2]}`),
 			wantErr: false,
 			setup:   nil,
 			cleanup: nil,
@@ -87,6 +91,145 @@ func TestJsonFormatter_Format(t *testing.T) {
 			setup:   nil,
 			cleanup: nil,
 		},
+		{
+			name: "positive testing (result is []*jrpApp.GenerateJrpUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						Phrase:  "test1",
+						Reading: "てすといち",
+						Romaji:  "tesutoichi",
+						WordIDs: []int{1, 2},
+					},
+					{
+						Phrase:  "test2",
+						Reading: "てすとに",
+						Romaji:  "tesutoni",
+						WordIDs: []int{3},
+					},
+				},
+			},
+			want:    []byte(`[{"phrase":"test1","reading":"てすといち","romaji":"tesutoichi","word_ids":[1,2]},{"phrase":"test2","reading":"てすとに","romaji":"tesutoni","word_ids":[3]}]`),
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is empty []*jrpApp.GenerateJrpUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{},
+			},
+			want:    []byte(`[]`),
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result is []*jrpApp.GenerateJrpUseCaseOutputDto, Ju.Marshal(jjoDtos) failed)",
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						Phrase: "test",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetHistoryUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:          1,
+						Phrase:      "test",
+						Reading:     "てすと",
+						Romaji:      "tesuto",
+						WordIDs:     []int{1, 2},
+						Prefix:      "",
+						Suffix:      "",
+						IsFavorited: 1,
+						CreatedAt:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			want:    []byte(`[{"id":1,"phrase":"test","reading":"てすと","romaji":"tesuto","word_ids":[1,2],"prefix":"","suffix":"","is_favorited":true,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}]`),
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result is []*jrpApp.GetHistoryUseCaseOutputDto, Ju.Marshal(hjoDtos) failed)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						Phrase: "test",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:          2,
+						Phrase:      "test",
+						Reading:     "",
+						Romaji:      "",
+						WordIDs:     nil,
+						Prefix:      "test",
+						Suffix:      "",
+						IsFavorited: 0,
+						CreatedAt:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			want:    []byte(`[{"id":2,"phrase":"test","reading":"","romaji":"","word_ids":null,"prefix":"test","suffix":"","is_favorited":false,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}]`),
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto, Ju.Marshal(hjoDtos) failed)",
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						Phrase: "test",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -19,6 +19,9 @@ func Test_main(t *testing.T) {
 	if err := os.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(os.TempDir(), "wnjpn.db")); err != nil {
 		t.Fatalf("failed to set env var: %v", err)
 	}
+	if err := os.Setenv("JRP_SERVER_DB", filepath.Join(os.TempDir(), "jrp.db")); err != nil {
+		t.Fatalf("failed to set env var: %v", err)
+	}
 	origExit := exit
 	exit = func(code int) {}
 	defer func() {
@@ -36,6 +39,11 @@ func Test_main(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockGroup := proxy.NewMockGroup(mockCtrl)
 				mockGroup.EXPECT().GET("/jrp", gomock.Any())
+				mockGroup.EXPECT().GET("/histories", gomock.Any())
+				mockGroup.EXPECT().GET("/histories/search", gomock.Any())
+				mockGroup.EXPECT().DELETE("/histories", gomock.Any())
+				mockGroup.EXPECT().POST("/histories/:id/favorite", gomock.Any())
+				mockGroup.EXPECT().DELETE("/histories/:id/favorite", gomock.Any())
 				mockEcho := proxy.NewMockEcho(mockCtrl)
 				mockEcho.EXPECT().Use(gomock.Any())
 				mockEcho.EXPECT().Use(gomock.Any())
@@ -0,0 +1,57 @@
+package history
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// BindFavoriteHandler binds the favorite handler to the server.
+func BindFavoriteHandler(g proxy.Group) {
+	g.POST("/histories/:id/favorite", favorite)
+}
+
+// @Summary favorite the history.
+// @Description favorites the history of the generated Japanese phrase.
+// @Tags history
+// @Param id path int true "ID of the history to favorite"
+// @Success 204 "favorited"
+// @Failure 400 "invalid id"
+// @Failure 404 "no histories to favorite"
+// @Router /histories/{id}/favorite [post]
+// favorite is a handler that favorites the history.
+func favorite(c echo.Context) error {
+	if err := checkJrpDBConnection(); err != nil {
+		log.Error("Failed to get a connection to the database...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Error("Invalid id...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	fuc := jrpApp.NewFavoriteUseCase(historyRepo)
+
+	if err := fuc.Run(
+		c.Request().Context(),
+		[]int{id},
+		false,
+	); err != nil && err.Error() == "no histories to favorite" {
+		return c.NoContent(http.StatusNotFound)
+	} else if err != nil {
+		log.Error("Failed to favorite the history...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+package history
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/api/jrp-server/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// BindGetHistoryHandler binds the getHistory handler to the server.
+func BindGetHistoryHandler(g proxy.Group) {
+	g.GET("/histories", getHistory)
+}
+
+// @Summary get the histories.
+// @Description returns the histories of the generated Japanese phrases.
+// @Tags history
+// @Produce json
+// @Param number query int false "number of histories to get (default 10, e.g. : 50)"
+// @Param all query bool false "get all the histories"
+// @Param favorited query bool false "get only favorited histories"
+// @Param offset query int false "number of the most recent histories to skip (e.g. : 50)"
+// @Param cursor query int false "ID the histories to get are older than, the smallest ID of the previous page (e.g. : 120)"
+// @Success 200 {array} formatter.HistoryJsonOutputDto
+// @Failure 400 "invalid number, all, favorited, offset or cursor"
+// @Router /histories [get]
+// getHistory is a handler that returns the histories.
+func getHistory(c echo.Context) error {
+	if err := checkJrpDBConnection(); err != nil {
+		log.Error("Failed to get a connection to the database...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	number, err := queryNumber(c)
+	if err != nil {
+		log.Error("Invalid number...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	all, err := queryBool(c, "all")
+	if err != nil {
+		log.Error("Invalid all...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	favorited, err := queryBool(c, "favorited")
+	if err != nil {
+		log.Error("Invalid favorited...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	offset, err := queryNonNegative(c, "offset")
+	if err != nil {
+		log.Error("Invalid offset...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	cursor, err := queryNonNegative(c, "cursor")
+	if err != nil {
+		log.Error("Invalid cursor...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	f, err := formatter.NewFormatter(format)
+	if err != nil {
+		log.Error("Invalid format...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	ghuc := jrpApp.NewGetHistoryUseCase(historyRepo)
+
+	ghoDtos, err := ghuc.Run(
+		c.Request().Context(),
+		&jrpApp.GetHistoryUseCaseInputDto{
+			All:       all,
+			Favorited: favorited,
+			Number:    number,
+			Offset:    offset,
+			Cursor:    cursor,
+		},
+	)
+	if err != nil {
+		log.Error("Failed to get the histories...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	if ghoDtos == nil {
+		ghoDtos = []*jrpApp.GetHistoryUseCaseOutputDto{}
+	}
+
+	body, err := f.Format(ghoDtos)
+	if body == nil || err != nil {
+		log.Error("Failed to format the output...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	return c.JSONBlob(http.StatusOK, body)
+}
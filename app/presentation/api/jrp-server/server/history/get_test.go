@@ -0,0 +1,169 @@
+package history
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBindGetHistoryHandler(t *testing.T) {
+	type args struct {
+		g proxy.Group
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				g: nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().GET("/histories", gomock.Any())
+				tt.g = mockGroup
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			BindGetHistoryHandler(tt.args.g)
+		})
+	}
+}
+
+func Test_getHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		want   int
+		setup  func(t *testing.T)
+	}{
+		{
+			name:   "positive testing",
+			target: "/api/histories",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (all and favorited are specified)",
+			target: "/api/histories?all=true&favorited=true",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (offset and cursor are specified)",
+			target: "/api/histories?number=1&offset=1&cursor=3",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (no histories)",
+			target: "/api/histories",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, nil)
+			},
+		},
+		{
+			name:   "negative testing (connection is not initialized)",
+			target: "/api/histories",
+			want:   http.StatusInternalServerError,
+			setup:  nil,
+		},
+		{
+			name:   "negative testing (number is invalid)",
+			target: "/api/histories?number=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (all is invalid)",
+			target: "/api/histories?all=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (favorited is invalid)",
+			target: "/api/histories?favorited=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (offset is invalid)",
+			target: "/api/histories?offset=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (cursor is negative)",
+			target: "/api/histories?cursor=-1",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (formatter.NewFormatter(format) failed)",
+			target: "/api/histories",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+				origFormat := format
+				format = "test"
+				t.Cleanup(func() {
+					format = origFormat
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+			}()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, tt.target, nil), rec)
+			if err := getHistory(c); err != nil {
+				t.Errorf("getHistory() error = %v", err)
+			}
+			if rec.Code != tt.want {
+				t.Errorf("getHistory() status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package history provides the entry points for the histories of the Jrp server client.
+package history
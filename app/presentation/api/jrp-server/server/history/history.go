@@ -0,0 +1,97 @@
+package history
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+)
+
+var (
+	// format is the default format of the response for injecting the dependencies in testing.
+	format = "json"
+)
+
+const (
+	// defaultNumber is the default number of histories to get.
+	defaultNumber = 10
+)
+
+// checkJrpDBConnection checks the connection to the jrp database is initialized.
+func checkJrpDBConnection() error {
+	connManager := database.GetConnectionManager()
+	if connManager == nil {
+		return errors.New("connection manager is not initialized")
+	}
+
+	if _, err := connManager.GetConnection(database.JrpDB); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// queryBool returns the boolean query parameter of the name.
+// it returns false if the parameter is not specified.
+func queryBool(c echo.Context, name string) (bool, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(v)
+}
+
+// queryNumber returns the number of histories specified by the query parameter.
+// it returns the default number if the parameter is not specified.
+func queryNumber(c echo.Context) (int, error) {
+	v := c.QueryParam("number")
+	if v == "" {
+		return defaultNumber, nil
+	}
+
+	number, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, err
+	}
+	if number < 1 {
+		return 0, errors.New("number must be greater than 0")
+	}
+
+	return number, nil
+}
+
+// queryNonNegative returns the non-negative integer query parameter of the name.
+// it returns 0 if the parameter is not specified.
+func queryNonNegative(c echo.Context, name string) (int, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, errors.New(name + " must not be negative")
+	}
+
+	return n, nil
+}
+
+// queryIDs returns the IDs of the histories specified by the query parameters.
+func queryIDs(c echo.Context) ([]int, error) {
+	var ids []int
+	for _, v := range c.QueryParams()["id"] {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
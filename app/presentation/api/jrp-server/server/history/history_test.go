@@ -0,0 +1,174 @@
+package history
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// initializeJrpDB initializes the connection to the jrp database in the temporary directory and saves the histories.
+func initializeJrpDB(t *testing.T, shiDtos []*jrpApp.SaveHistoryUseCaseInputDto) {
+	t.Helper()
+	cm := database.NewConnectionManager(proxy.NewSql())
+	if err := cm.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.JrpDB,
+			DBType: database.SQLite,
+			DSN:    filepath.Join(t.TempDir(), "jrp.db"),
+		},
+	); err != nil {
+		t.Errorf("Failed to initialize connection: %v", err)
+	}
+	if len(shiDtos) == 0 {
+		return
+	}
+	shuc := jrpApp.NewSaveHistoryUseCase(repository.NewHistoryRepository())
+	if _, err := shuc.Run(context.Background(), shiDtos); err != nil {
+		t.Errorf("Failed to save histories: %v", err)
+	}
+}
+
+// testHistories returns the histories for testing.
+func testHistories() []*jrpApp.SaveHistoryUseCaseInputDto {
+	now := time.Now()
+	return []*jrpApp.SaveHistoryUseCaseInputDto{
+		{
+			Phrase:      "test1",
+			IsFavorited: 0,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "test2",
+			IsFavorited: 1,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+}
+
+func Test_checkJrpDBConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+		setup   func(t *testing.T)
+	}{
+		{
+			name:    "positive testing",
+			wantErr: false,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, nil)
+			},
+		},
+		{
+			name:    "negative testing (connManager == nil)",
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (connManager.GetConnection(database.JrpDB) failed)",
+			wantErr: true,
+			setup: func(t *testing.T) {
+				_ = database.NewConnectionManager(proxy.NewSql())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+			}()
+			if err := checkJrpDBConnection(); (err != nil) != tt.wantErr {
+				t.Errorf("checkJrpDBConnection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_queryHelpers(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		wantBool      bool
+		wantBoolErr   bool
+		wantNumber    int
+		wantNumberErr bool
+		wantIDs       []int
+		wantIDsErr    bool
+	}{
+		{
+			name:          "positive testing (no parameters)",
+			target:        "/api/histories",
+			wantBool:      false,
+			wantBoolErr:   false,
+			wantNumber:    defaultNumber,
+			wantNumberErr: false,
+			wantIDs:       nil,
+			wantIDsErr:    false,
+		},
+		{
+			name:          "positive testing (parameters are specified)",
+			target:        "/api/histories?all=true&number=5&id=1&id=3",
+			wantBool:      true,
+			wantBoolErr:   false,
+			wantNumber:    5,
+			wantNumberErr: false,
+			wantIDs:       []int{1, 3},
+			wantIDsErr:    false,
+		},
+		{
+			name:          "negative testing (parameters are invalid)",
+			target:        "/api/histories?all=test&number=test&id=test",
+			wantBool:      false,
+			wantBoolErr:   true,
+			wantNumber:    0,
+			wantNumberErr: true,
+			wantIDs:       nil,
+			wantIDsErr:    true,
+		},
+		{
+			name:          "negative testing (number is less than 1)",
+			target:        "/api/histories?number=0",
+			wantBool:      false,
+			wantBoolErr:   false,
+			wantNumber:    0,
+			wantNumberErr: true,
+			wantIDs:       nil,
+			wantIDsErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, tt.target, nil), httptest.NewRecorder())
+			gotBool, err := queryBool(c, "all")
+			if (err != nil) != tt.wantBoolErr || gotBool != tt.wantBool {
+				t.Errorf("queryBool() = %v, %v, want %v, wantErr %v", gotBool, err, tt.wantBool, tt.wantBoolErr)
+			}
+			gotNumber, err := queryNumber(c)
+			if (err != nil) != tt.wantNumberErr || gotNumber != tt.wantNumber {
+				t.Errorf("queryNumber() = %v, %v, want %v, wantErr %v", gotNumber, err, tt.wantNumber, tt.wantNumberErr)
+			}
+			gotIDs, err := queryIDs(c)
+			if (err != nil) != tt.wantIDsErr || !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("queryIDs() = %v, %v, want %v, wantErr %v", gotIDs, err, tt.wantIDs, tt.wantIDsErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package history
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// BindRemoveHistoryHandler binds the removeHistory handler to the server.
+func BindRemoveHistoryHandler(g proxy.Group) {
+	g.DELETE("/histories", removeHistory)
+}
+
+// @Summary remove the histories.
+// @Description removes the histories of the generated Japanese phrases.
+// @Description favorited histories are not removed unless force is specified.
+// @Tags history
+// @Param id query []int false "IDs of the histories to remove (e.g. : id=1&id=2)" collectionFormat(multi)
+// @Param all query bool false "remove all the histories"
+// @Param force query bool false "remove the favorited histories too"
+// @Success 204 "removed"
+// @Failure 400 "invalid id, all or force"
+// @Failure 404 "no histories to remove"
+// @Router /histories [delete]
+// removeHistory is a handler that removes the histories.
+func removeHistory(c echo.Context) error {
+	if err := checkJrpDBConnection(); err != nil {
+		log.Error("Failed to get a connection to the database...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	ids, err := queryIDs(c)
+	if err != nil {
+		log.Error("Invalid id...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	all, err := queryBool(c, "all")
+	if err != nil {
+		log.Error("Invalid all...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	force, err := queryBool(c, "force")
+	if err != nil {
+		log.Error("Invalid force...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	if len(ids) == 0 && !all {
+		log.Error("No IDs specified...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	rhuc := jrpApp.NewRemoveHistoryUseCase(historyRepo)
+
+	if err := rhuc.Run(
+		c.Request().Context(),
+		ids,
+		all,
+		force,
+	); err != nil && err.Error() == "no histories to remove" {
+		return c.NoContent(http.StatusNotFound)
+	} else if err != nil {
+		log.Error("Failed to remove the histories...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,148 @@
+package history
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBindRemoveHistoryHandler(t *testing.T) {
+	type args struct {
+		g proxy.Group
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				g: nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().DELETE("/histories", gomock.Any())
+				tt.g = mockGroup
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			BindRemoveHistoryHandler(tt.args.g)
+		})
+	}
+}
+
+func Test_removeHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		want   int
+		setup  func(t *testing.T)
+	}{
+		{
+			name:   "positive testing (id is specified)",
+			target: "/api/histories?id=1",
+			want:   http.StatusNoContent,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (all is specified)",
+			target: "/api/histories?all=true",
+			want:   http.StatusNoContent,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (all and force are specified)",
+			target: "/api/histories?all=true&force=true",
+			want:   http.StatusNoContent,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (favorited history without force)",
+			target: "/api/histories?id=2",
+			want:   http.StatusNotFound,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (connection is not initialized)",
+			target: "/api/histories?id=1",
+			want:   http.StatusInternalServerError,
+			setup:  nil,
+		},
+		{
+			name:   "negative testing (id is invalid)",
+			target: "/api/histories?id=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (all is invalid)",
+			target: "/api/histories?all=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (force is invalid)",
+			target: "/api/histories?id=1&force=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (neither id nor all is specified)",
+			target: "/api/histories",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+			}()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodDelete, tt.target, nil), rec)
+			if err := removeHistory(c); err != nil {
+				t.Errorf("removeHistory() error = %v", err)
+			}
+			if rec.Code != tt.want {
+				t.Errorf("removeHistory() status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+}
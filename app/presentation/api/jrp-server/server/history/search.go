@@ -0,0 +1,121 @@
+package history
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/api/jrp-server/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// BindSearchHistoryHandler binds the searchHistory handler to the server.
+func BindSearchHistoryHandler(g proxy.Group) {
+	g.GET("/histories/search", searchHistory)
+}
+
+// @Summary search the histories.
+// @Description returns the histories of the generated Japanese phrases that contain the keywords.
+// @Tags history
+// @Produce json
+// @Param keyword query []string true "keywords to search (e.g. : keyword=猫&keyword=犬)" collectionFormat(multi)
+// @Param and query bool false "search the histories that contain all the keywords"
+// @Param number query int false "number of histories to get (default 10, e.g. : 50)"
+// @Param all query bool false "search all the histories"
+// @Param favorited query bool false "search only favorited histories"
+// @Param offset query int false "number of the most recent histories to skip (e.g. : 50)"
+// @Param cursor query int false "ID the histories to get are older than, the smallest ID of the previous page (e.g. : 120)"
+// @Success 200 {array} formatter.HistoryJsonOutputDto
+// @Failure 400 "invalid keyword, and, number, all, favorited, offset or cursor"
+// @Router /histories/search [get]
+// searchHistory is a handler that returns the histories that contain the keywords.
+func searchHistory(c echo.Context) error {
+	if err := checkJrpDBConnection(); err != nil {
+		log.Error("Failed to get a connection to the database...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	var keywords []string
+	for _, keyword := range c.QueryParams()["keyword"] {
+		if keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	if len(keywords) == 0 {
+		log.Error("No keywords specified...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	and, err := queryBool(c, "and")
+	if err != nil {
+		log.Error("Invalid and...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	number, err := queryNumber(c)
+	if err != nil {
+		log.Error("Invalid number...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	all, err := queryBool(c, "all")
+	if err != nil {
+		log.Error("Invalid all...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	favorited, err := queryBool(c, "favorited")
+	if err != nil {
+		log.Error("Invalid favorited...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	offset, err := queryNonNegative(c, "offset")
+	if err != nil {
+		log.Error("Invalid offset...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+	cursor, err := queryNonNegative(c, "cursor")
+	if err != nil {
+		log.Error("Invalid cursor...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	f, err := formatter.NewFormatter(format)
+	if err != nil {
+		log.Error("Invalid format...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	shuc := jrpApp.NewSearchHistoryUseCase(historyRepo)
+
+	shoDtos, err := shuc.Run(
+		c.Request().Context(),
+		&jrpApp.SearchHistoryUseCaseInputDto{
+			Keywords:  keywords,
+			And:       and,
+			All:       all,
+			Favorited: favorited,
+			Number:    number,
+			Offset:    offset,
+			Cursor:    cursor,
+		},
+	)
+	if err != nil {
+		log.Error("Failed to search the histories...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	if shoDtos == nil {
+		shoDtos = []*jrpApp.SearchHistoryUseCaseOutputDto{}
+	}
+
+	body, err := f.Format(shoDtos)
+	if body == nil || err != nil {
+		log.Error("Failed to format the output...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	return c.JSONBlob(http.StatusOK, body)
+}
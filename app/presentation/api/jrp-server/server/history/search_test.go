@@ -0,0 +1,177 @@
+package history
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBindSearchHistoryHandler(t *testing.T) {
+	type args struct {
+		g proxy.Group
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				g: nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().GET("/histories/search", gomock.Any())
+				tt.g = mockGroup
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			BindSearchHistoryHandler(tt.args.g)
+		})
+	}
+}
+
+func Test_searchHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		want   int
+		setup  func(t *testing.T)
+	}{
+		{
+			name:   "positive testing",
+			target: "/api/histories/search?keyword=test",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (and, all and favorited are specified)",
+			target: "/api/histories/search?keyword=test&keyword=2&and=true&all=true&favorited=true",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "positive testing (offset and cursor are specified)",
+			target: "/api/histories/search?keyword=test&number=1&offset=1&cursor=3",
+			want:   http.StatusOK,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (connection is not initialized)",
+			target: "/api/histories/search?keyword=test",
+			want:   http.StatusInternalServerError,
+			setup:  nil,
+		},
+		{
+			name:   "negative testing (keyword is not specified)",
+			target: "/api/histories/search",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (and is invalid)",
+			target: "/api/histories/search?keyword=test&and=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (number is invalid)",
+			target: "/api/histories/search?keyword=test&number=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (all is invalid)",
+			target: "/api/histories/search?keyword=test&all=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (favorited is invalid)",
+			target: "/api/histories/search?keyword=test&favorited=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (offset is invalid)",
+			target: "/api/histories/search?keyword=test&offset=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (cursor is negative)",
+			target: "/api/histories/search?keyword=test&cursor=-1",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (formatter.NewFormatter(format) failed)",
+			target: "/api/histories/search?keyword=test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+				origFormat := format
+				format = "test"
+				t.Cleanup(func() {
+					format = origFormat
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+			}()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, tt.target, nil), rec)
+			if err := searchHistory(c); err != nil {
+				t.Errorf("searchHistory() error = %v", err)
+			}
+			if rec.Code != tt.want {
+				t.Errorf("searchHistory() status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+}
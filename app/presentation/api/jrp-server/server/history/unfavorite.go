@@ -0,0 +1,57 @@
+package history
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// BindUnfavoriteHandler binds the unfavorite handler to the server.
+func BindUnfavoriteHandler(g proxy.Group) {
+	g.DELETE("/histories/:id/favorite", unfavorite)
+}
+
+// @Summary unfavorite the history.
+// @Description unfavorites the favorited history of the generated Japanese phrase.
+// @Tags history
+// @Param id path int true "ID of the history to unfavorite"
+// @Success 204 "unfavorited"
+// @Failure 400 "invalid id"
+// @Failure 404 "no favorited histories to unfavorite"
+// @Router /histories/{id}/favorite [delete]
+// unfavorite is a handler that unfavorites the history.
+func unfavorite(c echo.Context) error {
+	if err := checkJrpDBConnection(); err != nil {
+		log.Error("Failed to get a connection to the database...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Error("Invalid id...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	uuc := jrpApp.NewUnfavoriteUseCase(historyRepo)
+
+	if err := uuc.Run(
+		c.Request().Context(),
+		[]int{id},
+		false,
+	); err != nil && err.Error() == "no favorited histories to unfavorite" {
+		return c.NoContent(http.StatusNotFound)
+	} else if err != nil {
+		log.Error("Failed to unfavorite the history...")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+package history
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBindUnfavoriteHandler(t *testing.T) {
+	type args struct {
+		g proxy.Group
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				g: nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().DELETE("/histories/:id/favorite", gomock.Any())
+				tt.g = mockGroup
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			BindUnfavoriteHandler(tt.args.g)
+		})
+	}
+}
+
+func Test_unfavorite(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		id     string
+		want   int
+		setup  func(t *testing.T)
+	}{
+		{
+			name:   "positive testing",
+			target: "/api/histories/2/favorite",
+			id:     "2",
+			want:   http.StatusNoContent,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (history does not exist)",
+			target: "/api/histories/3/favorite",
+			id:     "3",
+			want:   http.StatusNotFound,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+		{
+			name:   "negative testing (connection is not initialized)",
+			target: "/api/histories/2/favorite",
+			id:     "2",
+			want:   http.StatusInternalServerError,
+			setup:  nil,
+		},
+		{
+			name:   "negative testing (id is invalid)",
+			target: "/api/histories/test/favorite",
+			id:     "test",
+			want:   http.StatusBadRequest,
+			setup: func(t *testing.T) {
+				initializeJrpDB(t, testHistories())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			defer func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+			}()
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodDelete, tt.target, nil), rec)
+			if tt.id != "" {
+				c.SetParamNames("id")
+				c.SetParamValues(tt.id)
+			}
+			if err := unfavorite(c); err != nil {
+				t.Errorf("unfavorite() error = %v", err)
+			}
+			if rec.Code != tt.want {
+				t.Errorf("unfavorite() status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+}
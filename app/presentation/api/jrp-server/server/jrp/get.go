@@ -1,7 +1,9 @@
 package jrp
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -16,21 +18,34 @@ import (
 )
 
 var (
+	// format is the default format of the response for injecting the dependencies in testing.
 	format = "json"
 )
 
+const (
+	// maxCount is the maximum number of phrases to generate per request.
+	maxCount = 100
+)
+
 // BindGetJrpHandler binds the getJrp handler to the server.
 func BindGetJrpHandler(g proxy.Group) {
 	g.GET("/jrp", getJrp)
 }
 
-// @Summary get a random Japanese phrase.
-// @Description returns a randomly generated Japanese phrase.
+// @Summary get random Japanese phrases.
+// @Description returns randomly generated Japanese phrases.
 // @Tags jrp
 // @Produce json
-// @Success 200 {object} formatter.JrpJsonOutputDto
+// @Param count query int false "number of phrases to generate (default 1, max 100, e.g. : 10)"
+// @Param prefix query string false "prefix of phrases to generate"
+// @Param suffix query string false "suffix of phrases to generate"
+// @Param template query string false "template of phrases to generate (e.g. : {a}{n}の{n})"
+// @Param seed query int false "seed to generate the same phrases reproducibly (e.g. : 42)"
+// @Param raw query bool false "whether to join the words without conjugating them into the attributive form (default false)"
+// @Success 200 {array} formatter.JrpJsonOutputDto
+// @Failure 400 "invalid count, prefix, suffix, template, seed or raw"
 // @Router /jrp [get]
-// getJrp is a handler that returns a random Japanese phrase.
+// getJrp is a handler that returns random Japanese phrases.
 func getJrp(c echo.Context) error {
 	connManager := database.GetConnectionManager()
 	if connManager == nil {
@@ -43,41 +58,96 @@ func getJrp(c echo.Context) error {
 		return c.NoContent(http.StatusInternalServerError)
 	}
 
-	wordQueryService := query_service.NewWordQueryService()
-	fwuc := wnjpnApp.NewFetchWordsUseCase(wordQueryService)
+	count := 1
+	if n := c.QueryParam("count"); n != "" {
+		var err error
+		if count, err = strconv.Atoi(n); err != nil || count < 1 || count > maxCount {
+			log.Error("Invalid count...")
+			return c.NoContent(http.StatusBadRequest)
+		}
+	}
 
-	fwoDtos, err := fwuc.Run(
-		c.Request().Context(),
-		"jpn",
-		[]string{"a", "v", "n"},
-	)
-	if err != nil {
-		log.Error("Failed to fetch words...")
-		return c.NoContent(http.StatusInternalServerError)
+	prefix := c.QueryParam("prefix")
+	suffix := c.QueryParam("suffix")
+	needRandomPrefix := prefix == ""
+	needRandomSuffix := suffix == ""
+	if !needRandomPrefix && !needRandomSuffix {
+		log.Error("Both prefix and suffix are specified...")
+		return c.NoContent(http.StatusBadRequest)
 	}
 
-	var gjiDtos []*jrpApp.GenerateJrpUseCaseInputDto
-	for _, fwoDto := range fwoDtos {
-		gjiDto := &jrpApp.GenerateJrpUseCaseInputDto{
-			WordID: fwoDto.WordID,
-			Lang:   fwoDto.Lang,
-			Lemma:  fwoDto.Lemma,
-			Pron:   fwoDto.Pron,
-			Pos:    fwoDto.Pos,
+	var template *jrpApp.JrpTemplate
+	if t := c.QueryParam("template"); t != "" {
+		if !needRandomPrefix || !needRandomSuffix {
+			log.Error("Template is specified with prefix or suffix...")
+			return c.NoContent(http.StatusBadRequest)
+		}
+		var err error
+		if template, err = jrpApp.ParseJrpTemplate(t); err != nil {
+			log.Error("Invalid template...")
+			return c.NoContent(http.StatusBadRequest)
+		}
+	}
+
+	var seed int64
+	if s := c.QueryParam("seed"); s != "" {
+		var err error
+		if seed, err = strconv.ParseInt(s, 10, 64); err != nil {
+			log.Error("Invalid seed...")
+			return c.NoContent(http.StatusBadRequest)
 		}
-		gjiDtos = append(gjiDtos, gjiDto)
 	}
 
-	gjuc := jrpApp.NewGenerateJrpUseCase()
-	gjoDto := gjuc.RunWithRandom(gjiDtos)
+	var raw bool
+	if r := c.QueryParam("raw"); r != "" {
+		var err error
+		if raw, err = strconv.ParseBool(r); err != nil {
+			log.Error("Invalid raw...")
+			return c.NoContent(http.StatusBadRequest)
+		}
+	}
 
 	f, err := formatter.NewFormatter(format)
 	if err != nil {
-		log.Error("Failed to create a new formatter...")
+		log.Error("Invalid format...")
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	// all the parts of speech of the slots are fetched at once, so the pool is shared by every request.
+	pool, err := jrpApp.GetWordPool(
+		func() ([]*jrpApp.GenerateJrpUseCaseInputDto, error) {
+			return fetchWords(c.Request().Context(), []string{"a", "v", "n"})
+		},
+	)
+	if err != nil {
+		log.Error("Failed to fetch words...")
 		return c.NoContent(http.StatusInternalServerError)
 	}
 
-	body, err := f.Format(gjoDto)
+	gjuc := jrpApp.NewGenerateJrpUseCase(!raw)
+	if seed != 0 {
+		gjuc = gjuc.WithSeed(seed)
+	}
+	var gjoDtos []*jrpApp.GenerateJrpUseCaseOutputDto
+	for i := 0; i < count; i++ {
+		var gjoDto *jrpApp.GenerateJrpUseCaseOutputDto
+		if template != nil {
+			gjoDto = gjuc.RunWithTemplate(pool, template)
+		} else if needRandomPrefix && needRandomSuffix {
+			gjoDto = gjuc.RunWithRandom(pool)
+		} else if needRandomPrefix {
+			gjoDto = gjuc.RunWithSuffix(pool, suffix)
+		} else {
+			gjoDto = gjuc.RunWithPrefix(pool, prefix)
+		}
+		if gjoDto == nil {
+			log.Error("Failed to generate a phrase...")
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		gjoDtos = append(gjoDtos, gjoDto)
+	}
+
+	body, err := f.Format(gjoDtos)
 	if body == nil || err != nil {
 		log.Error("Failed to format the output...")
 		return c.NoContent(http.StatusInternalServerError)
@@ -85,3 +155,29 @@ func getJrp(c echo.Context) error {
 
 	return c.JSONBlob(http.StatusOK, body)
 }
+
+// fetchWords fetches the words of the parts of speech as the input of the GenerateJrpUseCase.
+func fetchWords(ctx context.Context, pos []string) ([]*jrpApp.GenerateJrpUseCaseInputDto, error) {
+	fwuc := wnjpnApp.NewFetchWordsUseCase(query_service.NewWordQueryService())
+	fwoDtos, err := fwuc.Run(
+		ctx,
+		"jpn",
+		pos,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gjiDtos := make([]*jrpApp.GenerateJrpUseCaseInputDto, 0, len(fwoDtos))
+	for _, fwoDto := range fwoDtos {
+		gjiDtos = append(gjiDtos, &jrpApp.GenerateJrpUseCaseInputDto{
+			WordID: fwoDto.WordID,
+			Lang:   fwoDto.Lang,
+			Lemma:  fwoDto.Lemma,
+			Pron:   fwoDto.Pron,
+			Pos:    fwoDto.Pos,
+		})
+	}
+
+	return gjiDtos, nil
+}
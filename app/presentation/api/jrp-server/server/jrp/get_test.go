@@ -1,9 +1,11 @@
 package jrp
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
@@ -64,176 +66,281 @@ func Test_getJrp(t *testing.T) {
 		t.Errorf("Failed to download WordNet Japan DB file: %v", err)
 	}
 
-	type args struct {
-		c echo.Context
-	}
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *args)
+		name     string
+		target   string
+		wantCode int
+		// wantLen is the number of the phrases in the response, checked only if the status is OK.
+		wantLen int
+		setup   func(mockCtrl *gomock.Controller, t *testing.T)
 		cleanup func()
 	}{
 		{
-			name: "positive testing",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				cm := database.NewConnectionManager(proxy.NewSql())
-				if err := cm.InitializeConnection(
-					database.ConnectionConfig{
-						DBName: database.WNJpnDB,
-						DBType: database.SQLite,
-						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
-					},
-				); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
-			},
-			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
+			name:     "positive testing",
+			target:   "/api/jrp",
+			wantCode: http.StatusOK,
+			wantLen:  1,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
 			},
+			cleanup: nil,
 		},
 		{
-			name: "negative testing (connManager == nil)",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
-			},
-			cleanup: nil,
+			name:     "negative testing (connManager == nil)",
+			target:   "/api/jrp",
+			wantCode: http.StatusInternalServerError,
+			setup:    nil,
+			cleanup:  nil,
 		},
 		{
-			name: "negative testing (connectionManager.GetConnection(WNJpnDB) failed)",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
+			name:     "negative testing (connectionManager.GetConnection(WNJpnDB) failed)",
+			target:   "/api/jrp",
+			wantCode: http.StatusInternalServerError,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
 				mockConnManager := database.NewMockConnectionManager(mockCtrl)
 				mockConnManager.EXPECT().GetConnection(database.WNJpnDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
 				database.GetConnectionManagerFunc = func() database.ConnectionManager {
 					return mockConnManager
 				}
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
 			},
 			cleanup: func() {
 				database.GetConnectionManagerFunc = origFunc
 			},
 		},
 		{
-			name: "negative testing (fwuc.Run() failed)",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				cm := database.NewConnectionManager(proxy.NewSql())
-				if err := cm.InitializeConnection(
-					database.ConnectionConfig{
-						DBName: database.WNJpnDB,
-						DBType: database.SQLite,
-						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
-					},
-				); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+			name:     "negative testing (fwuc.Run() failed)",
+			target:   "/api/jrp",
+			wantCode: http.StatusInternalServerError,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
 				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
 				mockWordQueryService.EXPECT().
 					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
 					Return(nil, errors.New("WordQueryService.FindByLangIsAndPosIn() failed"))
-				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
 				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
 					return origNewFetchWordsUseCase(mockWordQueryService)
 				}
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
 				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
 			},
 		},
 		{
-			name: "negative testing (formatter.NewFormatter(format) failed)",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				cm := database.NewConnectionManager(proxy.NewSql())
-				if err := cm.InitializeConnection(
-					database.ConnectionConfig{
-						DBName: database.WNJpnDB,
-						DBType: database.SQLite,
-						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
-					},
-				); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+			name:     "negative testing (formatter.NewFormatter(format) failed)",
+			target:   "/api/jrp",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
 				format = "test"
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
 				format = origFormat
 			},
 		},
 		{
-			name: "negative testing (f.Format() failed)",
-			args: args{
-				c: nil,
-			},
-			wantErr: false,
-			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				cm := database.NewConnectionManager(proxy.NewSql())
-				if err := cm.InitializeConnection(
-					database.ConnectionConfig{
-						DBName: database.WNJpnDB,
-						DBType: database.SQLite,
-						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
-					},
-				); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
+			name:     "negative testing (f.Format() failed)",
+			target:   "/api/jrp",
+			wantCode: http.StatusInternalServerError,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
 				mockJu := utility.NewMockJsonUtil(mockCtrl)
 				mockJu.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
 				formatter.Ju = mockJu
-				tt.c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/jrp", nil), httptest.NewRecorder())
 			},
 			cleanup: func() {
-				if err := database.ResetConnectionManager(); err != nil {
-					t.Errorf("Failed to reset connection manager: %v", err)
-				}
 				formatter.Ju = origJu
 			},
 		},
+		{
+			name:     "positive testing (template is specified)",
+			target:   "/api/jrp?template=" + url.QueryEscape("{a}{n}の{n}"),
+			wantCode: http.StatusOK,
+			wantLen:  1,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (template is invalid)",
+			target:   "/api/jrp?template=" + url.QueryEscape("{x}"),
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (seed is specified)",
+			target:   "/api/jrp?seed=42",
+			wantCode: http.StatusOK,
+			wantLen:  1,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (seed is invalid)",
+			target:   "/api/jrp?seed=test",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (raw is specified)",
+			target:   "/api/jrp?raw=true&count=2",
+			wantCode: http.StatusOK,
+			wantLen:  2,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (raw is invalid)",
+			target:   "/api/jrp?raw=test",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (count is specified)",
+			target:   "/api/jrp?count=10",
+			wantCode: http.StatusOK,
+			wantLen:  10,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (count is maxCount)",
+			target:   "/api/jrp?count=100",
+			wantCode: http.StatusOK,
+			wantLen:  100,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (count is not an integer)",
+			target:   "/api/jrp?count=test",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (count is less than 1)",
+			target:   "/api/jrp?count=0",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (count is more than maxCount)",
+			target:   "/api/jrp?count=101",
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (prefix is specified)",
+			target:   "/api/jrp?prefix=" + url.QueryEscape("テスト") + "&count=3",
+			wantCode: http.StatusOK,
+			wantLen:  3,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "positive testing (suffix is specified)",
+			target:   "/api/jrp?suffix=" + url.QueryEscape("テスト") + "&count=3",
+			wantCode: http.StatusOK,
+			wantLen:  3,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (both prefix and suffix are specified)",
+			target:   "/api/jrp?prefix=" + url.QueryEscape("テスト") + "&suffix=" + url.QueryEscape("テスト"),
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
+		{
+			name:     "negative testing (template is specified with prefix)",
+			target:   "/api/jrp?prefix=" + url.QueryEscape("テスト") + "&template=" + url.QueryEscape("{a}{n}"),
+			wantCode: http.StatusBadRequest,
+			setup: func(mockCtrl *gomock.Controller, t *testing.T) {
+				initializeWNJpnDB(t)
+			},
+			cleanup: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			if tt.setup != nil {
-				tt.setup(mockCtrl, &tt.args)
+				tt.setup(mockCtrl, t)
 			}
 			defer func() {
 				if tt.cleanup != nil {
 					tt.cleanup()
 				}
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
 			}()
-			if err := getJrp(tt.args.c); (err != nil) != tt.wantErr {
-				t.Errorf("getJrp() error = %v, wantErr %v", err, tt.wantErr)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, tt.target, nil), rec)
+			if err := getJrp(c); err != nil {
+				t.Errorf("getJrp() error = %v", err)
+			}
+			if rec.Code != tt.wantCode {
+				t.Errorf("getJrp() status = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+			var got []formatter.JrpJsonOutputDto
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Errorf("getJrp() body = %v, error = %v", rec.Body.String(), err)
+			}
+			if len(got) != tt.wantLen {
+				t.Errorf("getJrp() len = %v, want %v", len(got), tt.wantLen)
 			}
 		})
 	}
 }
+
+// initializeWNJpnDB initializes the connection to the WordNet Japan database file downloaded to the temporary directory.
+func initializeWNJpnDB(t *testing.T) {
+	cm := database.NewConnectionManager(proxy.NewSql())
+	if err := cm.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.WNJpnDB,
+			DBType: database.SQLite,
+			DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+		},
+	); err != nil {
+		t.Errorf("Failed to initialize connection: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/swaggo/echo-swagger"
 
+	"github.com/yanosea/jrp/v2/app/presentation/api/jrp-server/server/history"
 	"github.com/yanosea/jrp/v2/app/presentation/api/jrp-server/server/jrp"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
@@ -13,4 +14,9 @@ func Bind(e proxy.Echo) {
 	e.Get("/swagger/*", echoSwagger.WrapHandler)
 	apiGroup := e.Group("/api")
 	jrp.BindGetJrpHandler(apiGroup)
+	history.BindGetHistoryHandler(apiGroup)
+	history.BindSearchHistoryHandler(apiGroup)
+	history.BindRemoveHistoryHandler(apiGroup)
+	history.BindFavoriteHandler(apiGroup)
+	history.BindUnfavoriteHandler(apiGroup)
 }
@@ -25,6 +25,11 @@ func TestBind(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
 				mockGroup := proxy.NewMockGroup(mockCtrl)
 				mockGroup.EXPECT().GET("/jrp", gomock.Any())
+				mockGroup.EXPECT().GET("/histories", gomock.Any())
+				mockGroup.EXPECT().GET("/histories/search", gomock.Any())
+				mockGroup.EXPECT().DELETE("/histories", gomock.Any())
+				mockGroup.EXPECT().POST("/histories/:id/favorite", gomock.Any())
+				mockGroup.EXPECT().DELETE("/histories/:id/favorite", gomock.Any())
 				mockEcho := proxy.NewMockEcho(mockCtrl)
 				mockEcho.EXPECT().Group("/api").Return(mockGroup)
 				mockEcho.EXPECT().Get("/swagger/*", gomock.Any())
@@ -1,12 +1,16 @@
 package server
 
 import (
+	"context"
 	"errors"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
 	"github.com/yanosea/jrp/v2/app/presentation/api/jrp-server/config"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
@@ -69,7 +73,7 @@ func (s *server) Init(
 	if s.Port == "8080" {
 		s.Route.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 			AllowOrigins: []string{"*"},
-			AllowMethods: []string{echo.GET},
+			AllowMethods: []string{echo.GET, echo.POST, echo.DELETE},
 		}))
 	}
 
@@ -93,6 +97,33 @@ func (s *server) Init(
 		return 1
 	}
 
+	if err := s.ConnectionManager.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.JrpDB,
+			DBType: conf.JrpDBType,
+			DSN:    conf.JrpDBDsn,
+		},
+	); err != nil {
+		s.Logger.Fatal(err)
+		return 1
+	}
+
+	// the migrations are applied before serving not to race on the concurrent requests.
+	if err := repository.MigrateJrpDB(context.Background(), s.ConnectionManager); err != nil {
+		s.Logger.Fatal(err)
+		return 1
+	}
+
+	// the database other than SQLite is not versioned, so the words are kept until the server restarts.
+	version := string(conf.WNJpnDBType)
+	if conf.WNJpnDBType == database.SQLite {
+		if version, err = query_service.GetWordCacheVersion(proxy.NewOs(), conf.WNJpnDBDsn); err != nil {
+			s.Logger.Fatal(err)
+			return 1
+		}
+	}
+	jrpApp.InitializeWordPool(version)
+
 	return 0
 }
 
@@ -106,6 +106,9 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
 					t.Errorf("Failed to set environment variable: %v", err)
 				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
 			},
 			cleanup: func() {
 				if err := database.ResetConnectionManager(); err != nil {
@@ -120,6 +123,9 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
 					t.Errorf("Failed to unset environment variable: %v", err)
 				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
 			},
 		},
 		{
@@ -151,8 +157,13 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
 					t.Errorf("Failed to set environment variable: %v", err)
 				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
 				mockGroup := proxy.NewMockGroup(mockCtrl)
-				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any()).Times(3)
+				mockGroup.EXPECT().POST(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().DELETE(gomock.Any(), gomock.Any()).Times(2)
 				mockEcho := proxy.NewMockEcho(mockCtrl)
 				mockEcho.EXPECT().Use(gomock.Any())
 				mockEcho.EXPECT().Use(gomock.Any())
@@ -180,6 +191,9 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
 					t.Errorf("Failed to unset environment variable: %v", err)
 				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
 			},
 		},
 		{
@@ -207,8 +221,13 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
 					t.Errorf("Failed to set environment variable: %v", err)
 				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
 				mockGroup := proxy.NewMockGroup(mockCtrl)
-				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any()).Times(3)
+				mockGroup.EXPECT().POST(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().DELETE(gomock.Any(), gomock.Any()).Times(2)
 				mockEcho := proxy.NewMockEcho(mockCtrl)
 				mockEcho.EXPECT().Use(gomock.Any())
 				mockEcho.EXPECT().Use(gomock.Any())
@@ -222,7 +241,7 @@ func Test_server_Init(t *testing.T) {
 				tf.Echos = mockEchos
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
-				mockFileUtil.EXPECT().MkdirIfNotExist(gomock.Any()).Return(nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist(gomock.Any()).Return(nil).Times(2)
 				mockFileUtil.EXPECT().IsExist(filepath.Join(o.TempDir(), "wnjpn.db")).Return(false)
 				ta.fileUtil = mockFileUtil
 			},
@@ -239,6 +258,9 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
 					t.Errorf("Failed to unset environment variable: %v", err)
 				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
 			},
 		},
 		{
@@ -270,8 +292,13 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
 					t.Errorf("Failed to set environment variable: %v", err)
 				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
 				mockGroup := proxy.NewMockGroup(mockCtrl)
-				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any()).Times(3)
+				mockGroup.EXPECT().POST(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().DELETE(gomock.Any(), gomock.Any()).Times(2)
 				mockEcho := proxy.NewMockEcho(mockCtrl)
 				mockEcho.EXPECT().Use(gomock.Any())
 				mockEcho.EXPECT().Use(gomock.Any())
@@ -300,6 +327,150 @@ func Test_server_Init(t *testing.T) {
 				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
 					t.Errorf("Failed to unset environment variable: %v", err)
 				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (JrpDB InitializeConnection failed)",
+			fields: fields{
+				ConnectionManager: nil,
+				Echos:             echos,
+				Logger:            nil,
+				Port:              "",
+				Route:             nil,
+			},
+			args: args{
+				envconfig: proxy.NewEnvconfig(),
+				fileUtil: utility.NewFileUtil(
+					proxy.NewGzip(),
+					proxy.NewIo(),
+					proxy.NewOs(),
+				),
+				sql: proxy.NewSql(),
+			},
+			want: 1,
+			setup: func(mockCtrl *gomock.Controller, ta *args, tf *fields) {
+				if err := o.Setenv("JRP_SERVER_PORT", "8080"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_WNJPN_DB_TYPE", "sqlite"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any()).Times(3)
+				mockGroup.EXPECT().POST(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().DELETE(gomock.Any(), gomock.Any()).Times(2)
+				mockEcho := proxy.NewMockEcho(mockCtrl)
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Group(gomock.Any()).Return(mockGroup)
+				mockEcho.EXPECT().Get("/swagger/*", gomock.Any())
+				mockLogger := proxy.NewMockLogger(mockCtrl)
+				mockLogger.EXPECT().Fatal(gomock.Any())
+				mockEchos := proxy.NewMockEchos(mockCtrl)
+				mockEchos.EXPECT().NewEcho().Return(mockEcho, mockLogger)
+				tf.Echos = mockEchos
+				mockConnectionManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnectionManager.EXPECT().InitializeConnection(gomock.Any()).Return(nil)
+				mockConnectionManager.EXPECT().InitializeConnection(gomock.Any()).Return(errors.New("ConnectionManager.InitializeConnection() failed"))
+				tf.ConnectionManager = mockConnectionManager
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_PORT"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB_TYPE"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (repository.MigrateJrpDB() failed)",
+			fields: fields{
+				ConnectionManager: nil,
+				Echos:             echos,
+				Logger:            nil,
+				Port:              "",
+				Route:             nil,
+			},
+			args: args{
+				envconfig: proxy.NewEnvconfig(),
+				fileUtil: utility.NewFileUtil(
+					proxy.NewGzip(),
+					proxy.NewIo(),
+					proxy.NewOs(),
+				),
+				sql: proxy.NewSql(),
+			},
+			want: 1,
+			setup: func(mockCtrl *gomock.Controller, ta *args, tf *fields) {
+				if err := o.Setenv("JRP_SERVER_PORT", "8080"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_WNJPN_DB_TYPE", "sqlite"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_WNJPN_DB", filepath.Join(o.TempDir(), "wnjpn.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_SERVER_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				mockGroup := proxy.NewMockGroup(mockCtrl)
+				mockGroup.EXPECT().GET(gomock.Any(), gomock.Any()).Times(3)
+				mockGroup.EXPECT().POST(gomock.Any(), gomock.Any())
+				mockGroup.EXPECT().DELETE(gomock.Any(), gomock.Any()).Times(2)
+				mockEcho := proxy.NewMockEcho(mockCtrl)
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Use(gomock.Any())
+				mockEcho.EXPECT().Group(gomock.Any()).Return(mockGroup)
+				mockEcho.EXPECT().Get("/swagger/*", gomock.Any())
+				mockLogger := proxy.NewMockLogger(mockCtrl)
+				mockLogger.EXPECT().Fatal(gomock.Any())
+				mockEchos := proxy.NewMockEchos(mockCtrl)
+				mockEchos.EXPECT().NewEcho().Return(mockEcho, mockLogger)
+				tf.Echos = mockEchos
+				mockConnectionManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnectionManager.EXPECT().InitializeConnection(gomock.Any()).Return(nil)
+				mockConnectionManager.EXPECT().InitializeConnection(gomock.Any()).Return(nil)
+				mockConnectionManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				tf.ConnectionManager = mockConnectionManager
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_PORT"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB_TYPE"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_WNJPN_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_SERVER_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
 			},
 		},
 	}
@@ -3,8 +3,11 @@ package command
 import (
 	"context"
 	"os"
+	"slices"
 
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
@@ -16,6 +19,8 @@ import (
 var (
 	// output is the output string.
 	output = ""
+	// osArgs is the arguments of the command line for injecting the dependencies in testing.
+	osArgs = os.Args[1:]
 	// NewCli is a variable holding the current Cli creation function.
 	NewCli CreateCliFunc = newCli
 )
@@ -55,6 +60,20 @@ func (c *cli) Init(
 	configurator := config.NewJrpCliConfigurator(envconfig, fileUtil)
 	conf, err := configurator.GetConfig()
 	if err != nil {
+		if isConfigFileCommand(osArgs) {
+			// the config file can be repaired by the commands not depending on the configuration even if it is broken.
+			c.RootCommand = NewRootCommand(
+				c.Cobra,
+				versionUtil.GetVersion(version),
+				&config.JrpCliConfig{
+					Generate:    config.DefaultGenerateConfig(),
+					Keybindings: config.DefaultKeybindings(),
+				},
+				configurator,
+				&output,
+			)
+			return 0
+		}
 		output = formatter.AppendErrorToOutput(err, output)
 		if err := presenter.Print(os.Stderr, output); err != nil {
 			return 1
@@ -66,20 +85,18 @@ func (c *cli) Init(
 		c.ConnectionManager = database.NewConnectionManager(sql)
 	}
 
-	if conf.JrpDBType == database.SQLite {
-		if err := c.ConnectionManager.InitializeConnection(
-			database.ConnectionConfig{
-				DBName: database.JrpDB,
-				DBType: conf.JrpDBType,
-				DSN:    conf.JrpDBDsn,
-			},
-		); err != nil {
-			output = formatter.AppendErrorToOutput(err, output)
-			if err := presenter.Print(os.Stderr, output); err != nil {
-				return 1
-			}
+	if err := c.ConnectionManager.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.JrpDB,
+			DBType: conf.JrpDBType,
+			DSN:    conf.JrpDBDsn,
+		},
+	); err != nil {
+		output = formatter.AppendErrorToOutput(err, output)
+		if err := presenter.Print(os.Stderr, output); err != nil {
 			return 1
 		}
+		return 1
 	}
 
 	if conf.WNJpnDBType == database.SQLite && fileUtil.IsExist(conf.WNJpnDBDsn) {
@@ -96,18 +113,52 @@ func (c *cli) Init(
 			}
 			return 1
 		}
+
+		osProxy := proxy.NewOs()
+		if version, err := query_service.GetWordCacheVersion(osProxy, conf.WNJpnDBDsn); err == nil {
+			query_service.InitializeWordCache(
+				query_service.NewFileWordCache(osProxy, conf.WNJpnDBDsn+".idx"),
+				version,
+			)
+		}
+	}
+
+	// the db commands show and apply the migrations by themselves.
+	if !isDBCommand(osArgs) {
+		if err := repository.MigrateJrpDB(context.Background(), c.ConnectionManager); err != nil {
+			output = formatter.AppendErrorToOutput(err, output)
+			if err := presenter.Print(os.Stderr, output); err != nil {
+				return 1
+			}
+			return 1
+		}
 	}
 
 	c.RootCommand = NewRootCommand(
 		c.Cobra,
 		versionUtil.GetVersion(version),
 		conf,
+		configurator,
 		&output,
 	)
 
 	return 0
 }
 
+// isConfigFileCommand returns whether the arguments run the command to show the path of the config file or to set the value to it.
+func isConfigFileCommand(args []string) bool {
+	if len(args) < 2 || !slices.Contains([]string{"config", "conf", "C"}, args[0]) {
+		return false
+	}
+
+	return slices.Contains([]string{"path", "pa", "p", "set", "se"}, args[1])
+}
+
+// isDBCommand returns whether the arguments run the command to manage the jrp database.
+func isDBCommand(args []string) bool {
+	return len(args) > 0 && args[0] == "db"
+}
+
 // Run runs the command line interface of jrp cli.
 func (c *cli) Run(ctx context.Context) (exitCode int) {
 	defer func() {
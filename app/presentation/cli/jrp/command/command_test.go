@@ -59,6 +59,7 @@ func Test_cli_Init(t *testing.T) {
 	cobra := proxy.NewCobra()
 	duc := jrpApp.NewDownloadUseCase()
 	origPrint := presenter.Print
+	origArgs := osArgs
 	if err := duc.Run(filepath.Join(o.TempDir(), "wnjpn.db")); err != nil && err.Error() != "wnjpn.db already exists" {
 		t.Errorf("Failed to download WordNet Japan DB file: %v", err)
 	}
@@ -231,6 +232,125 @@ func Test_cli_Init(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "positive testing (the config file is broken and the command to repair it is run)",
+			fields: fields{
+				os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func(_ *gomock.Controller) {
+					c := &cli{
+						Cobra:             cobra,
+						RootCommand:       nil,
+						ConnectionManager: nil,
+					}
+					if got := c.Init(
+						proxy.NewEnvconfig(),
+						proxy.NewSql(),
+						"0.0.0",
+						utility.NewFileUtil(
+							proxy.NewGzip(),
+							proxy.NewIo(),
+							proxy.NewOs(),
+						),
+						utility.NewVersionUtil(
+							proxy.NewDebug(),
+						),
+					); got != 0 {
+						t.Errorf("cli.Init() = %v, want %v", got, 0)
+					}
+					if c.RootCommand == nil || c.ConnectionManager != nil {
+						t.Errorf("cli.Init() RootCommand = %v, ConnectionManager = %v, want the root command without the connections", c.RootCommand, c.ConnectionManager)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantErr:    false,
+			setup: func() {
+				output = ""
+				osArgs = []string{"config", "set", "generate.format", "json"}
+				if err := o.MkdirAll(filepath.Join(o.TempDir(), "jrp_broken_config", "jrp"), 0755); err != nil {
+					t.Errorf("Failed to create the config directory: %v", err)
+				}
+				if err := o.WriteFile(filepath.Join(o.TempDir(), "jrp_broken_config", "jrp", "config.yaml"), []byte("generate:\n  format: xml\n"), 0644); err != nil {
+					t.Errorf("Failed to write the config file: %v", err)
+				}
+				if err := o.Setenv("XDG_CONFIG_HOME", filepath.Join(o.TempDir(), "jrp_broken_config")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+			},
+			cleanup: func() {
+				output = ""
+				osArgs = origArgs
+				if err := o.RemoveAll(filepath.Join(o.TempDir(), "jrp_broken_config")); err != nil {
+					t.Errorf("Failed to remove the config directory: %v", err)
+				}
+				if err := o.Unsetenv("XDG_CONFIG_HOME"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (the config file is broken)",
+			fields: fields{
+				os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func(_ *gomock.Controller) {
+					c := &cli{
+						Cobra:             cobra,
+						RootCommand:       nil,
+						ConnectionManager: nil,
+					}
+					if got := c.Init(
+						proxy.NewEnvconfig(),
+						proxy.NewSql(),
+						"0.0.0",
+						utility.NewFileUtil(
+							proxy.NewGzip(),
+							proxy.NewIo(),
+							proxy.NewOs(),
+						),
+						utility.NewVersionUtil(
+							proxy.NewDebug(),
+						),
+					); got != 1 {
+						t.Errorf("cli.Init() = %v, want %v", got, 1)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: color.RedString("Error : the value \"xml\" of the key \"generate.format\" must be one of [table plain json ndjson csv tsv]") + "\n",
+			wantErr:    false,
+			setup: func() {
+				output = ""
+				osArgs = []string{"history", "show"}
+				if err := o.MkdirAll(filepath.Join(o.TempDir(), "jrp_broken_config", "jrp"), 0755); err != nil {
+					t.Errorf("Failed to create the config directory: %v", err)
+				}
+				if err := o.WriteFile(filepath.Join(o.TempDir(), "jrp_broken_config", "jrp", "config.yaml"), []byte("generate:\n  format: xml\n"), 0644); err != nil {
+					t.Errorf("Failed to write the config file: %v", err)
+				}
+				if err := o.Setenv("XDG_CONFIG_HOME", filepath.Join(o.TempDir(), "jrp_broken_config")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+			},
+			cleanup: func() {
+				output = ""
+				osArgs = origArgs
+				if err := o.RemoveAll(filepath.Join(o.TempDir(), "jrp_broken_config")); err != nil {
+					t.Errorf("Failed to remove the config directory: %v", err)
+				}
+				if err := o.Unsetenv("XDG_CONFIG_HOME"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+			},
+		},
 		{
 			name: "negative testing (presenter.Print(os.Stderr, output) failed in Init)",
 			fields: fields{
@@ -635,6 +755,74 @@ func Test_cli_Init(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (repository.MigrateJrpDB() failed)",
+			fields: fields{
+				os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func(mockCtrl *gomock.Controller) {
+					mockConnectionManager := database.NewMockConnectionManager(mockCtrl)
+					mockConnectionManager.EXPECT().InitializeConnection(gomock.Any()).Return(nil)
+					mockConnectionManager.EXPECT().GetConnection(database.JrpDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+					c := &cli{
+						Cobra:             cobra,
+						RootCommand:       nil,
+						ConnectionManager: mockConnectionManager,
+					}
+					if got := c.Init(
+						proxy.NewEnvconfig(),
+						proxy.NewSql(),
+						"0.0.0",
+						utility.NewFileUtil(
+							proxy.NewGzip(),
+							proxy.NewIo(),
+							proxy.NewOs(),
+						),
+						utility.NewVersionUtil(
+							proxy.NewDebug(),
+						),
+					); got != 1 {
+						t.Errorf("cli.Init() = %v, want %v", got, 1)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: color.RedString("Error : ConnectionManager.GetConnection() failed") + "\n",
+			wantErr:    false,
+			setup: func() {
+				output = ""
+				if err := o.Setenv("JRP_DB_TYPE", "sqlite"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_DB", filepath.Join(o.TempDir(), "jrp.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_WNJPN_DB_TYPE", "sqlite"); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+				if err := o.Setenv("JRP_WNJPN_DB", filepath.Join(o.TempDir(), "not-exist-wnjpn.db")); err != nil {
+					t.Errorf("Failed to set environment variable: %v", err)
+				}
+			},
+			cleanup: func() {
+				output = ""
+				if err := o.Unsetenv("JRP_DB_TYPE"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_WNJPN_DB_TYPE"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+				if err := o.Unsetenv("JRP_WNJPN_DB"); err != nil {
+					t.Errorf("Failed to unset environment variable: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -667,6 +855,100 @@ func Test_cli_Init(t *testing.T) {
 	}
 }
 
+func Test_isConfigFileCommand(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "positive testing (config path)",
+			args: args{
+				args: []string{"config", "path"},
+			},
+			want: true,
+		},
+		{
+			name: "positive testing (C se)",
+			args: args{
+				args: []string{"C", "se", "generate.number", "3"},
+			},
+			want: true,
+		},
+		{
+			name: "positive testing (config show)",
+			args: args{
+				args: []string{"config", "show"},
+			},
+			want: false,
+		},
+		{
+			name: "positive testing (config)",
+			args: args{
+				args: []string{"config"},
+			},
+			want: false,
+		},
+		{
+			name: "positive testing (the other command)",
+			args: args{
+				args: []string{"history", "path"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isConfigFileCommand(tt.args.args); got != tt.want {
+				t.Errorf("isConfigFileCommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isDBCommand(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "positive testing (db status)",
+			args: args{
+				args: []string{"db", "status"},
+			},
+			want: true,
+		},
+		{
+			name: "positive testing (no arguments)",
+			args: args{
+				args: []string{},
+			},
+			want: false,
+		},
+		{
+			name: "positive testing (the other command)",
+			args: args{
+				args: []string{"history", "show"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDBCommand(tt.args.args); got != tt.want {
+				t.Errorf("isDBCommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_cli_Run(t *testing.T) {
 	os := proxy.NewOs()
 	stdBuffer := proxy.NewBuffer()
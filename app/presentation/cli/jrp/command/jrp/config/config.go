@@ -0,0 +1,100 @@
+package config
+
+import (
+	c "github.com/spf13/cobra"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewConfigCommand returns a new instance of the config command.
+func NewConfigCommand(
+	cobra proxy.Cobra,
+	conf *cliConfig.JrpCliConfig,
+	configurator cliConfig.JrpCliConfigurator,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("config")
+	cmd.SetAliases([]string{"conf", "C"})
+	cmd.SetUsageTemplate(configUsageTemplate)
+	cmd.SetHelpTemplate(configHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	showCmd := NewShowCommand(
+		cobra,
+		conf,
+		output,
+	)
+	cmd.AddCommand(
+		NewPathCommand(
+			cobra,
+			configurator,
+			output,
+		),
+		NewSetCommand(
+			cobra,
+			configurator,
+			output,
+		),
+		showCmd,
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runConfig(
+				cmd,
+				showCmd,
+				args,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runConfig runs the config command.
+func runConfig(
+	cmd *c.Command,
+	showCmd proxy.Command,
+	args []string,
+) error {
+	return showCmd.RunE(cmd, args)
+}
+
+const (
+	// configHelpTemplate is the help template of the config command.
+	configHelpTemplate = `⚙️ Manage the config file of jrp.
+
+You can show the configuration, set the values to the config file and show the path of the config file.
+
+The config file is "$XDG_CONFIG_HOME/jrp/config.yaml" ("~/.config/jrp/config.yaml" by default).
+The environment variables take precedence over the config file, and the config file takes precedence over the defaults.
+The flags of the commands take precedence over all of them.
+
+Even if the config file is broken, "jrp config path" and "jrp config set" are available to repair it.
+
+Those commands below are the same.
+  "jrp config" : "jrp config show"
+
+` + configUsageTemplate
+	// configUsageTemplate is the usage template of the config command.
+	configUsageTemplate = `Usage:
+  jrp config [command]
+  jrp conf   [command]
+  jrp C      [command]
+
+Available Subommands:
+  path, pa, p  ⚙️📍 Show the path of the config file.
+  set,  se     ⚙️✏️ Set the value to the key of the config file.
+  show, sh, s  ⚙️📋 Show the configuration of jrp.
+                  You can abbreviate "show" sub command. ("jrp config" and "jrp config show" are the same.)
+
+Flags:
+  -h, --help  🤝 help for config
+
+Use "jrp config [command] --help" for more information about a command.
+`
+)
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"testing"
+
+	c "github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewConfigCommand(t *testing.T) {
+	type args struct {
+		cobra        proxy.Cobra
+		conf         *cliConfig.JrpCliConfig
+		configurator cliConfig.JrpCliConfigurator
+		output       *string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra: proxy.NewCobra(),
+				conf: &cliConfig.JrpCliConfig{
+					Generate:    cliConfig.DefaultGenerateConfig(),
+					Keybindings: cliConfig.DefaultKeybindings(),
+				},
+				configurator: nil,
+				output:       new(string),
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				tt.configurator = cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got := NewConfigCommand(tt.args.cobra, tt.args.conf, tt.args.configurator, tt.args.output)
+			if got == nil {
+				t.Errorf("NewConfigCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(&c.Command{}, []string{}); err != nil {
+					t.Errorf("Failed to run the config command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runConfig(t *testing.T) {
+	var output string
+
+	type args struct {
+		cmd     *c.Command
+		showCmd proxy.Command
+		args    []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd: &c.Command{},
+				showCmd: NewShowCommand(
+					proxy.NewCobra(),
+					&cliConfig.JrpCliConfig{
+						Generate:    cliConfig.DefaultGenerateConfig(),
+						Keybindings: cliConfig.DefaultKeybindings(),
+					},
+					&output,
+				),
+				args: []string{},
+			},
+			wantErr: false,
+			setup: func() {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runConfig(tt.args.cmd, tt.args.showCmd, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output == "" {
+				t.Errorf("runConfig() : output is empty")
+			}
+		})
+	}
+}
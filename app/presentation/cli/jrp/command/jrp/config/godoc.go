@@ -0,0 +1,2 @@
+// Package config provides the sub commands for the jrp config.
+package config
@@ -0,0 +1,70 @@
+package config
+
+import (
+	c "github.com/spf13/cobra"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewPathCommand returns a new instance of the path command.
+func NewPathCommand(
+	cobra proxy.Cobra,
+	configurator cliConfig.JrpCliConfigurator,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("path")
+	cmd.SetAliases([]string{"pa", "p"})
+	cmd.SetUsageTemplate(pathUsageTemplate)
+	cmd.SetHelpTemplate(pathHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(_ *c.Command, _ []string) error {
+			return runPath(
+				configurator,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runPath runs the path command.
+func runPath(
+	configurator cliConfig.JrpCliConfigurator,
+	output *string,
+) error {
+	configFilePath, err := configurator.GetConfigFilePath()
+	if err != nil {
+		o := formatter.Red("❌ Failed to get the path of the config file...")
+		*output = o
+		return err
+	}
+	*output = configFilePath
+
+	return nil
+}
+
+const (
+	// pathHelpTemplate is the help template of the path command.
+	pathHelpTemplate = `⚙️📍 Show the path of the config file.
+
+You can show the path of the config file whether it exists or not.
+
+` + pathUsageTemplate
+	// pathUsageTemplate is the usage template of the path command.
+	pathUsageTemplate = `Usage:
+  jrp config path
+  jrp config pa
+  jrp config p
+
+Flags:
+  -h, --help  🤝 help for path
+`
+)
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fatih/color"
+	"go.uber.org/mock/gomock"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewPathCommand(t *testing.T) {
+	type args struct {
+		cobra        proxy.Cobra
+		configurator cliConfig.JrpCliConfigurator
+		output       *string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:        proxy.NewCobra(),
+				configurator: nil,
+				output:       new(string),
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().GetConfigFilePath().Return("~/.config/jrp/config.yaml", nil)
+				tt.configurator = mockConfigurator
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got := NewPathCommand(tt.args.cobra, tt.args.configurator, tt.args.output)
+			if got == nil {
+				t.Errorf("NewPathCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{}); err != nil {
+					t.Errorf("Failed to run the path command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runPath(t *testing.T) {
+	var output string
+
+	type args struct {
+		configurator cliConfig.JrpCliConfigurator
+		output       *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				configurator: nil,
+				output:       &output,
+			},
+			want:    "~/.config/jrp/config.yaml",
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().GetConfigFilePath().Return("~/.config/jrp/config.yaml", nil)
+				tt.configurator = mockConfigurator
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (configurator.GetConfigFilePath() failed)",
+			args: args{
+				configurator: nil,
+				output:       &output,
+			},
+			want:    color.RedString("❌ Failed to get the path of the config file..."),
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().GetConfigFilePath().Return("", errors.New("JrpCliConfigurator.GetConfigFilePath() failed"))
+				tt.configurator = mockConfigurator
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runPath(tt.args.configurator, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runPath() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	c "github.com/spf13/cobra"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewSetCommand returns a new instance of the set command.
+func NewSetCommand(
+	cobra proxy.Cobra,
+	configurator cliConfig.JrpCliConfigurator,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("set")
+	cmd.SetAliases([]string{"se"})
+	cmd.SetUsageTemplate(setUsageTemplate)
+	cmd.SetHelpTemplate(setHelpTemplate)
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(_ *c.Command, args []string) error {
+			return runSet(
+				args,
+				configurator,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runSet runs the set command.
+func runSet(
+	args []string,
+	configurator cliConfig.JrpCliConfigurator,
+	output *string,
+) error {
+	if len(args) != 2 {
+		o := formatter.Yellow("⚡ Specify the key and the value to set...")
+		*output = o
+		return nil
+	}
+
+	if err := configurator.SetConfigFileValue(args[0], args[1]); err != nil {
+		o := formatter.Red("❌ Failed to set the value to the config file...")
+		*output = o
+		return err
+	}
+
+	o := formatter.Green("✅ Set successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// setHelpTemplate is the help template of the set command.
+	setHelpTemplate = `⚙️✏️ Set the value to the key of the config file.
+
+You can set the value to the key below of the config file.
+The config file is created if it does not exist.
+With the empty value (e.g. : ""), the key is unset and the default is used.
+
+  jrp_db.type                       type of the jrp database ("sqlite", "postgres" or "mysql")
+  jrp_db.dsn                        dsn of the jrp database
+  wnjpn_db.type                     type of the WordNet Japan database ("sqlite", "postgres" or "mysql")
+  wnjpn_db.dsn                      dsn of the WordNet Japan database
+  generate.number                   default number of phrases to generate
+  generate.format                   default format of the output ("table", "plain", "json", "ndjson", "csv" or "tsv")
+  generate.dry_run                  whether to generate phrases without saving as the histories by default
+  generate.timeout                  default timeout in seconds for the interactive mode
+  interactive.keybindings.<action>  key of the action of the interactive mode (e.g. : "interactive.keybindings.save_exit")
+
+` + setUsageTemplate
+	// setUsageTemplate is the usage template of the set command.
+	setUsageTemplate = `Usage:
+  jrp config set [argument]
+  jrp config se  [argument]
+
+Flags:
+  -h, --help  🤝 help for set
+
+Argument:
+  key    🔑 key of the config file to set (e.g. : "generate.number")
+  value  ✏️ value to set (e.g. : 10)
+`
+)
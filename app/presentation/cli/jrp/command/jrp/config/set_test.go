@@ -0,0 +1,170 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fatih/color"
+	"go.uber.org/mock/gomock"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewSetCommand(t *testing.T) {
+	type args struct {
+		cobra        proxy.Cobra
+		configurator cliConfig.JrpCliConfigurator
+		output       *string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:        proxy.NewCobra(),
+				configurator: nil,
+				output:       new(string),
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().SetConfigFileValue("generate.number", "3").Return(nil)
+				tt.configurator = mockConfigurator
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got := NewSetCommand(tt.args.cobra, tt.args.configurator, tt.args.output)
+			if got == nil {
+				t.Errorf("NewSetCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{"generate.number", "3"}); err != nil {
+					t.Errorf("Failed to run the set command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runSet(t *testing.T) {
+	var output string
+
+	type args struct {
+		args         []string
+		configurator cliConfig.JrpCliConfigurator
+		output       *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				args:         []string{"generate.number", "3"},
+				configurator: nil,
+				output:       &output,
+			},
+			want:    color.GreenString("✅ Set successfully!"),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().SetConfigFileValue("generate.number", "3").Return(nil)
+				tt.configurator = mockConfigurator
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (the value is empty)",
+			args: args{
+				args:         []string{"generate.number", ""},
+				configurator: nil,
+				output:       &output,
+			},
+			want:    color.GreenString("✅ Set successfully!"),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().SetConfigFileValue("generate.number", "").Return(nil)
+				tt.configurator = mockConfigurator
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (the value is not specified)",
+			args: args{
+				args:         []string{"generate.number"},
+				configurator: nil,
+				output:       &output,
+			},
+			want:    color.YellowString("⚡ Specify the key and the value to set..."),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				tt.configurator = cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (configurator.SetConfigFileValue() failed)",
+			args: args{
+				args:         []string{"generate.number", "three"},
+				configurator: nil,
+				output:       &output,
+			},
+			want:    color.RedString("❌ Failed to set the value to the config file..."),
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConfigurator := cliConfig.NewMockJrpCliConfigurator(mockCtrl)
+				mockConfigurator.EXPECT().SetConfigFileValue("generate.number", "three").Return(errors.New("JrpCliConfigurator.SetConfigFileValue() failed"))
+				tt.configurator = mockConfigurator
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runSet(tt.args.args, tt.args.configurator, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runSet() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewShowCommand returns a new instance of the show command.
+func NewShowCommand(
+	cobra proxy.Cobra,
+	conf *cliConfig.JrpCliConfig,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("show")
+	cmd.SetAliases([]string{"sh", "s"})
+	cmd.SetUsageTemplate(showUsageTemplate)
+	cmd.SetHelpTemplate(showHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(_ *c.Command, _ []string) error {
+			return runShow(
+				conf,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runShow runs the show command.
+func runShow(
+	conf *cliConfig.JrpCliConfig,
+	output *string,
+) error {
+	data, err := proxy.NewYaml().Marshal(cliConfig.NewFileConfig(conf))
+	if err != nil {
+		o := formatter.Red("❌ Failed to show the configuration...")
+		*output = o
+		return err
+	}
+	*output = strings.TrimSuffix(string(data), "\n")
+
+	return nil
+}
+
+const (
+	// showHelpTemplate is the help template of the show command.
+	showHelpTemplate = `⚙️📋 Show the configuration of jrp.
+
+You can show the configuration in effect in the format of the config file,
+merged from the environment variables, the config file and the defaults.
+
+` + showUsageTemplate
+	// showUsageTemplate is the usage template of the show command.
+	showUsageTemplate = `Usage:
+  jrp config show
+  jrp config sh
+  jrp config s
+
+Flags:
+  -h, --help  🤝 help for show
+`
+)
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"testing"
+
+	baseConfig "github.com/yanosea/jrp/v2/app/config"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	cliConfig "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewShowCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		conf   *cliConfig.JrpCliConfig
+		output *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra: proxy.NewCobra(),
+				conf: &cliConfig.JrpCliConfig{
+					Generate:    cliConfig.DefaultGenerateConfig(),
+					Keybindings: cliConfig.DefaultKeybindings(),
+				},
+				output: new(string),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewShowCommand(tt.args.cobra, tt.args.conf, tt.args.output)
+			if got == nil {
+				t.Errorf("NewShowCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{}); err != nil {
+					t.Errorf("Failed to run the show command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runShow(t *testing.T) {
+	var output string
+
+	type args struct {
+		conf   *cliConfig.JrpCliConfig
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				conf: &cliConfig.JrpCliConfig{
+					JrpConfig: baseConfig.JrpConfig{
+						WNJpnDBType: database.SQLite,
+						WNJpnDBDsn:  "/home/jrp/.local/share/jrp/wnjpn.db",
+					},
+					JrpDBType: database.PostgreSQL,
+					JrpDBDsn:  "postgres://localhost:5432/jrp",
+					Generate: cliConfig.GenerateConfig{
+						Number:  5,
+						Format:  "plain",
+						DryRun:  true,
+						Timeout: 30,
+					},
+					Keybindings: cliConfig.Keybindings{
+						cliConfig.ActionSaveExit: "n",
+					},
+				},
+				output: &output,
+			},
+			want: `jrp_db:
+    type: postgres
+    dsn: postgres://localhost:5432/jrp
+wnjpn_db:
+    type: sqlite
+    dsn: /home/jrp/.local/share/jrp/wnjpn.db
+generate:
+    number: 5
+    format: plain
+    dry_run: true
+    timeout: 30
+interactive:
+    keybindings:
+        save_exit: "n"`,
+			wantErr: false,
+			setup: func() {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runShow(tt.args.conf, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runShow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runShow() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// DbOptions provides the options for the db command.
+type DbOptions struct {
+	StatusOptions StatusOptions
+}
+
+var (
+	// dbOps is a variable to store the db options with the default values for injecting the dependencies in testing.
+	dbOps = DbOptions{
+		StatusOptions: StatusOptions{
+			Format: "table",
+		},
+	}
+)
+
+// NewDbCommand returns a new instance of the db command.
+func NewDbCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("db")
+	cmd.SetUsageTemplate(dbUsageTemplate)
+	cmd.SetHelpTemplate(dbHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&dbOps.StatusOptions.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g. : \"plain\")",
+	)
+
+	statusCmd := NewStatusCommand(
+		cobra,
+		output,
+	)
+	cmd.AddCommand(
+		NewMigrateCommand(
+			cobra,
+			output,
+		),
+		statusCmd,
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runDb(
+				cmd,
+				statusCmd,
+				args,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runDb runs the db command.
+func runDb(
+	cmd *c.Command,
+	statusCmd proxy.Command,
+	args []string,
+) error {
+	statusOps = dbOps.StatusOptions
+	return statusCmd.RunE(cmd, args)
+}
+
+const (
+	// dbHelpTemplate is the help template of the db command.
+	dbHelpTemplate = `🗄️ Manage the schema of the jrp database.
+
+You can migrate the schema of the jrp database and show the status of the migrations.
+
+jrp applies the migrations not applied yet automatically when it accesses the histories,
+so you usually do not have to migrate by yourself.
+This is useful to migrate the database shared with the team before using it.
+
+Those commands below are the same.
+  "jrp db" : "jrp db status"
+
+` + dbUsageTemplate
+	// dbUsageTemplate is the usage template of the db command.
+	dbUsageTemplate = `Usage:
+  jrp db [flag]
+  jrp db [command]
+
+Available Subommands:
+  migrate, mig, m  🗄️⬆️ Apply the migrations not applied yet to the jrp database.
+  status,  st,  s  🗄️📋 Show the status of the migrations of the jrp database.
+                      You can abbreviate "status" sub command. ("jrp db" and "jrp db status" are the same.)
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g. : "plain")
+  -h, --help    🤝 help for db
+
+Use "jrp db [command] --help" for more information about a command.
+`
+)
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// initializeJrpDB initializes the connection to the jrp database in the temporary directory.
+func initializeJrpDB(t *testing.T) {
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+	cm := database.NewConnectionManager(proxy.NewSql())
+	if err := cm.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.JrpDB,
+			DBType: database.SQLite,
+			DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+		},
+	); err != nil {
+		t.Errorf("Failed to initialize connection: %v", err)
+	}
+}
+
+// cleanupJrpDB resets the connection to the jrp database and removes the database file.
+func cleanupJrpDB(t *testing.T) {
+	if err := database.ResetConnectionManager(); err != nil {
+		t.Errorf("Failed to reset connection manager: %v", err)
+	}
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+}
+
+func TestNewDbCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewDbCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewDbCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run db command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runDb(t *testing.T) {
+	origStatusOps := statusOps
+
+	type args struct {
+		cmd       *c.Command
+		statusCmd proxy.Command
+		args      []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    StatusOptions
+		wantErr bool
+		setup   func(tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:       nil,
+				statusCmd: nil,
+				args:      []string{},
+			},
+			want: StatusOptions{
+				Format: "plain",
+			},
+			wantErr: false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				dbOps.StatusOptions.Format = "plain"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				tt.statusCmd = NewStatusCommand(proxy.NewCobra(), new(string))
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				dbOps.StatusOptions.Format = "table"
+				statusOps = origStatusOps
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runDb(tt.args.cmd, tt.args.statusCmd, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runDb() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if statusOps != tt.want {
+				t.Errorf("runDb() : statusOps = %v, want %v", statusOps, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package db provides the sub commands for the jrp db.
+package db
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewMigrateCommand returns a new instance of the migrate command.
+func NewMigrateCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("migrate")
+	cmd.SetAliases([]string{"mig", "m"})
+	cmd.SetUsageTemplate(migrateUsageTemplate)
+	cmd.SetHelpTemplate(migrateHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runMigrate(
+				cmd,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runMigrate runs the migrate command.
+func runMigrate(
+	cmd *c.Command,
+	output *string,
+) error {
+	schemaMigrator := repository.NewSchemaMigrator()
+	muc := jrpApp.NewMigrateUseCase(schemaMigrator)
+
+	moDtos, err := muc.Run(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if len(moDtos) == 0 {
+		o := formatter.Yellow("⚡ The jrp database is already up to date...")
+		*output = o
+		return nil
+	}
+
+	var migrated []string
+	for _, moDto := range moDtos {
+		migrated = append(migrated, fmt.Sprintf("  %d : %s", moDto.Version, moDto.Description))
+	}
+	o := formatter.Green(
+		fmt.Sprintf("✅ Migrated successfully!\n%s", strings.Join(migrated, "\n")),
+	)
+	*output = o
+
+	return nil
+}
+
+const (
+	// migrateHelpTemplate is the help template of the migrate command.
+	migrateHelpTemplate = `🗄️⬆️ Apply the migrations not applied yet to the jrp database.
+
+You can apply the migrations not applied yet in order.
+The applied migrations are recorded in the jrp database.
+
+` + migrateUsageTemplate
+	// migrateUsageTemplate is the usage template of the migrate command.
+	migrateUsageTemplate = `Usage:
+  jrp db migrate
+  jrp db mig
+  jrp db m
+
+Flags:
+  -h, --help  🤝 help for migrate
+`
+)
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewMigrateCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewMigrateCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewMigrateCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run migrate command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runMigrate(t *testing.T) {
+	var output string
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (migrations applied)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want: color.GreenString(
+				"✅ Migrated successfully!\n" +
+					"  1 : create the history table\n" +
+					"  2 : add the reading columns to the history table\n" +
+					"  3 : add the word ids column to the history table\n" +
+					"  4 : create the tag and the note tables\n" +
+					"  5 : create the full-text search table of the phrases\n" +
+					"  6 : add the deleted at column to the history table\n" +
+					"  7 : create the table of the histories unfavorited at once",
+			),
+			wantErr: false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (already up to date)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    color.YellowString("⚡ The jrp database is already up to date..."),
+			wantErr: false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				if err := runMigrate(cmd, new(string)); err != nil {
+					t.Errorf("Failed to migrate the database: %v", err)
+				}
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (muc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runMigrate(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runMigrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output != tt.want {
+				t.Errorf("runMigrate() = %v, want %v", output, tt.want)
+			}
+		})
+	}
+}
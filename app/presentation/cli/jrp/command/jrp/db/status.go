@@ -0,0 +1,114 @@
+package db
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// StatusOptions provides the options for the status command.
+type StatusOptions struct {
+	// Format is a flag to specify the format of the output.
+	Format string
+}
+
+var (
+	// statusOps is a variable to store the status options with the default values for injecting the dependencies in testing.
+	statusOps = StatusOptions{
+		Format: "table",
+	}
+)
+
+// NewStatusCommand returns a new instance of the status command.
+func NewStatusCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("status")
+	cmd.SetAliases([]string{"st", "s"})
+	cmd.SetUsageTemplate(statusUsageTemplate)
+	cmd.SetHelpTemplate(statusHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&statusOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g. : \"plain\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runStatus(
+				cmd,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runStatus runs the status command.
+func runStatus(
+	cmd *c.Command,
+	output *string,
+) error {
+	if statusOps.Format != "table" && statusOps.Format != "plain" {
+		o := formatter.Red("🚨 Only the format \"table\" or \"plain\" is available...")
+		*output = o
+		return errors.New("unsupported format")
+	}
+
+	schemaMigrator := repository.NewSchemaMigrator()
+	gmsuc := jrpApp.NewGetMigrationStatusUseCase(schemaMigrator)
+
+	gmsoDtos, err := gmsuc.Run(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(statusOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(gmsoDtos)
+	if err != nil {
+		return err
+	}
+	*output = o
+
+	return nil
+}
+
+const (
+	// statusHelpTemplate is the help template of the status command.
+	statusHelpTemplate = `🗄️📋 Show the status of the migrations of the jrp database.
+
+You can show the migrations with whether they are applied or pending.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table" and "plain" are available.
+
+` + statusUsageTemplate
+	// statusUsageTemplate is the usage template of the status command.
+	statusUsageTemplate = `Usage:
+  jrp db status [flag]
+  jrp db st     [flag]
+  jrp db s      [flag]
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g. : "plain")
+  -h, --help    🤝 help for status
+`
+)
@@ -0,0 +1,258 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewStatusCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewStatusCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewStatusCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run status command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runStatus(t *testing.T) {
+	var output string
+	origStatusOps := statusOps
+	origNewFormatter := formatter.NewFormatter
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (no migrations applied)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want: "1\tcreate the history table\tpending\n" +
+				"2\tadd the reading columns to the history table\tpending\n" +
+				"3\tadd the word ids column to the history table\tpending\n" +
+				"4\tcreate the tag and the note tables\tpending\n" +
+				"5\tcreate the full-text search table of the phrases\tpending\n" +
+				"6\tadd the deleted at column to the history table\tpending\n" +
+				"7\tcreate the table of the histories unfavorited at once\tpending",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				statusOps.Format = "plain"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				statusOps = origStatusOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (all the migrations applied)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want: "1\tcreate the history table\tapplied\n" +
+				"2\tadd the reading columns to the history table\tapplied\n" +
+				"3\tadd the word ids column to the history table\tapplied\n" +
+				"4\tcreate the tag and the note tables\tapplied\n" +
+				"5\tcreate the full-text search table of the phrases\tapplied\n" +
+				"6\tadd the deleted at column to the history table\tapplied\n" +
+				"7\tcreate the table of the histories unfavorited at once\tapplied",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				statusOps.Format = "plain"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				if err := runMigrate(cmd, new(string)); err != nil {
+					t.Errorf("Failed to migrate the database: %v", err)
+				}
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				statusOps = origStatusOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gmsuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (format option is not table or plain)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    color.RedString("🚨 Only the format \"table\" or \"plain\" is available..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				statusOps.Format = "json"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				statusOps = origStatusOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter(statusOps.Format) failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    color.RedString("❌ Failed to create a formatter..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("formatter.NewFormatter() failed")
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				formatter.NewFormatter = origNewFormatter
+				statusOps = origStatusOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (f.Format() failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				mockFormatter := formatter.NewMockFormatter(mockCtrl)
+				mockFormatter.EXPECT().Format(gomock.Any()).Return("", errors.New("format error"))
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return mockFormatter, nil
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				formatter.NewFormatter = origNewFormatter
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runStatus(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output != tt.want {
+				t.Errorf("runStatus() = %v, want %v", output, tt.want)
+			}
+		})
+	}
+}
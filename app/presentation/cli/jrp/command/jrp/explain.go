@@ -0,0 +1,180 @@
+package jrp
+
+import (
+	"errors"
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// ExplainOptions provides the options for the explain command.
+type ExplainOptions struct {
+	// Format is a flag to specify the format of the output.
+	Format string
+}
+
+var (
+	// explainOps is a variable to store the explain options with the default values for injecting the dependencies in testing.
+	explainOps = ExplainOptions{
+		Format: "table",
+	}
+)
+
+// NewExplainCommand returns a new instance of the explain command.
+func NewExplainCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("explain")
+	cmd.SetAliases([]string{"exp", "e"})
+	cmd.SetUsageTemplate(explainUsageTemplate)
+	cmd.SetHelpTemplate(explainHelpTemplate)
+	cmd.SetArgs(cobra.MaximumNArgs(1))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&explainOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g. : \"plain\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runExplain(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runExplain runs the explain command.
+func runExplain(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if explainOps.Format != "table" && explainOps.Format != "plain" {
+		o := formatter.Red("🚨 Only the format \"table\" or \"plain\" is available...")
+		*output = o
+		return errors.New("unsupported format")
+	}
+
+	if len(args) == 0 {
+		o := formatter.Yellow("⚡ No ID argument specified...")
+		*output = o
+		return nil
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		o := formatter.Red("🚨 The ID argument must be an integer...")
+		*output = o
+		return err
+	}
+
+	connManager := database.GetConnectionManager()
+	if connManager == nil {
+		o := formatter.Red("❌ Connection manager is not initialized...")
+		*output = o
+		return nil
+	}
+
+	if _, err := connManager.GetConnection(database.WNJpnDB); err != nil && err.Error() == "connection not initialized" {
+		o := formatter.Yellow("⚡ You have to execute \"download\" to use jrp...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	ghuc := jrpApp.NewGetHistoryUseCase(historyRepo)
+
+	ghoDto, err := ghuc.RunById(cmd.Context(), id)
+	if err != nil {
+		return err
+	}
+	if ghoDto == nil {
+		o := formatter.Yellow("⚡ No history found...")
+		*output = o
+		return nil
+	}
+	if len(ghoDto.WordIDs) == 0 {
+		o := formatter.Yellow("⚡ The history has no source words to explain...")
+		*output = o
+		return nil
+	}
+
+	wordQueryService := query_service.NewWordQueryService()
+	fwduc := wnjpnApp.NewFetchWordDefinitionsUseCase(wordQueryService)
+
+	fwdoDtos, err := fwduc.Run(cmd.Context(), ghoDto.WordIDs)
+	if err != nil {
+		return err
+	}
+	if len(fwdoDtos) == 0 {
+		o := formatter.Yellow("⚡ No source words found...")
+		*output = o
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(explainOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(fwdoDtos)
+	if err != nil {
+		return err
+	}
+	*output = o
+
+	return nil
+}
+
+const (
+	// explainHelpTemplate is the help template of the explain command.
+	explainHelpTemplate = `📖 Explain the history of the "generate" command with the source words.
+
+You can specify the history to explain with an ID argument.
+You have to get ID from the "history" command.
+
+This command shows the words the phrase is generated from
+and the definitions and the glosses of their synsets in WordNet Japan.
+
+The histories generated before the source words are kept can not be explained.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table" and "plain" are available.
+
+` + explainUsageTemplate
+	// explainUsageTemplate is the usage template of the explain command.
+	explainUsageTemplate = `Usage:
+  jrp explain [flag] [argument]
+  jrp exp     [flag] [argument]
+  jrp e       [flag] [argument]
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g. : "plain")
+  -h, --help    🤝 help for explain
+
+Argument:
+  ID  🆔 explain with the ID of the history (e.g. : 1)
+`
+)
@@ -0,0 +1,474 @@
+package jrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewExplainCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewExplainCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewExplainCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{}); err != nil {
+					t.Errorf("Failed to run the explain command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runExplain(t *testing.T) {
+	var output string
+	origExplainOps := explainOps
+	origNewFetchWordDefinitionsUseCase := wnjpnApp.NewFetchWordDefinitionsUseCase
+	origNewFormatter := formatter.NewFormatter
+	origFunc := database.GetConnectionManagerFunc
+
+	initializeConnections := func() {
+		if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+			t.Errorf("Failed to remove test database: %v", err)
+		}
+		cm := database.NewConnectionManager(proxy.NewSql())
+		if err := cm.InitializeConnection(
+			database.ConnectionConfig{
+				DBName: database.JrpDB,
+				DBType: database.SQLite,
+				DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize connection: %v", err)
+		}
+		if err := cm.InitializeConnection(
+			database.ConnectionConfig{
+				DBName: database.WNJpnDB,
+				DBType: database.SQLite,
+				DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize connection: %v", err)
+		}
+	}
+	resetConnections := func() {
+		if err := database.ResetConnectionManager(); err != nil {
+			t.Errorf("Failed to reset connection manager: %v", err)
+		}
+		if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+			t.Errorf("Failed to remove test database: %v", err)
+		}
+	}
+	mockFetchWordDefinitionsUseCase := func(mockCtrl *gomock.Controller, dtos []*wnjpnApp.FetchWordDefinitionsDto, err error) {
+		mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+		mockWordQueryService.EXPECT().FindDefinitionsByWordIdIn(gomock.Any(), []int{6, 7}).Return(dtos, err)
+		wnjpnApp.NewFetchWordDefinitionsUseCase = func(_ wnjpnApp.WordQueryService) *wnjpnApp.FetchWordDefinitionsUseCaseStruct {
+			return origNewFetchWordDefinitionsUseCase(mockWordQueryService)
+		}
+	}
+	testData := []*historyDomain.History{
+		{
+			Phrase:      "test",
+			WordIDs:     sql.NullString{String: "6,7", Valid: true},
+			IsFavorited: 0,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		{
+			Phrase:      "test2",
+			IsFavorited: 0,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+
+	type args struct {
+		cmd    *c.Command
+		args   []string
+		output *string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		testData []*historyDomain.History
+		want     string
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *args)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: testData,
+			want:     "犬\tイヌ属の動物\ta member of the genus Canis\n猫",
+			wantErr:  false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				explainOps.Format = "plain"
+				initializeConnections()
+				mockFetchWordDefinitionsUseCase(mockCtrl, []*wnjpnApp.FetchWordDefinitionsDto{
+					{
+						WordID:     6,
+						Lemma:      sql.NullString{String: "犬", Valid: true},
+						Pos:        sql.NullString{String: "n", Valid: true},
+						Synset:     sql.NullString{String: "02084071-n", Valid: true},
+						Definition: sql.NullString{String: "イヌ属の動物", Valid: true},
+						Gloss:      sql.NullString{String: "a member of the genus Canis", Valid: true},
+					},
+					{
+						WordID: 7,
+						Lemma:  sql.NullString{String: "猫", Valid: true},
+						Pos:    sql.NullString{String: "n", Valid: true},
+					},
+				}, nil)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				explainOps = origExplainOps
+				wnjpnApp.NewFetchWordDefinitionsUseCase = origNewFetchWordDefinitionsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no ID arguments)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.YellowString("⚡ No ID argument specified..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ID argument is not an integer)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The ID argument must be an integer..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager == nil)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("❌ Connection manager is not initialized..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (WNJpnDB is not downloaded)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.YellowString("⚡ You have to execute \"download\" to use jrp..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager.GetConnection(database.WNJpnDB) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, _ *args) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.WNJpnDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				database.GetConnectionManagerFunc = func() database.ConnectionManager {
+					return mockConnManager
+				}
+				output = ""
+			},
+			cleanup: func() {
+				database.GetConnectionManagerFunc = origFunc
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (history not found)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"3"},
+				output: &output,
+			},
+			testData: testData,
+			want:     color.YellowString("⚡ No history found..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeConnections()
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (history has no word IDs)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"2"},
+				output: &output,
+			},
+			testData: testData,
+			want:     color.YellowString("⚡ The history has no source words to explain..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeConnections()
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no source words found)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: testData,
+			want:     color.YellowString("⚡ No source words found..."),
+			wantErr:  false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				initializeConnections()
+				mockFetchWordDefinitionsUseCase(mockCtrl, []*wnjpnApp.FetchWordDefinitionsDto{}, nil)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				wnjpnApp.NewFetchWordDefinitionsUseCase = origNewFetchWordDefinitionsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ghuc.RunById() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (fwduc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: testData,
+			want:     "",
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				initializeConnections()
+				mockFetchWordDefinitionsUseCase(mockCtrl, nil, errors.New("WordQueryService.FindDefinitionsByWordIdIn() failed"))
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				wnjpnApp.NewFetchWordDefinitionsUseCase = origNewFetchWordDefinitionsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (format option is not table or plain)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: testData,
+			want:     color.RedString("🚨 Only the format \"table\" or \"plain\" is available..."),
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				explainOps.Format = "json"
+				initializeConnections()
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				explainOps = origExplainOps
+				wnjpnApp.NewFetchWordDefinitionsUseCase = origNewFetchWordDefinitionsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: testData,
+			want:     color.RedString("❌ Failed to create a formatter..."),
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("formatter.NewFormatter() failed")
+				}
+				initializeConnections()
+				mockFetchWordDefinitionsUseCase(mockCtrl, []*wnjpnApp.FetchWordDefinitionsDto{
+					{
+						WordID: 6,
+						Lemma:  sql.NullString{String: "犬", Valid: true},
+					},
+				}, nil)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				resetConnections()
+				formatter.NewFormatter = origNewFormatter
+				explainOps = origExplainOps
+				wnjpnApp.NewFetchWordDefinitionsUseCase = origNewFetchWordDefinitionsUseCase
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if len(tt.testData) > 0 {
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			if err := runExplain(tt.args.cmd, tt.args.args, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runExplain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runExplain() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
 	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
 	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
@@ -31,24 +32,43 @@ type GenerateOptions struct {
 	Interactive bool
 	// Timeout is a flag to specify the timeout in seconds for the interactive mode.
 	Timeout int
+	// Template is a flag to specify the template of the phrases to generate.
+	Template string
+	// Raw is a flag to generate phrases without conjugating the adjectives and the verbs.
+	Raw bool
+	// Seed is a flag to specify the seed to generate the same phrases reproducibly.
+	Seed int64
+	// OutputTemplate is a flag to specify the template of the output for the template format.
+	OutputTemplate string
+	// OutputTemplateFile is a flag to specify the file of the template of the output for the template format.
+	OutputTemplateFile string
+	// Unique is a flag to generate only the phrases not duplicated and not in the history.
+	Unique bool
 }
 
 var (
 	// GenerateOps is a variable to store the generate options with the default values for injecting the dependencies in testing.
 	GenerateOps = GenerateOptions{
-		Number:      1,
-		Prefix:      "",
-		Suffix:      "",
-		DryRun:      false,
-		Format:      "table",
-		Interactive: false,
-		Timeout:     30,
+		Number:             1,
+		Prefix:             "",
+		Suffix:             "",
+		DryRun:             false,
+		Format:             "table",
+		Interactive:        false,
+		Timeout:            30,
+		Template:           "",
+		Raw:                false,
+		Seed:               0,
+		OutputTemplate:     "",
+		OutputTemplateFile: "",
+		Unique:             false,
 	}
 )
 
 // NewGenerateCommand returns a new instance of the generate command.
 func NewGenerateCommand(
 	cobra proxy.Cobra,
+	conf *config.JrpCliConfig,
 	interactiveCmd proxy.Command,
 	output *string,
 ) proxy.Command {
@@ -63,7 +83,7 @@ func NewGenerateCommand(
 		&GenerateOps.Number,
 		"number",
 		"n",
-		1,
+		conf.Generate.Number,
 		"🔢 number of phrases to generate (default 1, e.g. : 10)",
 	)
 	cmd.Flags().StringVarP(
@@ -84,14 +104,14 @@ func NewGenerateCommand(
 		&GenerateOps.DryRun,
 		"dry-run",
 		"d",
-		false,
+		conf.Generate.DryRun,
 		"🧪 generate phrases without saving to the history",
 	)
 	cmd.Flags().StringVarP(
 		&GenerateOps.Format,
 		"format",
 		"f",
-		"table",
+		conf.Generate.Format,
 		"📝 format of the output (default \"table\", e.g. : \"plain\")",
 	)
 	cmd.Flags().BoolVarP(
@@ -105,9 +125,51 @@ func NewGenerateCommand(
 		&GenerateOps.Timeout,
 		"timeout",
 		"t",
-		30,
+		conf.Generate.Timeout,
 		"⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)",
 	)
+	cmd.Flags().StringVarP(
+		&GenerateOps.Template,
+		"template",
+		"T",
+		"",
+		"🧩 template of phrases to generate (e.g. : \"{a}{n}の{n}\")",
+	)
+	cmd.Flags().BoolVarP(
+		&GenerateOps.Raw,
+		"raw",
+		"r",
+		false,
+		"🪨 generate phrases without conjugating adjectives and verbs",
+	)
+	cmd.Flags().Int64VarP(
+		&GenerateOps.Seed,
+		"seed",
+		"",
+		0,
+		"🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)",
+	)
+	cmd.Flags().StringVarP(
+		&GenerateOps.OutputTemplate,
+		"output-template",
+		"",
+		"",
+		"🖨️ Go template of the output for the template format",
+	)
+	cmd.Flags().StringVarP(
+		&GenerateOps.OutputTemplateFile,
+		"output-template-file",
+		"",
+		"",
+		"🖨️ file of the template of the output for the template format",
+	)
+	cmd.Flags().BoolVarP(
+		&GenerateOps.Unique,
+		"unique",
+		"",
+		false,
+		"🦄 generate only phrases not duplicated and not in the history",
+	)
 	cmd.AddCommand(interactiveCmd)
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
@@ -135,6 +197,12 @@ func runGenerate(
 		interactiveOps.Suffix = GenerateOps.Suffix
 		interactiveOps.Format = GenerateOps.Format
 		interactiveOps.Timeout = GenerateOps.Timeout
+		interactiveOps.Template = GenerateOps.Template
+		interactiveOps.Raw = GenerateOps.Raw
+		interactiveOps.Seed = GenerateOps.Seed
+		if cmd.Flags().Changed("number") {
+			interactiveOps.Number = GenerateOps.Number
+		}
 		return interactiveCmd.RunE(cmd, args)
 	}
 
@@ -162,12 +230,31 @@ func runGenerate(
 		return nil
 	}
 
-	var pos []string
-	if needRandomPrefix {
-		pos = append(pos, "a", "v")
+	var template *jrpApp.JrpTemplate
+	if GenerateOps.Template != "" {
+		if !needRandomPrefix || !needRandomSuffix {
+			o := formatter.Yellow("⚡ You can't specify the template with prefix or suffix at the same time...")
+			*output = o
+			return nil
+		}
+		template, err = jrpApp.ParseJrpTemplate(GenerateOps.Template)
+		if err != nil {
+			o := formatter.Red("🚨 The template is invalid...")
+			*output = o
+			return err
+		}
 	}
-	if needRandomSuffix {
-		pos = append(pos, "n")
+
+	var pos []string
+	if template != nil {
+		pos = template.Pos()
+	} else {
+		if needRandomPrefix {
+			pos = append(pos, "a", "v")
+		}
+		if needRandomSuffix {
+			pos = append(pos, "n")
+		}
 	}
 
 	wordQueryService := query_service.NewWordQueryService()
@@ -207,18 +294,42 @@ func runGenerate(
 		gjiDtos = append(gjiDtos, gjiDto)
 	}
 
-	gjuc := jrpApp.NewGenerateJrpUseCase()
-	var gjoDtos []*jrpApp.GenerateJrpUseCaseOutputDto
-	for i := 0; i < number; i++ {
-		var gjoDto *jrpApp.GenerateJrpUseCaseOutputDto
-		if needRandomPrefix && needRandomSuffix {
-			gjoDto = gjuc.RunWithRandom(gjiDtos)
+	pool := jrpApp.NewWordPool(gjiDtos)
+
+	gjuc := jrpApp.NewGenerateJrpUseCase(!GenerateOps.Raw)
+	if GenerateOps.Seed != 0 {
+		gjuc = gjuc.WithSeed(GenerateOps.Seed)
+	}
+	generate := func() *jrpApp.GenerateJrpUseCaseOutputDto {
+		if template != nil {
+			return gjuc.RunWithTemplate(pool, template)
+		} else if needRandomPrefix && needRandomSuffix {
+			return gjuc.RunWithRandom(pool)
 		} else if needRandomPrefix {
-			gjoDto = gjuc.RunWithSuffix(gjiDtos, GenerateOps.Suffix)
-		} else {
-			gjoDto = gjuc.RunWithPrefix(gjiDtos, GenerateOps.Prefix)
+			return gjuc.RunWithSuffix(pool, GenerateOps.Suffix)
+		}
+		return gjuc.RunWithPrefix(pool, GenerateOps.Prefix)
+	}
+	var gjoDtos []*jrpApp.GenerateJrpUseCaseOutputDto
+	if GenerateOps.Unique {
+		gjoDtos, err = gjuc.WithHistoryRepository(repository.NewHistoryRepository()).RunUnique(cmd.Context(), number, generate)
+		if err != nil && err.Error() == "not enough unique phrases" {
+			o := formatter.Red("🚨 The words can not generate " + strconv.Itoa(number) + " unique phrases not in the history...")
+			*output = o
+			return err
+		} else if err != nil {
+			return err
+		}
+	} else {
+		for i := 0; i < number; i++ {
+			gjoDto := generate()
+			if gjoDto == nil {
+				o := formatter.Yellow("⚡ The words can not generate phrases...")
+				*output = o
+				return nil
+			}
+			gjoDtos = append(gjoDtos, gjoDto)
 		}
-		gjoDtos = append(gjoDtos, gjoDto)
 	}
 
 	if !GenerateOps.DryRun {
@@ -226,6 +337,9 @@ func runGenerate(
 		for _, gjoDto := range gjoDtos {
 			shiDto := &jrpApp.SaveHistoryUseCaseInputDto{
 				Phrase:      gjoDto.Phrase,
+				Reading:     gjoDto.Reading,
+				Romaji:      gjoDto.Romaji,
+				WordIDs:     gjoDto.WordIDs,
 				Prefix:      gjoDto.Prefix,
 				Suffix:      gjoDto.Suffix,
 				IsFavorited: gjoDto.IsFavorited,
@@ -250,7 +364,12 @@ func runGenerate(
 		}
 	}
 
-	f, err := formatter.NewFormatter(GenerateOps.Format)
+	var f formatter.Formatter
+	if GenerateOps.Format == "template" {
+		f, err = formatter.NewTemplateFormatter(formatter.Fu, GenerateOps.OutputTemplate, GenerateOps.OutputTemplateFile)
+	} else {
+		f, err = formatter.NewFormatter(GenerateOps.Format)
+	}
 	if err != nil {
 		o := formatter.Red("❌ Failed to create a formatter...")
 		*output = o
@@ -275,6 +394,27 @@ If both are provided, the larger number takes precedence.
 And you can specify the prefix or suffix of the phrases to generate
 by the flag "-p" or "--prefix" and "-s" or "--suffix".
 
+Also, you can specify the template of the phrases to generate by the flag "-T" or "--template".
+The slots "{a}", "{v}" and "{n}" in the template are filled with a random adjective, verb and noun.
+(e.g. : "{a}{n}の{n}", "{v}{n}")
+
+The adjectives and verbs modifying the nouns are conjugated into the attributive form (e.g. : "静か" -> "静かな").
+You can generate phrases without the conjugation by the flag "-r" or "--raw".
+
+And you can generate the same phrases reproducibly by specifying the same seed by the flag "--seed".
+
+With the flag "--unique", the phrases duplicated or already in the history are not generated.
+If the words can not generate enough unique phrases, an error is shown.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table", "plain", "json", "ndjson", "csv", "tsv" and "template" are available.
+With the format "template", you can shape the output by the Go template
+specified by the flag "--output-template" or the file specified by the flag "--output-template-file".
+The fields ".ID", ".Phrase", ".Reading", ".Romaji", ".WordIDs", ".Prefix", ".Suffix", ".IsFavorited", ".CreatedAt" and ".UpdatedAt",
+and the functions "blue", "green", "red", "yellow", "formatTime" and "localTime" are available.
+
+The defaults of the flags "-n", "-d", "-f" and "-t" can be changed by the config file. (See "jrp config --help".)
+
 Those commands below are the same.
   "jrp" : "jrp generate"
   "jrp interactive" : "jrp --interactive" : "jrp generate interactive" : "jrp generate --interactive"
@@ -290,14 +430,20 @@ Available Subcommands:
   interactive, int, i  💬 Generate Japanese random phrases interactively.
 
 Flags:
-  -n, --number       🔢 number of phrases to generate (default 1, e.g. : 10)
-  -p, --prefix       🔡 prefix of phrases to generate
-  -s, --suffix       🔡 suffix of phrases to generate
-  -d, --dry-run      🧪 generate phrases without saving to the history
-  -f, --format       📝 format of the output (default "table", e.g. : "plain")
-  -i, --interactive  💬 generate Japanese random phrases interactively
-  -t, --timeout      ⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)
-  -h, --help         🤝 help for generate
+  -n, --number                🔢 number of phrases to generate (default 1, e.g. : 10)
+  -p, --prefix                🔡 prefix of phrases to generate
+  -s, --suffix                🔡 suffix of phrases to generate
+  -d, --dry-run               🧪 generate phrases without saving to the history
+  -f, --format                📝 format of the output (default "table", e.g. : "plain")
+      --output-template       🖨️ Go template of the output for the template format
+      --output-template-file  🖨️ file of the template of the output for the template format
+  -i, --interactive           💬 generate Japanese random phrases interactively
+  -t, --timeout               ⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)
+  -T, --template              🧩 template of phrases to generate (e.g. : "{a}{n}の{n}")
+  -r, --raw                   🪨 generate phrases without conjugating adjectives and verbs
+      --seed                  🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)
+      --unique                🦄 generate only phrases not duplicated and not in the history
+  -h, --help                  🤝 help for generate
 
 Argument:
   number  🔢 number of phrases to generate (default 1, e.g. : 10)
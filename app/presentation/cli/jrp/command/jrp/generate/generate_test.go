@@ -2,6 +2,7 @@ package generate
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
 	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
 
@@ -29,6 +31,7 @@ func TestNewGenerateCommand(t *testing.T) {
 
 	type args struct {
 		cobra          proxy.Cobra
+		conf           *config.JrpCliConfig
 		interactiveCmd proxy.Command
 		output         *string
 	}
@@ -42,7 +45,9 @@ func TestNewGenerateCommand(t *testing.T) {
 			name: "positive testing",
 			args: args{
 				cobra: proxy.NewCobra(),
+				conf:  &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(),
+					&config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 					new(string),
 				),
 				output: new(string),
@@ -88,7 +93,7 @@ func TestNewGenerateCommand(t *testing.T) {
 					tt.cleanup()
 				}
 			}()
-			got := NewGenerateCommand(tt.args.cobra, tt.args.interactiveCmd, tt.args.output)
+			got := NewGenerateCommand(tt.args.cobra, tt.args.conf, tt.args.interactiveCmd, tt.args.output)
 			if got == nil {
 				t.Errorf("NewGenerateCommand() = %v, want not nil", got)
 			} else {
@@ -132,7 +137,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -176,7 +181,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{"2"},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -220,7 +225,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{"2"},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -266,7 +271,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{"3"},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -312,7 +317,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -358,7 +363,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -404,7 +409,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -456,7 +461,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -472,7 +477,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -501,7 +506,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -523,7 +528,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: false,
@@ -570,7 +575,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -623,7 +628,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{"test"},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -667,7 +672,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -704,7 +709,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -750,7 +755,7 @@ func Test_runGenerate(t *testing.T) {
 			args: args{
 				cmd:            &c.Command{},
 				args:           []string{},
-				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &output),
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
 				output:         &output,
 			},
 			wantErr: true,
@@ -795,6 +800,506 @@ func Test_runGenerate(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (template option is set)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Template = "{a}{n}の{n}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (both template and suffix options are set)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Suffix = "suffix"
+				GenerateOps.Template = "{a}{n}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (jrpApp.ParseJrpTemplate(GenerateOps.Template) failed)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Template = "{x}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (raw option is set)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Raw = true
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (seed option is set)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Seed = 42
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (unique option is set)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				GenerateOps.Unique = true
+				GenerateOps.Number = 10
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gjuc.RunUnique(cmd.Context(), number, generate) failed)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				GenerateOps.Unique = true
+				GenerateOps.Number = 2
+				GenerateOps.Prefix = "テスト"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return([]*wnjpnApp.FetchWordsDto{
+						{
+							WordID: 1,
+							Lang:   sql.NullString{String: "jpn", Valid: true},
+							Lemma:  sql.NullString{String: "山", Valid: true},
+							Pron:   sql.NullString{String: "やま", Valid: true},
+							Pos:    sql.NullString{String: "n", Valid: true},
+						},
+					}, nil)
+				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (the words can not generate phrases by the template)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				GenerateOps.Template = "{a}{n}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return([]*wnjpnApp.FetchWordsDto{
+						{
+							WordID: 1,
+							Lang:   sql.NullString{String: "jpn", Valid: true},
+							Lemma:  sql.NullString{String: "山", Valid: true},
+							Pron:   sql.NullString{String: "やま", Valid: true},
+							Pos:    sql.NullString{String: "n", Valid: true},
+						},
+					}, nil)
+				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (format option is template)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				GenerateOps.Format = "template"
+				GenerateOps.OutputTemplate = "{{.ID}}\t{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewTemplateFormatter(formatter.Fu, GenerateOps.OutputTemplate, GenerateOps.OutputTemplateFile) failed)",
+			args: args{
+				cmd:            &c.Command{},
+				args:           []string{},
+				interactiveCmd: NewInteractiveCommand(proxy.NewCobra(), &config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()}, &output),
+				output:         &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				GenerateOps.Format = "template"
+				GenerateOps.OutputTemplate = "{{.Phrase"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				GenerateOps = origGenerateOps
+				output = ""
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,8 +1,10 @@
 package generate
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
 	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
 	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
 
@@ -27,28 +30,46 @@ type InteractiveOptions struct {
 	Format string
 	// Timeout is a flag to specify the timeout in seconds for the interactive mode.
 	Timeout int
+	// Template is a flag to specify the template of the phrases to generate.
+	Template string
+	// Raw is a flag to generate phrases without conjugating the adjectives and the verbs.
+	Raw bool
+	// Seed is a flag to specify the seed to generate the same phrases reproducibly.
+	Seed int64
+	// Number is a flag to specify the number of the candidates to generate in each phase.
+	Number int
 }
 
+const (
+	// maxInteractiveNumber is the max number of the candidates in each phase, which can be selected by a number key.
+	maxInteractiveNumber = 9
+)
+
 var (
 	// interactiveOps is a variable to store the interactive options with the default values for injecting the dependencies in testing.
 	interactiveOps = InteractiveOptions{
-		Prefix:  "",
-		Suffix:  "",
-		Format:  "table",
-		Timeout: 30,
+		Prefix:   "",
+		Suffix:   "",
+		Format:   "table",
+		Timeout:  30,
+		Template: "",
+		Raw:      false,
+		Seed:     0,
+		Number:   1,
 	}
 )
 
 // NewInteractiveCommand returns a new instance of the interactive command.
 func NewInteractiveCommand(
 	cobra proxy.Cobra,
+	conf *config.JrpCliConfig,
 	output *string,
 ) proxy.Command {
 	cmd := cobra.NewCommand()
 	cmd.SetUse("interactive")
 	cmd.SetAliases([]string{"int", "i"})
 	cmd.SetUsageTemplate(interactiveUsageTemplate)
-	cmd.SetHelpTemplate(interactiveHelpTemplate)
+	cmd.SetHelpTemplate(newInteractiveHelpTemplate(conf.Keybindings))
 	cmd.SetArgs(cobra.MaximumNArgs(1))
 	cmd.SetSilenceErrors(true)
 	cmd.PersistentFlags().StringVarP(
@@ -69,21 +90,51 @@ func NewInteractiveCommand(
 		&interactiveOps.Format,
 		"format",
 		"f",
-		"table",
+		conf.Generate.Format,
 		"📝 format of the output (default \"table\", e.g: \"plain\")",
 	)
 	cmd.PersistentFlags().IntVarP(
 		&interactiveOps.Timeout,
 		"timeout",
 		"t",
-		30,
+		conf.Generate.Timeout,
 		"⌛ timeout in seconds for the interactive mode (default 30, e.g: 10)",
 	)
+	cmd.PersistentFlags().StringVarP(
+		&interactiveOps.Template,
+		"template",
+		"T",
+		"",
+		"🧩 template of phrases to generate (e.g: \"{a}{n}の{n}\")",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&interactiveOps.Raw,
+		"raw",
+		"r",
+		false,
+		"🪨 generate phrases without conjugating adjectives and verbs",
+	)
+	cmd.PersistentFlags().Int64VarP(
+		&interactiveOps.Seed,
+		"seed",
+		"",
+		0,
+		"🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)",
+	)
+	cmd.PersistentFlags().IntVarP(
+		&interactiveOps.Number,
+		"number",
+		"n",
+		1,
+		"🔢 number of candidates to generate in each phase (default 1, max 9, e.g. : 5)",
+	)
 
 	cmd.SetRunE(
-		func(cmd *c.Command, _ []string) error {
+		func(cmd *c.Command, args []string) error {
 			return runInteractive(
 				cmd,
+				args,
+				conf.Keybindings,
 				output,
 			)
 		},
@@ -95,6 +146,8 @@ func NewInteractiveCommand(
 // runInteractive runs the interactive command.
 func runInteractive(
 	cmd *c.Command,
+	args []string,
+	keybindings config.Keybindings,
 	output *string,
 ) error {
 	connManager := database.GetConnectionManager()
@@ -121,12 +174,49 @@ func runInteractive(
 		return nil
 	}
 
-	var pos []string
-	if needRandomPrefix {
-		pos = append(pos, "a", "v")
+	var number = interactiveOps.Number
+	if len(args) > 0 {
+		argNumber, err := strconv.Atoi(args[0])
+		if err != nil {
+			o := formatter.Red("🚨 The number argument must be an integer...")
+			*output = o
+			return err
+		}
+		if argNumber > number {
+			number = argNumber
+		}
+	}
+	if number < 1 || number > maxInteractiveNumber {
+		o := formatter.Yellow("⚡ The number of candidates must be between 1 and " + strconv.Itoa(maxInteractiveNumber) + "...")
+		*output = o
+		return nil
+	}
+
+	var template *jrpApp.JrpTemplate
+	if interactiveOps.Template != "" {
+		if !needRandomPrefix || !needRandomSuffix {
+			o := formatter.Yellow("⚡ You can't specify the template with prefix or suffix at the same time...")
+			*output = o
+			return nil
+		}
+		template, err = jrpApp.ParseJrpTemplate(interactiveOps.Template)
+		if err != nil {
+			o := formatter.Red("🚨 The template is invalid...")
+			*output = o
+			return err
+		}
 	}
-	if needRandomSuffix {
-		pos = append(pos, "n")
+
+	var pos []string
+	if template != nil {
+		pos = template.Pos()
+	} else {
+		if needRandomPrefix {
+			pos = append(pos, "a", "v")
+		}
+		if needRandomSuffix {
+			pos = append(pos, "n")
+		}
 	}
 
 	wordQueryService := query_service.NewWordQueryService()
@@ -141,43 +231,97 @@ func runInteractive(
 		return err
 	}
 
+	var gjiDtos []*jrpApp.GenerateJrpUseCaseInputDto
+	for _, fwDto := range fwoDtos {
+		gjiDto := &jrpApp.GenerateJrpUseCaseInputDto{
+			WordID: fwDto.WordID,
+			Lang:   fwDto.Lang,
+			Lemma:  fwDto.Lemma,
+			Pron:   fwDto.Pron,
+			Pos:    fwDto.Pos,
+		}
+		gjiDtos = append(gjiDtos, gjiDto)
+	}
+	// the pool is built once and shared by all the phases.
+	pool := jrpApp.NewWordPool(gjiDtos)
+
+	gjuc := jrpApp.NewGenerateJrpUseCase(!interactiveOps.Raw)
+	if interactiveOps.Seed != 0 {
+		gjuc = gjuc.WithSeed(interactiveOps.Seed)
+	}
+
+	// the words of one side can be re-rolled or locked only if both sides are random words.
+	canReroll := template == nil && needRandomPrefix && needRandomSuffix
+	// keptPrefix and keptSuffix are the words kept in the next phase, lockedPrefix and lockedSuffix are kept until unlocked.
+	var keptPrefix, keptSuffix, lockedPrefix, lockedSuffix *jrpApp.GenerateJrpUseCaseInputDto
+
+	promptLabel := newInteractivePromptLabel(keybindings)
 	phase := 1
+	var gjoDtos []*jrpApp.GenerateJrpUseCaseOutputDto
+	// selected is the flags to indicate whether each candidate is selected by the number key.
+	var selected []bool
 	for {
 		if err := presenter.Print(os.Stdout, formatter.Blue("🔄 Phase : "+strconv.Itoa(phase))); err != nil {
 			return err
 		}
-
-		var gjiDtos []*jrpApp.GenerateJrpUseCaseInputDto
-		for _, fwDto := range fwoDtos {
-			gjiDto := &jrpApp.GenerateJrpUseCaseInputDto{
-				WordID: fwDto.WordID,
-				Lang:   fwDto.Lang,
-				Lemma:  fwDto.Lemma,
-				Pron:   fwDto.Pron,
-				Pos:    fwDto.Pos,
+		if lockedPrefix != nil || lockedSuffix != nil {
+			if err := presenter.Print(os.Stdout, "\n"+formatter.Blue(lockedLabel(lockedPrefix, lockedSuffix))); err != nil {
+				return err
 			}
-			gjiDtos = append(gjiDtos, gjiDto)
 		}
 
-		gjuc := jrpApp.NewGenerateJrpUseCase()
-		var gjoDtos []*jrpApp.GenerateJrpUseCaseOutputDto
-		var gjoDto *jrpApp.GenerateJrpUseCaseOutputDto
-		if needRandomPrefix && needRandomSuffix {
-			gjoDto = gjuc.RunWithRandom(gjiDtos)
-		} else if needRandomPrefix {
-			gjoDto = gjuc.RunWithSuffix(gjiDtos, GenerateOps.Suffix)
-		} else {
-			gjoDto = gjuc.RunWithPrefix(gjiDtos, GenerateOps.Prefix)
+		if gjoDtos == nil {
+			prefixWord := keptPrefix
+			if lockedPrefix != nil {
+				prefixWord = lockedPrefix
+			}
+			suffixWord := keptSuffix
+			if lockedSuffix != nil {
+				suffixWord = lockedSuffix
+			}
+			keptPrefix, keptSuffix = nil, nil
+
+			for i := 0; i < number; i++ {
+				var gjoDto *jrpApp.GenerateJrpUseCaseOutputDto
+				if template != nil {
+					gjoDto = gjuc.RunWithTemplate(pool, template)
+				} else if prefixWord != nil || suffixWord != nil {
+					gjoDto = gjuc.RunWithWords(pool, prefixWord, suffixWord)
+				} else if needRandomPrefix && needRandomSuffix {
+					gjoDto = gjuc.RunWithRandom(pool)
+				} else if needRandomPrefix {
+					gjoDto = gjuc.RunWithSuffix(pool, GenerateOps.Suffix)
+				} else {
+					gjoDto = gjuc.RunWithPrefix(pool, GenerateOps.Prefix)
+				}
+				if gjoDto == nil {
+					o := formatter.Yellow("⚡ The words can not generate phrases...")
+					*output = o
+					return nil
+				}
+				gjoDtos = append(gjoDtos, gjoDto)
+			}
+			// the only candidate is always the target of the action.
+			selected = make([]bool, len(gjoDtos))
+			if len(selected) == 1 {
+				selected[0] = true
+			}
 		}
-		gjoDtos = append(gjoDtos, gjoDto)
 
+		var result interface{} = gjoDtos
+		if len(gjoDtos) > 1 {
+			result = &formatter.JrpCandidates{
+				Jrps:     gjoDtos,
+				Selected: selected,
+			}
+		}
 		f, err := formatter.NewFormatter(interactiveOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(gjoDtos)
+		o, err := f.Format(result)
 		if err != nil {
 			return err
 		}
@@ -190,7 +334,12 @@ func runInteractive(
 		if err := presenter.Print(os.Stdout, "\n"); err != nil {
 			return err
 		}
-		if err := presenter.Print(os.Stdout, formatter.Yellow(interactivePromptLabel)); err != nil {
+		if len(gjoDtos) > 1 {
+			if err := presenter.Print(os.Stdout, formatter.Yellow(interactiveCandidatesLabel)); err != nil {
+				return err
+			}
+		}
+		if err := presenter.Print(os.Stdout, formatter.Yellow(promptLabel)); err != nil {
 			return err
 		}
 
@@ -205,26 +354,94 @@ func runInteractive(
 			return err
 		}
 
+		if n, err := strconv.Atoi(answer); err == nil && len(gjoDtos) > 1 {
+			if n < 1 || n > len(gjoDtos) {
+				if err := presenter.Print(os.Stdout, formatter.Yellow("⚡ There is no candidate of the number...")+"\n"); err != nil {
+					return err
+				}
+				continue
+			}
+			selected[n-1] = !selected[n-1]
+			continue
+		}
+
+		action := keybindings.Action(answer)
+		var targets []*jrpApp.GenerateJrpUseCaseOutputDto
+		for i, gjoDto := range gjoDtos {
+			if selected[i] {
+				targets = append(targets, gjoDto)
+			}
+		}
+
+		switch action {
+		case config.ActionRerollPrefix, config.ActionRerollSuffix, config.ActionLockPrefix, config.ActionLockSuffix:
+			if !canReroll {
+				if err := presenter.Print(os.Stdout, formatter.Yellow("⚡ Only the phrases with the random prefix and suffix can be re-rolled or locked...")+"\n"); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(targets) != 1 {
+				if err := presenter.Print(os.Stdout, formatter.Yellow("⚡ Select one candidate by the number key to re-roll or lock...")+"\n"); err != nil {
+					return err
+				}
+				continue
+			}
+		case config.ActionFavoriteContinue, config.ActionFavoriteExit, config.ActionSaveContinue, config.ActionSaveExit:
+			if len(targets) == 0 {
+				if err := presenter.Print(os.Stdout, formatter.Yellow("⚡ Select the candidates by the number keys to favorite or save...")+"\n"); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
 		var save bool
 		var cont bool
-		switch answer {
-		case "u", "U":
-			gjoDtos[0].IsFavorited = 1
+		switch action {
+		case config.ActionFavoriteContinue:
+			for _, target := range targets {
+				target.IsFavorited = 1
+			}
 			save = true
 			cont = true
-		case "i", "I":
-			gjoDtos[0].IsFavorited = 1
+		case config.ActionFavoriteExit:
+			for _, target := range targets {
+				target.IsFavorited = 1
+			}
 			save = true
 			cont = false
-		case "j", "J":
+		case config.ActionSaveContinue:
 			save = true
 			cont = true
-		case "k", "K":
+		case config.ActionSaveExit:
 			save = true
 			cont = false
-		case "m", "M":
+		case config.ActionSkipContinue:
+			save = false
+			cont = true
+		case config.ActionRerollPrefix:
+			keptSuffix = targets[0].SuffixWord
 			save = false
 			cont = true
+		case config.ActionRerollSuffix:
+			keptPrefix = targets[0].PrefixWord
+			save = false
+			cont = true
+		case config.ActionLockPrefix:
+			if lockedPrefix == nil {
+				lockedPrefix = targets[0].PrefixWord
+			} else {
+				lockedPrefix = nil
+			}
+			continue
+		case config.ActionLockSuffix:
+			if lockedSuffix == nil {
+				lockedSuffix = targets[0].SuffixWord
+			} else {
+				lockedSuffix = nil
+			}
+			continue
 		default:
 			save = false
 			cont = false
@@ -232,14 +449,17 @@ func runInteractive(
 
 		if save {
 			var shiDtos []*jrpApp.SaveHistoryUseCaseInputDto
-			for _, gjoDto := range gjoDtos {
+			for _, target := range targets {
 				shiDto := &jrpApp.SaveHistoryUseCaseInputDto{
-					Phrase:      gjoDto.Phrase,
-					Prefix:      gjoDto.Prefix,
-					Suffix:      gjoDto.Suffix,
-					IsFavorited: gjoDto.IsFavorited,
-					CreatedAt:   gjoDto.CreatedAt,
-					UpdatedAt:   gjoDto.UpdatedAt,
+					Phrase:      target.Phrase,
+					Reading:     target.Reading,
+					Romaji:      target.Romaji,
+					WordIDs:     target.WordIDs,
+					Prefix:      target.Prefix,
+					Suffix:      target.Suffix,
+					IsFavorited: target.IsFavorited,
+					CreatedAt:   target.CreatedAt,
+					UpdatedAt:   target.UpdatedAt,
 				}
 				shiDtos = append(shiDtos, shiDto)
 			}
@@ -252,7 +472,7 @@ func runInteractive(
 				return err
 			}
 
-			if answer == "u" || answer == "U" || answer == "i" || answer == "I" {
+			if action == config.ActionFavoriteContinue || action == config.ActionFavoriteExit {
 				if err := presenter.Print(os.Stdout, formatter.Green("✅ Favorited successfully!")); err != nil {
 					return err
 				}
@@ -283,50 +503,104 @@ func runInteractive(
 			break
 		}
 
+		gjoDtos = nil
 		phase++
 	}
 
 	return nil
 }
 
+var (
+	// interactiveActionLabels is a variable to store the labels of the actions of the interactive mode.
+	interactiveActionLabels = map[string]string{
+		config.ActionFavoriteContinue: "Favorite, continue.",
+		config.ActionFavoriteExit:     "Favorite, exit.",
+		config.ActionSaveContinue:     "Save, continue.",
+		config.ActionSaveExit:         "Save, exit.",
+		config.ActionSkipContinue:     "Skip, continue.",
+		config.ActionRerollPrefix:     "Skip, re-roll the prefix keeping the suffix, continue.",
+		config.ActionRerollSuffix:     "Skip, re-roll the suffix keeping the prefix, continue.",
+		config.ActionLockPrefix:       "Lock or unlock the prefix.",
+		config.ActionLockSuffix:       "Lock or unlock the suffix.",
+	}
+)
+
+// newInteractiveHelpTemplate returns the help template of the interactive command with the keys bound to the actions.
+func newInteractiveHelpTemplate(keybindings config.Keybindings) string {
+	return interactiveHelpTemplate + interactiveKeysLabel(keybindings) + "\n" + generateUsageTemplate
+}
+
+// newInteractivePromptLabel returns the prompt label of the interactive command with the keys bound to the actions.
+func newInteractivePromptLabel(keybindings config.Keybindings) string {
+	return interactivePromptLabel + interactiveKeysLabel(keybindings)
+}
+
+// interactiveKeysLabel returns the label of the keys bound to the actions of the interactive mode.
+func interactiveKeysLabel(keybindings config.Keybindings) string {
+	var label string
+	for _, action := range config.InteractiveActions {
+		label += fmt.Sprintf("  %-5s : %s\n", "\""+keybindings[action]+"\"", interactiveActionLabels[action])
+	}
+
+	return label + "  other : Skip, exit.\n"
+}
+
+// lockedLabel returns the label of the locked words.
+func lockedLabel(lockedPrefix *jrpApp.GenerateJrpUseCaseInputDto, lockedSuffix *jrpApp.GenerateJrpUseCaseInputDto) string {
+	var locked []string
+	if lockedPrefix != nil {
+		locked = append(locked, "prefix \""+lockedPrefix.Lemma+"\"")
+	}
+	if lockedSuffix != nil {
+		locked = append(locked, "suffix \""+lockedSuffix.Lemma+"\"")
+	}
+
+	return "🔒 Locked : " + strings.Join(locked, ", ")
+}
+
 const (
-	// interactiveHelpTemplate is the help template of the interactive command.
+	// interactiveHelpTemplate is the help template of the interactive command followed by the keys and the usage.
 	interactiveHelpTemplate = `💬 Generate Japanese random phrases interactively.
 
 You can specify the prefix or suffix of the phrases to generate
 by the flag "-p" or "--prefix" and "-s" or "--suffix".
+Also, you can specify the template of the phrases to generate by the flag "-T" or "--template".
+The adjectives and verbs are conjugated unless you specify the flag "-r" or "--raw".
+And you can generate the same phrases reproducibly by specifying the same seed by the flag "--seed".
 
 And you can choose to save or favorite the phrases generated interactively.
+Without the prefix, the suffix and the template, you can re-roll only the prefix or the suffix of the phrase,
+and lock the prefix or the suffix to keep it in the following phases until you unlock it.
+You can specify how many candidates to generate in each phase by flag "-n" or "--number" or the argument, up to 9.
+With several candidates, select the candidates by the number keys first, then the actions below apply to the selected ones.
+You can change the keys below by "interactive.keybindings" in the config file "XDG_CONFIG_HOME/jrp/config.yaml".
 
 Press either key below for your action:
-  "u"   : Favorite, continue.
-  "i"   : Favorite, exit.
-  "j"   : Save, continue.
-  "k"   : Save, exit.
-  "m"   : Skip, continue.
-  other : Skip, exit.
-
-` + generateUsageTemplate
+`
 	// interactiveUsageTemplate is the usage template of the interactive command.
 	interactiveUsageTemplate = `Usage:
-  jrp interactive [flags]
-  jrp int         [flags]
-  jrp i           [flags]
+  jrp interactive [flags] [argument]
+  jrp int         [flags] [argument]
+  jrp i           [flags] [argument]
 
 Flags:
-  -p, --prefix   🔡 prefix of phrases to generate
-  -s, --suffix   🔡 suffix of phrases to generate
-  -P, --plain    📝 plain text output instead of table output
-  -t, --timeout  ⌛ timeout second for the interactive mode (default 30, e.g: 10)
-  -h, --help     🤝 help for interactive
+  -n, --number    🔢 number of candidates to generate in each phase (default 1, max 9, e.g. : 5)
+  -p, --prefix    🔡 prefix of phrases to generate
+  -s, --suffix    🔡 suffix of phrases to generate
+  -P, --plain     📝 plain text output instead of table output
+  -t, --timeout   ⌛ timeout second for the interactive mode (default 30, e.g: 10)
+  -T, --template  🧩 template of phrases to generate (e.g: "{a}{n}の{n}")
+  -r, --raw       🪨 generate phrases without conjugating adjectives and verbs
+      --seed      🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)
+  -h, --help      🤝 help for interactive
+
+Argument:
+  number  🔢 number of candidates to generate in each phase (default 1, max 9, e.g. : 5)
+`
+	// interactiveCandidatesLabel is the label shown with several candidates.
+	interactiveCandidatesLabel = `🔢 Press the number key of the candidate to select or deselect it, then press the key below for the selected ones.
 `
-	// interactivePromptLabel is the prompt label of the interactive command.
+	// interactivePromptLabel is the prompt label of the interactive command followed by the keys.
 	interactivePromptLabel = `🔽 Press either key below for your action:
-  "u"   : Favorite, continue.
-  "i"   : Favorite, exit.
-  "j"   : Save, continue.
-  "k"   : Save, exit.
-  "m"   : Skip, continue.
-  other : Skip, exit.
 `
 )
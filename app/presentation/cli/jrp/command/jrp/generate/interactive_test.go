@@ -2,6 +2,7 @@ package generate
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"io"
 	"os"
@@ -14,6 +15,7 @@ import (
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
 	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
 
@@ -32,6 +34,7 @@ func TestNewInteractiveCommand(t *testing.T) {
 
 	type args struct {
 		cobra  proxy.Cobra
+		conf   *config.JrpCliConfig
 		output *string
 	}
 	tests := []struct {
@@ -43,7 +46,11 @@ func TestNewInteractiveCommand(t *testing.T) {
 		{
 			name: "positive testing",
 			args: args{
-				cobra:  proxy.NewCobra(),
+				cobra: proxy.NewCobra(),
+				conf: &config.JrpCliConfig{
+					Generate:    config.DefaultGenerateConfig(),
+					Keybindings: config.DefaultKeybindings(),
+				},
 				output: new(string),
 			},
 			setup: func(mockCtrl *gomock.Controller) {
@@ -95,7 +102,7 @@ func TestNewInteractiveCommand(t *testing.T) {
 					tt.cleanup()
 				}
 			}()
-			got := NewInteractiveCommand(tt.args.cobra, tt.args.output)
+			got := NewInteractiveCommand(tt.args.cobra, tt.args.conf, tt.args.output)
 			if got == nil {
 				t.Errorf("NewInteractiveCommand() = %v, want not nil", got)
 			} else {
@@ -123,8 +130,10 @@ func Test_runInteractive(t *testing.T) {
 	}
 
 	type args struct {
-		cmd    *c.Command
-		output *string
+		cmd         *c.Command
+		args        []string
+		keybindings config.Keybindings
+		output      *string
 	}
 	tests := []struct {
 		name    string
@@ -136,8 +145,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "positive testing (keyboard input: \"u\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -187,8 +197,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "positive testing (keyboard input: \"i\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -235,8 +246,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "positive testing (keyboard input: \"j\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -286,8 +298,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "positive testing (keyboard input: \"k\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -334,8 +347,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "positive testing (keyboard input: \"m\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -383,13 +397,15 @@ func Test_runInteractive(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (keyboard input: \",\")",
+			name: "positive testing (number option is set, keyboard input: \"1\", \"3\" and \"j\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -411,6 +427,15 @@ func Test_runInteractive(t *testing.T) {
 				}
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
 				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("1", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("3", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
 				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
 				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
@@ -426,19 +451,21 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
+				interactiveOps = origInteractiveOps
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "positive testing (prefix option is set)",
+			name: "positive testing (number option is set, keyboard input: \"u\" without selection, \"2\" and \"u\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				interactiveOps.Prefix = "prefix"
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -460,6 +487,15 @@ func Test_runInteractive(t *testing.T) {
 				}
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
 				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("2", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
 				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
 				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
@@ -481,14 +517,15 @@ func Test_runInteractive(t *testing.T) {
 			},
 		},
 		{
-			name: "positive testing (suffix option is set)",
+			name: "positive testing (number option is set, keyboard input: \"p\" without selection, \"1\" and \"p\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				interactiveOps.Suffix = "suffix"
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -510,6 +547,15 @@ func Test_runInteractive(t *testing.T) {
 				}
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
 				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("1", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
 				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
 				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
@@ -531,27 +577,69 @@ func Test_runInteractive(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (connManager == nil)",
+			name: "positive testing (number option is set, keyboard input: \"9\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *args) {
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("9", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
 				output = ""
 			},
 			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (connManager.GetConnection(WNJpnDB) == connection not initialized)",
+			name: "positive testing (number argument is set)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				args:        []string{"3"},
+				output:      &output,
 			},
 			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *args) {
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -562,45 +650,171 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("2", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("k", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
 				output = ""
 			},
 			cleanup: func() {
 				if err := database.ResetConnectionManager(); err != nil {
 					t.Errorf("Failed to reset connection manager: %v", err)
 				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (connectionManager.GetConnection(WNJpnDB) failed)",
+			name: "positive testing (keybindings are customized, keyboard input: \"T\")",
 			args: args{
-				cmd:    &c.Command{},
+				cmd: &c.Command{},
+				keybindings: config.Keybindings{
+					config.ActionFavoriteContinue: "g",
+					config.ActionFavoriteExit:     "c",
+					config.ActionSaveContinue:     "t",
+					config.ActionSaveExit:         "n",
+					config.ActionSkipContinue:     "m",
+					config.ActionRerollPrefix:     "p",
+					config.ActionRerollSuffix:     "s",
+					config.ActionLockPrefix:       "h",
+					config.ActionLockSuffix:       "l",
+				},
 				output: &output,
 			},
-			wantErr: true,
+			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				mockConnManager := database.NewMockConnectionManager(mockCtrl)
-				mockConnManager.EXPECT().GetConnection(database.WNJpnDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
-				database.GetConnectionManagerFunc = func() database.ConnectionManager {
-					return mockConnManager
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("T", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
 				output = ""
 			},
 			cleanup: func() {
-				database.GetConnectionManagerFunc = origFunc
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (both prefix and suffix options are set)",
+			name: "positive testing (number option is set, keyboard input: \"1\", \"3\" and \"j\")",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: false,
-			setup: func(_ *gomock.Controller, tt *args) {
-				interactiveOps.Prefix = "prefix"
-				interactiveOps.Suffix = "suffix"
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("1", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("3", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (number option is set, keyboard input: \"u\" without selection, \"2\" and \"u\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -620,6 +834,20 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("2", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -633,14 +861,1177 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (number option is set, keyboard input: \"p\" without selection, \"1\" and \"p\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("1", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (number option is set, keyboard input: \"9\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("9", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (number argument is set)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				args:        []string{"3"},
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("2", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("k", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \"p\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \"s\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("s", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \"h\" twice)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("h", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("h", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \"l\" and \"j\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("l", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \"p\", prefix option is set)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Prefix = "prefix"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("p", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (keyboard input: \",\")",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (prefix option is set)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Prefix = "prefix"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (suffix option is set)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Suffix = "suffix"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return(",", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (the words can not generate phrases by the template)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Template = "{a}{n}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return([]*wnjpnApp.FetchWordsDto{
+						{
+							WordID: 1,
+							Lang:   sql.NullString{String: "jpn", Valid: true},
+							Lemma:  sql.NullString{String: "山", Valid: true},
+							Pron:   sql.NullString{String: "やま", Valid: true},
+							Pos:    sql.NullString{String: "n", Valid: true},
+						},
+					}, nil)
+				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager == nil)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager.GetConnection(WNJpnDB) == connection not initialized)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connectionManager.GetConnection(WNJpnDB) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.WNJpnDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				database.GetConnectionManagerFunc = func() database.ConnectionManager {
+					return mockConnManager
+				}
+				output = ""
+			},
+			cleanup: func() {
+				database.GetConnectionManagerFunc = origFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (both prefix and suffix options are set)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				interactiveOps.Prefix = "prefix"
+				interactiveOps.Suffix = "suffix"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (number argument is not an integer)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				args:        []string{"number"},
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (number option is greater than the max)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				interactiveOps.Number = maxInteractiveNumber + 1
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (fwuc.Run() failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return(nil, errors.New("WordQueryService.FindByLangIsAndPosIn() failed"))
+				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"🔄 Phase : \"+strconv.Itoa(phase)))) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Phase") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Print = origPrint
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter(interactiveOps.Format) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Format = "test"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				interactiveOps = origInteractiveOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.Print(os.Stdout, \"\n\")) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				printCount := 0
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Phase") {
+						return origPrint(writer, output)
+					}
+					if output == "\n" {
+						printCount++
+						if printCount == 1 {
+							return errors.New("Print(\\n) failed")
+						}
+					}
+					return origPrint(writer, output)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Print = origPrint
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.Print(os.Stdout, o)) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				printCount := 0
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Phase") || output == "\n" {
+						return origPrint(writer, output)
+					}
+					printCount++
+					if printCount == 1 {
+						return errors.New("Print(o) failed")
+					}
+					return origPrint(writer, output)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Print = origPrint
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) failed)",
+			args: args{
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				newlineCount := 0
+				presenter.Print = func(writer io.Writer, output string) error {
+					if output == "\n" {
+						newlineCount++
+						if newlineCount == 2 {
+							return errors.New("Print(\\n) failed")
+						}
+					}
+					return origPrint(writer, output)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (fwuc.Run() failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(newInteractivePromptLabel(config.DefaultKeybindings())))) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -663,13 +2054,11 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
-				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
-				mockWordQueryService.EXPECT().
-					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
-					Return(nil, errors.New("WordQueryService.FindByLangIsAndPosIn() failed"))
-				origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
-				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
-					return origNewFetchWordsUseCase(mockWordQueryService)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Press either key below") {
+						return errors.New("Print(promptLabel) failed")
+					}
+					return origPrint(writer, output)
 				}
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
@@ -683,15 +2072,16 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"🔄 Phase : \"+strconv.Itoa(phase)))) failed)",
+			name: "negative testing (presenter.OpenKeyboard() failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -714,12 +2104,9 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Phase") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(errors.New("KeyboardUtil.OpenKeyboard() failed"))
+				presenter.Ku = mockKeyboardUtil
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -732,19 +2119,20 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				presenter.Print = origPrint
+				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (formatter.NewFormatter(interactiveOps.Format) failed)",
+			name: "negative testing (presenter.GetKey(interactiveOps.Timeout) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
-				interactiveOps.Format = "test"
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -764,6 +2152,10 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("", errors.New("KeyboardUtil.GetKey() failed"))
+				presenter.Ku = mockKeyboardUtil
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -777,14 +2169,16 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				interactiveOps = origInteractiveOps
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, \"\n\")) failed)",
+			name: "negative testing (presenter.CloseKeyboard() failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -807,19 +2201,11 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
-				printCount := 0
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Phase") {
-						return origPrint(writer, output)
-					}
-					if output == "\n" {
-						printCount++
-						if printCount == 1 {
-							return errors.New("Print(\\n) failed")
-						}
-					}
-					return origPrint(writer, output)
-				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("m", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Return(errors.New("KeyboardUtil.CloseKeyboard() failed")).Times(1)
+				presenter.Ku = mockKeyboardUtil
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -832,28 +2218,20 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				presenter.Print = origPrint
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, o)) failed)",
+			name: "negative testing (shuc.Run() failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
-				if err := cm.InitializeConnection(
-					database.ConnectionConfig{
-						DBName: database.JrpDB,
-						DBType: database.SQLite,
-						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
-					},
-				); err != nil {
-					t.Errorf("Failed to initialize connection: %v", err)
-				}
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
 						DBName: database.WNJpnDB,
@@ -863,17 +2241,11 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
-				printCount := 0
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Phase") || output == "\n" {
-						return origPrint(writer, output)
-					}
-					printCount++
-					if printCount == 1 {
-						return errors.New("Print(o) failed")
-					}
-					return origPrint(writer, output)
-				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -886,15 +2258,16 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				presenter.Print = origPrint
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Green(\"✅ Favorited successfully!\"))) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -917,13 +2290,14 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
-				newlineCount := 0
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
 				presenter.Print = func(writer io.Writer, output string) error {
-					if output == "\n" {
-						newlineCount++
-						if newlineCount == 2 {
-							return errors.New("Print(\\n) failed")
-						}
+					if strings.Contains(output, "Favorited successfully") {
+						return errors.New("Print(Favorited successfully) failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -940,14 +2314,16 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(interactivePromptLabel))) failed)",
+			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) after favorited failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -970,9 +2346,19 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				presenter.Ku = mockKeyboardUtil
+				var successMsgShown bool
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Press either key below") {
-						return errors.New("Print(interactivePromptLabel) failed")
+					if strings.Contains(output, "Favorited successfully") {
+						successMsgShown = true
+						return origPrint(writer, output)
+					}
+					if output == "\n" && successMsgShown {
+						return errors.New("Print(\\n) after favorited failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -989,14 +2375,16 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.OpenKeyboard() failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Green(\"✅ Saved successfully!\")) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1020,8 +2408,16 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(errors.New("KeyboardUtil.OpenKeyboard() failed"))
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Saved successfully") {
+						return errors.New("Print(Saved successfully) failed")
+					}
+					return origPrint(writer, output)
+				}
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -1034,16 +2430,17 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				interactiveOps = origInteractiveOps
+				presenter.Print = origPrint
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.GetKey(interactiveOps.Timeout) failed)",
+			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) after saved failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1068,8 +2465,20 @@ func Test_runInteractive(t *testing.T) {
 				}
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
 				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("", errors.New("KeyboardUtil.GetKey() failed"))
+				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
+				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
+				var successMsgShown bool
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "Saved successfully") {
+						successMsgShown = true
+						return origPrint(writer, output)
+					}
+					if output == "\n" && successMsgShown {
+						return errors.New("Print(\\n) after saved failed")
+					}
+					return origPrint(writer, output)
+				}
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -1082,16 +2491,17 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
-				interactiveOps = origInteractiveOps
+				presenter.Print = origPrint
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.CloseKeyboard() failed)",
+			name: "negative testing (presenter.Print(os.Stdout, \"\n\"+formatter.Blue(lockedLabel(lockedPrefix, lockedSuffix))) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1114,11 +2524,19 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
 				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("m", nil).Times(1)
-				mockKeyboardUtil.EXPECT().CloseKeyboard().Return(errors.New("KeyboardUtil.CloseKeyboard() failed")).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(gomock.Any()).Return("h", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Times(1)
 				presenter.Ku = mockKeyboardUtil
+				presenter.Print = func(writer io.Writer, output string) error {
+					printCalls++
+					if strings.Contains(output, "🔒 Locked") {
+						return errors.New("Print(lockedLabel) failed")
+					}
+					return origPrint(writer, output)
+				}
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -1131,19 +2549,31 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
+				presenter.Print = origPrint
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (shuc.Run() failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(\"⚡ Only the phrases with the random prefix and suffix can be re-rolled or locked...\")+\"\n\") failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Prefix = "prefix"
 				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
 						DBName: database.WNJpnDB,
@@ -1153,11 +2583,19 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
-				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(gomock.Any()).Return("p", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Times(1)
 				presenter.Ku = mockKeyboardUtil
+				presenter.Print = func(writer io.Writer, output string) error {
+					printCalls++
+					if output == formatter.Yellow("⚡ Only the phrases with the random prefix and suffix can be re-rolled or locked...")+"\n" {
+						return errors.New("Print(re-roll unavailable) failed")
+					}
+					return origPrint(writer, output)
+				}
 				cmd := &c.Command{}
 				cmd.SetContext(context.Background())
 				tt.cmd = cmd
@@ -1170,18 +2608,22 @@ func Test_runInteractive(t *testing.T) {
 				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
+				interactiveOps = origInteractiveOps
+				presenter.Print = origPrint
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Green(\"✅ Favorited successfully!\"))) failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(interactiveCandidatesLabel)) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -1201,14 +2643,13 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
-				mockKeyboardUtil.EXPECT().CloseKeyboard()
 				presenter.Ku = mockKeyboardUtil
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Favorited successfully") {
-						return errors.New("Print(Favorited successfully) failed")
+					printCalls++
+					if output == formatter.Yellow(interactiveCandidatesLabel) {
+						return errors.New("Print(interactiveCandidatesLabel) failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -1225,18 +2666,21 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				interactiveOps = origInteractiveOps
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) after favorited failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(\"⚡ There is no candidate of the number...\")+\"\n\") failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -1256,19 +2700,16 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("u", nil)
-				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(gomock.Any()).Return("9", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Times(1)
 				presenter.Ku = mockKeyboardUtil
-				var successMsgShown bool
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Favorited successfully") {
-						successMsgShown = true
-						return origPrint(writer, output)
-					}
-					if output == "\n" && successMsgShown {
-						return errors.New("Print(\\n) after favorited failed")
+					printCalls++
+					if output == formatter.Yellow("⚡ There is no candidate of the number...")+"\n" {
+						return errors.New("Print(no candidate) failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -1285,18 +2726,21 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				interactiveOps = origInteractiveOps
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Green(\"✅ Saved successfully!\")) failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(\"⚡ Select one candidate by the number key to re-roll or lock...\")+\"\n\") failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -1316,14 +2760,16 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
-				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(gomock.Any()).Return("p", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Times(1)
 				presenter.Ku = mockKeyboardUtil
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Saved successfully") {
-						return errors.New("Print(Saved successfully) failed")
+					printCalls++
+					if output == formatter.Yellow("⚡ Select one candidate by the number key to re-roll or lock...")+"\n" {
+						return errors.New("Print(select one) failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -1340,18 +2786,21 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				interactiveOps = origInteractiveOps
 				presenter.Ku = origKu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, \"\n\"))) after saved failed)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(\"⚡ Select the candidates by the number keys to favorite or save...\")+\"\n\") failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				interactiveOps.Number = 3
 				cm := database.NewConnectionManager(proxy.NewSql())
 				if err := cm.InitializeConnection(
 					database.ConnectionConfig{
@@ -1371,19 +2820,16 @@ func Test_runInteractive(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize connection: %v", err)
 				}
+				var printCalls int
 				mockKeyboardUtil := utility.NewMockKeyboardUtil(mockCtrl)
-				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil)
-				mockKeyboardUtil.EXPECT().GetKey(interactiveOps.Timeout).Return("j", nil)
-				mockKeyboardUtil.EXPECT().CloseKeyboard()
+				mockKeyboardUtil.EXPECT().OpenKeyboard().Return(nil).Times(1)
+				mockKeyboardUtil.EXPECT().GetKey(gomock.Any()).Return("j", nil).Times(1)
+				mockKeyboardUtil.EXPECT().CloseKeyboard().Times(1)
 				presenter.Ku = mockKeyboardUtil
-				var successMsgShown bool
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "Saved successfully") {
-						successMsgShown = true
-						return origPrint(writer, output)
-					}
-					if output == "\n" && successMsgShown {
-						return errors.New("Print(\\n) after saved failed")
+					printCalls++
+					if output == formatter.Yellow("⚡ Select the candidates by the number keys to favorite or save...")+"\n" {
+						return errors.New("Print(select candidates) failed")
 					}
 					return origPrint(writer, output)
 				}
@@ -1400,6 +2846,7 @@ func Test_runInteractive(t *testing.T) {
 					t.Errorf("Failed to remove test database: %v", err)
 				}
 				presenter.Print = origPrint
+				interactiveOps = origInteractiveOps
 				presenter.Ku = origKu
 				output = ""
 			},
@@ -1407,8 +2854,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "negative testing (presenter.Print(os.Stdout, formatter.Yellow(\"⏩ Skip!\")) failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1464,8 +2912,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "negative testing (presenter.Print(os.Stdout, \"\n\")) after Skip failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1524,8 +2973,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "negative testing (f.Format() failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1572,8 +3022,9 @@ func Test_runInteractive(t *testing.T) {
 		{
 			name: "negative testing (presenter.Print(os.Stdout, \"🚪 Exit!\") failed)",
 			args: args{
-				cmd:    &c.Command{},
-				output: &output,
+				cmd:         &c.Command{},
+				keybindings: config.DefaultKeybindings(),
+				output:      &output,
 			},
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
@@ -1637,7 +3088,7 @@ func Test_runInteractive(t *testing.T) {
 					tt.cleanup()
 				}
 			}()
-			err := runInteractive(tt.args.cmd, tt.args.output)
+			err := runInteractive(tt.args.cmd, tt.args.args, tt.args.keybindings, tt.args.output)
 			if tt.wantErr {
 				if err == nil {
 					t.Errorf("runInteractive() error = %v, wantErr %v", err, tt.wantErr)
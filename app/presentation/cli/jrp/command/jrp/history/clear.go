@@ -115,6 +115,9 @@ You can clear the histories.
 This is the same as the "history remove -a" command.
 Also, you can clear the histories even if it is favorited by using the "-f" or ""--force" flag.
 
+The histories removed are moved to the trash.
+You can restore them by the "history restore" command, or remove them permanently by the "history purge" command.
+
 ` + clearUsageTemplate
 	// clearUsageTemplate is the usage template of the clear command.
 	clearUsageTemplate = `Usage:
@@ -0,0 +1,168 @@
+package history
+
+import (
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// ExportOptions provides the options for the export command.
+type ExportOptions struct {
+	// Favorited is a flag to export only favorited histories.
+	Favorited bool
+	// Format is a flag to specify the format of the exported histories.
+	Format string
+	// Output is a flag to specify the file to export the histories to.
+	Output string
+}
+
+var (
+	// exportOps is a variable to store the export options with the default values for injecting the dependencies in testing.
+	exportOps = ExportOptions{
+		Favorited: false,
+		Format:    "json",
+		Output:    "",
+	}
+)
+
+// NewExportCommand returns a new instance of the export command.
+func NewExportCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("export")
+	cmd.SetAliases([]string{"ex", "e"})
+	cmd.SetUsageTemplate(exportUsageTemplate)
+	cmd.SetHelpTemplate(exportHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&exportOps.Favorited,
+		"favorited",
+		"F",
+		false,
+		"🌟 export only favorited histories",
+	)
+	cmd.Flags().StringVarP(
+		&exportOps.Format,
+		"format",
+		"f",
+		"json",
+		"📝 format of the exported histories (default \"json\", e.g. : \"csv\")",
+	)
+	cmd.Flags().StringVarP(
+		&exportOps.Output,
+		"output",
+		"o",
+		"",
+		"📁 file to export the histories to (default : stdout)",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runExport(
+				cmd,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runExport runs the export command.
+func runExport(
+	cmd *c.Command,
+	output *string,
+) error {
+	// only the formats which can be imported again are allowed.
+	if _, err := formatter.NewParser(exportOps.Format); err != nil {
+		o := formatter.Red("❌ The format is not supported to export...")
+		*output = o
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	ghuc := jrpApp.NewGetHistoryUseCase(historyRepo)
+
+	ghoDtos, err := ghuc.Run(
+		cmd.Context(),
+		&jrpApp.GetHistoryUseCaseInputDto{
+			All:       true,
+			Favorited: exportOps.Favorited,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(ghoDtos) == 0 {
+		o := formatter.Yellow("⚡ No histories to export...")
+		*output = o
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(exportOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(ghoDtos)
+	if err != nil {
+		return err
+	}
+
+	if exportOps.Output == "" {
+		*output = o
+		return nil
+	}
+
+	if err := formatter.Fu.WriteFile(exportOps.Output, []byte(o+"\n")); err != nil {
+		o := formatter.Red("❌ Failed to write the exported histories to the file...")
+		*output = o
+		return err
+	}
+
+	o = formatter.Green("✅ Exported " + strconv.Itoa(len(ghoDtos)) + " histories to " + exportOps.Output + " successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// exportHelpTemplate is the help template of the export command.
+	exportHelpTemplate = `📜📤 Export the histories of the "generate" command.
+
+You can export all the histories to share them with the other machines or your team.
+The exported histories can be imported by the "history import" command.
+
+You can specify the format of the exported histories by flag "-f" or "--format".
+"json", "ndjson", "csv" and "tsv" are available.
+
+The histories are written to stdout by default.
+You can write them to a file by flag "-o" or "--output".
+
+Also, you can export only the favorited histories by flag "-F" or "--favorited".
+
+` + exportUsageTemplate
+	// exportUsageTemplate is the usage template of the export command.
+	exportUsageTemplate = `Usage:
+  jrp history export [flag]
+  jrp history ex     [flag]
+  jrp history e      [flag]
+
+Flags:
+  -F, --favorited  🌟 export only favorited histories
+  -f, --format     📝 format of the exported histories (default "json", e.g. : "csv")
+  -o, --output     📁 file to export the histories to (default : stdout)
+  -h, --help       🤝 help for export
+`
+)
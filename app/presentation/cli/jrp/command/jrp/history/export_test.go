@@ -0,0 +1,482 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewExportCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewExportCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewExportCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run export command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runExport(t *testing.T) {
+	var output string
+	origExportOps := exportOps
+	origFu := formatter.Fu
+	origNewFormatter := formatter.NewFormatter
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		testData []*historyDomain.History
+		want     string
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *args)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    `[{"id":1,"phrase":"test1","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":true,"created_at":"` + now.UTC().Format(time.RFC3339Nano) + `","updated_at":"` + now.UTC().Format(time.RFC3339Nano) + `"},{"id":2,"phrase":"test2","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":false,"created_at":"` + now.UTC().Format(time.RFC3339Nano) + `","updated_at":"` + now.UTC().Format(time.RFC3339Nano) + `"}]`,
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (favorited option is set, format option is csv)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    "id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at\n1,test1,,,,,,true," + now.Format(time.RFC3339Nano) + "," + now.Format(time.RFC3339Nano),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				exportOps.Favorited = true
+				exportOps.Format = "csv"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				exportOps = origExportOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (output option is set)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    color.GreenString("✅ Exported 2 histories to " + filepath.Join(os.TempDir(), "jrp_export.json") + " successfully!"),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				exportOps.Output = filepath.Join(os.TempDir(), "jrp_export.json")
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp_export.json")); err != nil {
+					t.Errorf("Failed to remove the exported file: %v", err)
+				}
+				exportOps = origExportOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no histories in the database)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⚡ No histories to export..."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewParser(exportOps.Format) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.RedString("❌ The format is not supported to export..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				exportOps.Format = "table"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				exportOps = origExportOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ghuc.Run() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "notexist", "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter(exportOps.Format) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    color.RedString("❌ Failed to create a formatter..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("invalid format")
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				formatter.NewFormatter = origNewFormatter
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.Fu.WriteFile() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    color.RedString("❌ Failed to write the exported histories to the file..."),
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				exportOps.Output = filepath.Join(os.TempDir(), "jrp_export.json")
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().WriteFile(exportOps.Output, gomock.Any()).Return(errors.New("FileUtil.WriteFile() failed"))
+				formatter.Fu = mockFileUtil
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				formatter.Fu = origFu
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				exportOps = origExportOps
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := repository.NewHistoryRepository()
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(context.Background(), tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			if err := runExport(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runExport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output != tt.want {
+				t.Errorf("runExport() = %v, want %v", output, tt.want)
+			}
+		})
+	}
+}
@@ -15,10 +15,20 @@ var (
 	// historyOps is a variable to store the history options with the default values for injecting the dependencies in testing.
 	historyOps = HistoryOptions{
 		ShowOptions: ShowOptions{
-			Number:    1,
-			All:       false,
-			Favorited: false,
-			Format:    "table",
+			Number:             1,
+			All:                false,
+			Favorited:          false,
+			Tag:                "",
+			Since:              "",
+			Until:              "",
+			Sort:               "id",
+			Desc:               false,
+			Offset:             0,
+			Page:               0,
+			Trashed:            false,
+			Format:             "table",
+			OutputTemplate:     "",
+			OutputTemplateFile: "",
 		},
 	}
 )
@@ -56,6 +66,62 @@ func NewHistoryCommand(
 		false,
 		"🌟 show only favorited histories",
 	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.Tag,
+		"tag",
+		"t",
+		"",
+		"🏷️ show only histories tagged with the tag",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.Since,
+		"since",
+		"",
+		"",
+		"📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.Until,
+		"until",
+		"",
+		"",
+		"📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.Sort,
+		"sort",
+		"",
+		"id",
+		"🔀 key to sort the histories by (default \"id\", e.g. : \"created\", \"phrase\")",
+	)
+	cmd.Flags().BoolVarP(
+		&historyOps.ShowOptions.Desc,
+		"desc",
+		"",
+		false,
+		"🔽 sort the histories in descending order",
+	)
+	cmd.Flags().IntVarP(
+		&historyOps.ShowOptions.Offset,
+		"offset",
+		"",
+		0,
+		"⏭️ number of the most recent histories to skip (e.g. : 50)",
+	)
+	cmd.Flags().IntVarP(
+		&historyOps.ShowOptions.Page,
+		"page",
+		"p",
+		0,
+		"📄 page of the histories to show, each page has the number of histories (e.g. : 2)",
+	)
+	cmd.Flags().BoolVarP(
+		&historyOps.ShowOptions.Trashed,
+		"trashed",
+		"",
+		false,
+		"🗑️ show the histories in the trash instead",
+	)
 	cmd.Flags().StringVarP(
 		&historyOps.ShowOptions.Format,
 		"format",
@@ -63,6 +129,20 @@ func NewHistoryCommand(
 		"table",
 		"📝 format of the output (default \"table\", e.g. : \"plain\")",
 	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.OutputTemplate,
+		"output-template",
+		"",
+		"",
+		"🖨️ Go template of the output for the template format",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.ShowOptions.OutputTemplateFile,
+		"output-template-file",
+		"",
+		"",
+		"🖨️ file of the template of the output for the template format",
+	)
 
 	showCmd := NewShowCommand(
 		cobra,
@@ -73,10 +153,26 @@ func NewHistoryCommand(
 			cobra,
 			output,
 		),
+		NewExportCommand(
+			cobra,
+			output,
+		),
+		NewImportCommand(
+			cobra,
+			output,
+		),
+		NewPurgeCommand(
+			cobra,
+			output,
+		),
 		NewRemoveCommand(
 			cobra,
 			output,
 		),
+		NewRestoreCommand(
+			cobra,
+			output,
+		),
 		NewSearchCommand(
 			cobra,
 			output,
@@ -111,7 +207,8 @@ const (
 	// historyHelpTemplate is the help template of the history command.
 	historyHelpTemplate = `📜 Manage the histories of the "generate" command.
 
-You can show, search, remove and clear the histories of the "generate" command.
+You can show, search, remove, clear, restore, purge, export and import the histories of the "generate" command.
+The histories removed or cleared are moved to the trash, and can be restored until they are purged.
 
 You can specify how many histories to show by flag "-n" or "--number" or a number argument.
 jrp will get the most recent histories from the histories.
@@ -121,6 +218,29 @@ If both are provided, the larger number takes precedence.
 Also, you can show all the histories the history by flag "-a" or "--all".
 If you use the flag, the number flag or argument will be ignored.
 
+You can show only the histories tagged with a tag by flag "-t" or "--tag".
+Tag the histories with "jrp tag add".
+
+You can show only the histories created in a period by flags "--since" and "--until".
+They accept a date (e.g. : 2026-01-02), a date and time (e.g. : "2026-01-02 15:04") or a duration ago (e.g. : 30m, 12h, 7d, 2w).
+The date or the time of the flag "--until" is included.
+
+You can page through the histories by flag "--offset" to skip the most recent histories, or by flag "-p" or "--page".
+A page has as many histories as the number flag or argument, and the table format shows the page and the number of the pages.
+
+You can show the histories in the trash by flag "--trashed".
+Restore them with "jrp history restore", or remove them permanently with "jrp history purge".
+
+You can sort the histories by flag "--sort" with "id", "created" or "phrase", and in descending order by flag "--desc".
+With "created" or "phrase", the number flag or argument and the pages still take the most recent histories, and sort them.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table", "plain", "json", "ndjson", "csv", "tsv" and "template" are available.
+With the format "template", you can shape the output by the Go template
+specified by the flag "--output-template" or the file specified by the flag "--output-template-file".
+The fields ".ID", ".Phrase", ".Reading", ".Romaji", ".WordIDs", ".Prefix", ".Suffix", ".IsFavorited", ".CreatedAt" and ".UpdatedAt",
+and the functions "blue", "green", "red", "yellow", "formatTime" and "localTime" are available.
+
 ` + historyUsageTemplate
 	// historyUsageTemplate is the usage template of the history command.
 	historyUsageTemplate = `Usage:
@@ -137,13 +257,27 @@ Available Subommands:
   search, se, S  📜🔍 Search the histories of the "generate" command.
   remove, rm, r  📜🧹 Remove the histories of the "generate" command.
   clear,  cl, c  📜✨ Clear the histories of the "generate" command.
+  restore, rs, R 📜♻️ Restore the histories of the "generate" command from the trash.
+  purge,  pu, p  📜🔥 Purge the histories of the "generate" command in the trash.
+  export, ex, e  📜📤 Export the histories of the "generate" command.
+  import, im, i  📜📥 Import the histories of the "generate" command.
 
 Flags:
-  -n, --number     🔢 number how many histories to show (default 10, e.g. : 50)
-  -a, --all        📁 show all the histories
-  -F, --favorited  🌟 show only favorited histories
-  -f, --format     📝 format of the output (default "table", e.g. : "plain")
-  -h, --help       🤝 help for history
+  -n, --number                🔢 number how many histories to show (default 10, e.g. : 50)
+  -a, --all                   📁 show all the histories
+  -F, --favorited             🌟 show only favorited histories
+  -t, --tag                   🏷️ show only histories tagged with the tag
+      --since                 📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --until                 📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --sort                  🔀 key to sort the histories by (default "id", e.g. : "created", "phrase")
+      --desc                  🔽 sort the histories in descending order
+      --offset                ⏭️ number of the most recent histories to skip (e.g. : 50)
+  -p, --page                  📄 page of the histories to show, each page has the number of histories (e.g. : 2)
+      --trashed               🗑️ show the histories in the trash instead
+  -f, --format                📝 format of the output (default "table", e.g. : "plain")
+      --output-template       🖨️ Go template of the output for the template format
+      --output-template-file  🖨️ file of the template of the output for the template format
+  -h, --help                  🤝 help for history
 
 Argument:
   number  🔢 number how many histories to show (default 10, e.g. : 50)
@@ -129,7 +129,7 @@ func Test_runHistory(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
 			wantErr: false,
 			setup: func(tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
@@ -0,0 +1,195 @@
+package history
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// ImportOptions provides the options for the import command.
+type ImportOptions struct {
+	// Format is a flag to specify the format of the histories to import.
+	Format string
+	// Dedupe is a flag to skip the histories whose phrase already exists.
+	Dedupe bool
+}
+
+var (
+	// importOps is a variable to store the import options with the default values for injecting the dependencies in testing.
+	importOps = ImportOptions{
+		Format: "json",
+		Dedupe: false,
+	}
+	// stdin is a variable to store the standard input for injecting the dependencies in testing.
+	stdin io.Reader = os.Stdin
+)
+
+// NewImportCommand returns a new instance of the import command.
+func NewImportCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("import")
+	cmd.SetAliases([]string{"im", "i"})
+	cmd.SetUsageTemplate(importUsageTemplate)
+	cmd.SetHelpTemplate(importHelpTemplate)
+	cmd.SetArgs(cobra.MaximumNArgs(1))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&importOps.Format,
+		"format",
+		"f",
+		"json",
+		"📝 format of the histories to import (default \"json\", e.g. : \"csv\")",
+	)
+	cmd.Flags().BoolVarP(
+		&importOps.Dedupe,
+		"dedupe",
+		"d",
+		false,
+		"🧹 skip the histories whose phrase already exists",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runImport(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runImport runs the import command.
+func runImport(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	p, err := formatter.NewParser(importOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ The format is not supported to import...")
+		*output = o
+		return err
+	}
+
+	var data []byte
+	if len(args) == 0 || args[0] == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = formatter.Fu.ReadFile(args[0])
+	}
+	if err != nil {
+		o := formatter.Red("❌ Failed to read the histories to import...")
+		*output = o
+		return err
+	}
+
+	shiDtos, err := p.Parse(data)
+	if err != nil {
+		o := formatter.Red("❌ Failed to parse the histories to import...")
+		*output = o
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+
+	skipped := 0
+	if importOps.Dedupe {
+		ghuc := jrpApp.NewGetHistoryUseCase(historyRepo)
+		ghoDtos, err := ghuc.Run(
+			cmd.Context(),
+			&jrpApp.GetHistoryUseCaseInputDto{
+				All: true,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		phrases := make(map[string]bool, len(ghoDtos))
+		for _, ghoDto := range ghoDtos {
+			phrases[ghoDto.Phrase] = true
+		}
+		var deduped []*jrpApp.SaveHistoryUseCaseInputDto
+		for _, shiDto := range shiDtos {
+			if phrases[shiDto.Phrase] {
+				skipped++
+				continue
+			}
+			phrases[shiDto.Phrase] = true
+			deduped = append(deduped, shiDto)
+		}
+		shiDtos = deduped
+	}
+
+	if len(shiDtos) == 0 {
+		o := formatter.Yellow("⚡ No histories to import...")
+		*output = appendSkipped(o, skipped)
+		return nil
+	}
+
+	// all the histories are saved in one transaction, so nothing is imported if saving fails.
+	shuc := jrpApp.NewSaveHistoryUseCase(historyRepo)
+	if _, err := shuc.Run(cmd.Context(), shiDtos); err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Imported " + strconv.Itoa(len(shiDtos)) + " histories successfully!")
+	*output = appendSkipped(o, skipped)
+
+	return nil
+}
+
+// appendSkipped appends the number of the histories skipped by the dedupe flag to the output.
+func appendSkipped(output string, skipped int) string {
+	if skipped == 0 {
+		return output
+	}
+
+	return output + "\n" + formatter.Yellow("⏭️ Skipped "+strconv.Itoa(skipped)+" histories whose phrase already exists.")
+}
+
+const (
+	// importHelpTemplate is the help template of the import command.
+	importHelpTemplate = `📜📥 Import the histories of the "generate" command.
+
+You can import the histories exported by the "history export" command.
+The favorites and the timestamps of the histories are preserved.
+
+You can specify the file to import with an argument.
+If you don't specify the file or specify "-", jrp will read the histories from stdin.
+
+You can specify the format of the histories to import by flag "-f" or "--format".
+"json", "ndjson", "csv" and "tsv" are available.
+
+Also, you can skip the histories whose phrase already exists by flag "-d" or "--dedupe".
+
+` + importUsageTemplate
+	// importUsageTemplate is the usage template of the import command.
+	importUsageTemplate = `Usage:
+  jrp history import [flag] [argument]
+  jrp history im     [flag] [argument]
+  jrp history i      [flag] [argument]
+
+Flags:
+  -f, --format  📝 format of the histories to import (default "json", e.g. : "csv")
+  -d, --dedupe  🧹 skip the histories whose phrase already exists
+  -h, --help    🤝 help for import
+
+Argument:
+  file  📁 file to import the histories from (default : stdin)
+`
+)
@@ -0,0 +1,449 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewImportCommand(t *testing.T) {
+	origStdin := stdin
+
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				stdin = strings.NewReader("[]")
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				stdin = origStdin
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewImportCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewImportCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run import command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runImport(t *testing.T) {
+	var output string
+	origImportOps := importOps
+	origFu := formatter.Fu
+	origStdin := stdin
+
+	type args struct {
+		cmd    *c.Command
+		args   []string
+		output *string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		testData []*historyDomain.History
+		want     string
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *args)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{filepath.Join(os.TempDir(), "jrp_import.json")},
+				output: &output,
+			},
+			want:    color.GreenString("✅ Imported 2 histories successfully!"),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				if err := os.WriteFile(filepath.Join(os.TempDir(), "jrp_import.json"), []byte(`[{"phrase":"test1","is_favorited":true},{"phrase":"test3"}]`), 0644); err != nil {
+					t.Errorf("Failed to write the file to import: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp_import.json")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove the file to import: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (dedupe option is set)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{filepath.Join(os.TempDir(), "jrp_import.json")},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:          1,
+					Phrase:      "test1",
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+				{
+					ID:        2,
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    color.GreenString("✅ Imported 1 histories successfully!") + "\n" + color.YellowString("⏭️ Skipped 1 histories whose phrase already exists."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				importOps.Dedupe = true
+				if err := os.WriteFile(filepath.Join(os.TempDir(), "jrp_import.json"), []byte(`[{"phrase":"test1","is_favorited":true},{"phrase":"test3"}]`), 0644); err != nil {
+					t.Errorf("Failed to write the file to import: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp_import.json")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove the file to import: %v", err)
+				}
+				importOps = origImportOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (read from stdin, format option is csv)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"-"},
+				output: &output,
+			},
+			want:    color.GreenString("✅ Imported 1 histories successfully!"),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				importOps.Format = "csv"
+				stdin = strings.NewReader("phrase,is_favorited\ntest,true\n")
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				stdin = origStdin
+				importOps = origImportOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no histories to import)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			want:    color.YellowString("⚡ No histories to import..."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				stdin = strings.NewReader("[]")
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				stdin = origStdin
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewParser(importOps.Format) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			want:    color.RedString("❌ The format is not supported to import..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				importOps.Format = "table"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				importOps = origImportOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.Fu.ReadFile(args[0]) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{filepath.Join(os.TempDir(), "jrp_import.json")},
+				output: &output,
+			},
+			want:    color.RedString("❌ Failed to read the histories to import..."),
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().ReadFile(filepath.Join(os.TempDir(), "jrp_import.json")).Return(nil, errors.New("FileUtil.ReadFile() failed"))
+				formatter.Fu = mockFileUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				formatter.Fu = origFu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (p.Parse(data) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			want:    color.RedString("❌ Failed to parse the histories to import..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				stdin = strings.NewReader("{")
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				stdin = origStdin
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ghuc.Run() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				importOps.Dedupe = true
+				stdin = strings.NewReader(`[{"phrase":"test"}]`)
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "notexist", "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				stdin = origStdin
+				importOps = origImportOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (shuc.Run() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				stdin = strings.NewReader(`[{"phrase":"test"}]`)
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "notexist", "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				stdin = origStdin
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := repository.NewHistoryRepository()
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(context.Background(), tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			if err := runImport(tt.args.cmd, tt.args.args, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runImport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output != tt.want {
+				t.Errorf("runImport() = %v, want %v", output, tt.want)
+			}
+		})
+	}
+}
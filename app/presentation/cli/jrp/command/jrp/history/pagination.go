@@ -0,0 +1,50 @@
+package history
+
+import (
+	"errors"
+
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+)
+
+// pageOffset returns the offset of the histories from the offset and page flags.
+func pageOffset(offset int, page int, number int, output *string) (int, error) {
+	if offset < 0 {
+		o := formatter.Red("🚨 The offset flag must not be negative...")
+		*output = o
+		return 0, errors.New("offset is negative")
+	}
+	if page < 0 {
+		o := formatter.Red("🚨 The page flag must be a positive number...")
+		*output = o
+		return 0, errors.New("page is negative")
+	}
+	if offset > 0 && page > 0 {
+		o := formatter.Red("🚨 The offset flag and the page flag can not be used together...")
+		*output = o
+		return 0, errors.New("offset and page are both specified")
+	}
+	if page > 0 {
+		return (page - 1) * number, nil
+	}
+
+	return offset, nil
+}
+
+// newHistoryPage returns the page of the histories at the offset.
+func newHistoryPage(histories interface{}, offset int, number int, total int) *formatter.HistoryPage {
+	page := 1
+	pages := 1
+	if number > 0 {
+		page = offset/number + 1
+		pages = (total + number - 1) / number
+	}
+	if pages < page {
+		pages = page
+	}
+
+	return &formatter.HistoryPage{
+		Histories: histories,
+		Page:      page,
+		Pages:     pages,
+	}
+}
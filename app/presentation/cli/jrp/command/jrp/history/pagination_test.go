@@ -0,0 +1,128 @@
+package history
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+)
+
+func Test_pageOffset(t *testing.T) {
+	var output string
+
+	type args struct {
+		offset int
+		page   int
+		number int
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantOut string
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (neither is specified)",
+			args:    args{offset: 0, page: 0, number: 10, output: &output},
+			want:    0,
+			wantOut: "",
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (offset is specified)",
+			args:    args{offset: 5, page: 0, number: 10, output: &output},
+			want:    5,
+			wantOut: "",
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (page is specified)",
+			args:    args{offset: 0, page: 3, number: 10, output: &output},
+			want:    20,
+			wantOut: "",
+			wantErr: false,
+		},
+		{
+			name:    "negative testing (offset is negative)",
+			args:    args{offset: -1, page: 0, number: 10, output: &output},
+			want:    0,
+			wantOut: color.RedString("🚨 The offset flag must not be negative..."),
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (page is negative)",
+			args:    args{offset: 0, page: -1, number: 10, output: &output},
+			want:    0,
+			wantOut: color.RedString("🚨 The page flag must be a positive number..."),
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (both are specified)",
+			args:    args{offset: 5, page: 2, number: 10, output: &output},
+			want:    0,
+			wantOut: color.RedString("🚨 The offset flag and the page flag can not be used together..."),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output = ""
+			got, err := pageOffset(tt.args.offset, tt.args.page, tt.args.number, tt.args.output)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("pageOffset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("pageOffset() = %v, want %v", got, tt.want)
+			}
+			if output != tt.wantOut {
+				t.Errorf("pageOffset() : output = %v, want %v", output, tt.wantOut)
+			}
+		})
+	}
+}
+
+func Test_newHistoryPage(t *testing.T) {
+	type args struct {
+		histories interface{}
+		offset    int
+		number    int
+		total     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *formatter.HistoryPage
+	}{
+		{
+			name: "positive testing (the first page)",
+			args: args{histories: "test", offset: 0, number: 10, total: 25},
+			want: &formatter.HistoryPage{Histories: "test", Page: 1, Pages: 3},
+		},
+		{
+			name: "positive testing (the last page)",
+			args: args{histories: "test", offset: 20, number: 10, total: 30},
+			want: &formatter.HistoryPage{Histories: "test", Page: 3, Pages: 3},
+		},
+		{
+			name: "positive testing (the offset is over the total)",
+			args: args{histories: "test", offset: 50, number: 10, total: 30},
+			want: &formatter.HistoryPage{Histories: "test", Page: 6, Pages: 6},
+		},
+		{
+			name: "positive testing (number is 0)",
+			args: args{histories: "test", offset: 0, number: 0, total: 30},
+			want: &formatter.HistoryPage{Histories: "test", Page: 1, Pages: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newHistoryPage(tt.args.histories, tt.args.offset, tt.args.number, tt.args.total); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newHistoryPage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
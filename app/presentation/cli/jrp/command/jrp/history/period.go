@@ -0,0 +1,81 @@
+package history
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+)
+
+var (
+	// durationPattern is a pattern of the duration like "30m", "12h", "7d" or "2w".
+	durationPattern = regexp.MustCompile(`^(\d+)([mhdw])$`)
+	// durationUnits is the units of the duration pattern.
+	durationUnits = map[string]time.Duration{
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+	// periodLayouts is the layouts of the since and until flags and the functions returning the time just after the precision of them.
+	periodLayouts = []struct {
+		layout string
+		next   func(t time.Time) time.Time
+	}{
+		{layout: "2006-01-02", next: func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{layout: "2006-01-02 15:04", next: func(t time.Time) time.Time { return t.Add(time.Minute) }},
+		{layout: "2006-01-02 15:04:05", next: func(t time.Time) time.Time { return t.Add(time.Second) }},
+		{layout: time.RFC3339, next: func(t time.Time) time.Time { return t }},
+	}
+)
+
+// parsePeriod parses the since and until flags into the times.
+// the zero time is returned for the empty flag.
+func parsePeriod(since string, until string, now time.Time, output *string) (time.Time, time.Time, error) {
+	s, err := parsePeriodTime(since, false, now)
+	if err != nil {
+		o := formatter.Red("🚨 The since flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)...")
+		*output = o
+		return time.Time{}, time.Time{}, err
+	}
+	u, err := parsePeriodTime(until, true, now)
+	if err != nil {
+		o := formatter.Red("🚨 The until flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)...")
+		*output = o
+		return time.Time{}, time.Time{}, err
+	}
+
+	return s, u, nil
+}
+
+// parsePeriodTime parses the value of the since or until flag into the time.
+// the value of the until flag includes the whole of the date or the minute or the second it specifies,
+// so the time just after it is returned as the exclusive upper bound.
+func parsePeriodTime(value string, until bool, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if m := durationPattern.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(-time.Duration(n) * durationUnits[m[2]]), nil
+	}
+
+	for _, l := range periodLayouts {
+		t, err := time.ParseInLocation(l.layout, value, time.Local)
+		if err != nil {
+			continue
+		}
+		if until {
+			t = l.next(t)
+		}
+		return t, nil
+	}
+
+	return time.Time{}, errors.New("invalid time : " + value)
+}
@@ -0,0 +1,194 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+func Test_parsePeriod(t *testing.T) {
+	var output string
+	base := time.Date(2026, 1, 10, 12, 0, 0, 0, time.Local)
+
+	type args struct {
+		since  string
+		until  string
+		now    time.Time
+		output *string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantSince time.Time
+		wantUntil time.Time
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "positive testing (both are empty)",
+			args: args{
+				since:  "",
+				until:  "",
+				now:    base,
+				output: &output,
+			},
+			wantSince: time.Time{},
+			wantUntil: time.Time{},
+			want:      "",
+			wantErr:   false,
+		},
+		{
+			name: "positive testing (since is a duration, until is a date)",
+			args: args{
+				since:  "7d",
+				until:  "2026-01-10",
+				now:    base,
+				output: &output,
+			},
+			wantSince: time.Date(2026, 1, 3, 12, 0, 0, 0, time.Local),
+			wantUntil: time.Date(2026, 1, 11, 0, 0, 0, 0, time.Local),
+			want:      "",
+			wantErr:   false,
+		},
+		{
+			name: "negative testing (parsePeriodTime(since, false, now) failed)",
+			args: args{
+				since:  "test",
+				until:  "",
+				now:    base,
+				output: &output,
+			},
+			wantSince: time.Time{},
+			wantUntil: time.Time{},
+			want:      color.RedString("🚨 The since flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)..."),
+			wantErr:   true,
+		},
+		{
+			name: "negative testing (parsePeriodTime(until, true, now) failed)",
+			args: args{
+				since:  "",
+				until:  "test",
+				now:    base,
+				output: &output,
+			},
+			wantSince: time.Time{},
+			wantUntil: time.Time{},
+			want:      color.RedString("🚨 The until flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)..."),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output = ""
+			gotSince, gotUntil, err := parsePeriod(tt.args.since, tt.args.until, tt.args.now, tt.args.output)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !gotSince.Equal(tt.wantSince) {
+				t.Errorf("parsePeriod() since = %v, want %v", gotSince, tt.wantSince)
+			}
+			if !gotUntil.Equal(tt.wantUntil) {
+				t.Errorf("parsePeriod() until = %v, want %v", gotUntil, tt.wantUntil)
+			}
+			if output != tt.want {
+				t.Errorf("parsePeriod() = %v, want %v", output, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parsePeriodTime(t *testing.T) {
+	base := time.Date(2026, 1, 10, 12, 0, 0, 0, time.Local)
+
+	type args struct {
+		value string
+		until bool
+		now   time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (empty)",
+			args:    args{value: "", until: false, now: base},
+			want:    time.Time{},
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (minutes)",
+			args:    args{value: "30m", until: false, now: base},
+			want:    time.Date(2026, 1, 10, 11, 30, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (hours)",
+			args:    args{value: "12h", until: true, now: base},
+			want:    time.Date(2026, 1, 10, 0, 0, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (weeks)",
+			args:    args{value: "1w", until: false, now: base},
+			want:    time.Date(2026, 1, 3, 12, 0, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (date, since)",
+			args:    args{value: "2026-01-02", until: false, now: base},
+			want:    time.Date(2026, 1, 2, 0, 0, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (date, until)",
+			args:    args{value: "2026-01-02", until: true, now: base},
+			want:    time.Date(2026, 1, 3, 0, 0, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (date and minute, until)",
+			args:    args{value: "2026-01-02 15:04", until: true, now: base},
+			want:    time.Date(2026, 1, 2, 15, 5, 0, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (date and second, until)",
+			args:    args{value: "2026-01-02 15:04:05", until: true, now: base},
+			want:    time.Date(2026, 1, 2, 15, 4, 6, 0, time.Local),
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (RFC3339, until)",
+			args:    args{value: "2026-01-02T15:04:05+09:00", until: true, now: base},
+			want:    time.Date(2026, 1, 2, 6, 4, 5, 0, time.UTC),
+			wantErr: false,
+		},
+		{
+			name:    "negative testing (invalid unit)",
+			args:    args{value: "7y", until: false, now: base},
+			want:    time.Time{},
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (invalid date)",
+			args:    args{value: "2026/01/02", until: false, now: base},
+			want:    time.Time{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePeriodTime(tt.args.value, tt.args.until, tt.args.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePeriodTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parsePeriodTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
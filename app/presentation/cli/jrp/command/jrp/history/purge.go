@@ -0,0 +1,153 @@
+package history
+
+import (
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// PurgeOptions provides the options for the purge command.
+type PurgeOptions struct {
+	// All is a flag to purge all the histories in the trash.
+	All bool
+	// NoConfirm is a flag to not confirm before purging all the histories in the trash.
+	NoConfirm bool
+}
+
+var (
+	// purgeOps is a variable to store the purge options with the default values for injecting the dependencies in testing.
+	purgeOps = PurgeOptions{
+		All:       false,
+		NoConfirm: false,
+	}
+)
+
+// NewPurgeCommand returns a new instance of the purge command.
+func NewPurgeCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("purge")
+	cmd.SetAliases([]string{"pu", "p"})
+	cmd.SetUsageTemplate(purgeUsageTemplate)
+	cmd.SetHelpTemplate(purgeHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&purgeOps.All,
+		"all",
+		"a",
+		false,
+		"✨ purge all the histories in the trash",
+	)
+	cmd.Flags().BoolVarP(
+		&purgeOps.NoConfirm,
+		"no-confirm",
+		"",
+		false,
+		"🚫 do not confirm before purging all the histories in the trash",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runPurge(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runPurge runs the purge command.
+func runPurge(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) == 0 && !purgeOps.All {
+		o := formatter.Yellow("⚡ No ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	var ids []int
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			o := formatter.Red("🚨 The ID argument must be an integer...")
+			*output = o
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	phuc := jrpApp.NewPurgeHistoryUseCase(historyRepo)
+
+	if purgeOps.All && !purgeOps.NoConfirm {
+		if answer, err := presenter.RunPrompt(
+			"Proceed with purging all the histories in the trash? [y/N]",
+		); err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled purging the histories in the trash.")
+			*output = o
+			return nil
+		}
+	}
+
+	if err := phuc.Run(
+		cmd.Context(),
+		ids,
+		purgeOps.All,
+	); err != nil && err.Error() == "no histories to purge" {
+		o := formatter.Yellow("⚡ No histories to purge...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Purged successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// purgeHelpTemplate is the help template of the purge command.
+	purgeHelpTemplate = `📜🔥 Purge the histories of the "generate" command in the trash.
+
+The histories purged are removed permanently with their tags and notes, and can not be restored any more.
+You can specify the histories to purge with ID arguments.
+You have to get ID from the "history --trashed" command.
+Multiple ID's can be specified separated by spaces.
+
+You can purge all the histories in the trash by flag "-a" or "--all".
+
+` + purgeUsageTemplate
+	// purgeUsageTemplate is the usage template of the purge command.
+	purgeUsageTemplate = `Usage:
+  jrp history purge [flag] [arguments]
+  jrp history pu    [flag] [arguments]
+  jrp history p     [flag] [arguments]
+
+Flags:
+  -a, --all    ✨ purge all the histories in the trash
+  -no-confirm  🚫 do not confirm before purging all the histories in the trash
+  -h, --help   🤝 help for purge
+
+Arguments:
+  ID  🆔 purge the history by the ID (e.g: 1 2 3)
+`
+)
@@ -0,0 +1,519 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewPurgeCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewPurgeCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewPurgeCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run purge command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runPurge(t *testing.T) {
+	var output string
+	origPurgeOps := purgeOps
+	origPu := presenter.Pu
+
+	type args struct {
+		cmd    *c.Command
+		args   []string
+		output *string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		testData []*historyDomain.History
+		trashIds []int
+		want     string
+		wantErr  bool
+		setup    func(mockCtrl *gomock.Controller, tt *args)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (not all, not no-confirm)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			trashIds: []int{1},
+			want:     color.GreenString("✅ Purged successfully!"),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				purgeOps.All = false
+				purgeOps.NoConfirm = false
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				purgeOps = origPurgeOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (all, no-confirm)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			trashIds: []int{1},
+			want:     color.GreenString("✅ Purged successfully!"),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				purgeOps.All = true
+				purgeOps.NoConfirm = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				purgeOps = origPurgeOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (all, not no-confirm, answer is y)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			trashIds: []int{1},
+			want:     color.GreenString("✅ Purged successfully!"),
+			wantErr:  false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				purgeOps.All = true
+				purgeOps.NoConfirm = false
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptUtil := utility.NewMockPromptUtil(mockCtrl)
+				mockPromptUtil.EXPECT().GetPrompt("Proceed with purging all the histories in the trash? [y/N]").Return(mockPrompt)
+				presenter.Pu = mockPromptUtil
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				purgeOps = origPurgeOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (all, not no-confirm, answer is not y)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 0,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			trashIds: []int{1},
+			want:     color.YellowString("🚫 Cancelled purging the histories in the trash."),
+			wantErr:  false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				purgeOps.All = true
+				purgeOps.NoConfirm = false
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPromptUtil := utility.NewMockPromptUtil(mockCtrl)
+				mockPromptUtil.EXPECT().GetPrompt("Proceed with purging all the histories in the trash? [y/N]").Return(mockPrompt)
+				presenter.Pu = mockPromptUtil
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				purgeOps = origPurgeOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no args)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			trashIds: nil,
+			want:     color.YellowString("⚡ No ID arguments specified..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no histories to purge)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			trashIds: nil,
+			want:     color.YellowString("⚡ No histories to purge..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				purgeOps.All = false
+				purgeOps.NoConfirm = false
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				purgeOps = origPurgeOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (strconv.Atoi(arg) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			trashIds: nil,
+			want:     color.RedString("🚨 The ID argument must be an integer..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.RunPrompt() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			trashIds: nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(mockCtrl *gomock.Controller, _ *args) {
+				purgeOps.All = true
+				purgeOps.NoConfirm = false
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().Run().Return("", errors.New("PromptProxy.Run() failed"))
+				mockPromptUtil := utility.NewMockPromptUtil(mockCtrl)
+				mockPromptUtil.EXPECT().GetPrompt("Proceed with purging all the histories in the trash? [y/N]").Return(mockPrompt)
+				presenter.Pu = mockPromptUtil
+				output = ""
+			},
+			cleanup: func() {
+				purgeOps = origPurgeOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (phuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			trashIds: nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := repository.NewHistoryRepository()
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(context.Background(), tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.DeleteByIdIn(context.Background(), tt.trashIds); err != nil {
+					t.Errorf("Failed to move test data to the trash: %v", err)
+				}
+			}
+			if err := runPurge(tt.args.cmd, tt.args.args, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runPurge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runPurge() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
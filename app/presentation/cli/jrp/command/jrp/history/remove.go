@@ -148,6 +148,9 @@ This is the same as the "history clear" command.
 
 Also, you can remove the histories even if it is favorited by using the "-f" or ""--force" flag.
 
+The histories removed are moved to the trash.
+You can restore them by the "history restore" command, or remove them permanently by the "history purge" command.
+
 ` + removeUsageTemplate
 	// removeUsageTemplate is the usage template of the remove command.
 	removeUsageTemplate = `Usage:
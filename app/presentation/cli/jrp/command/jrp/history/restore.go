@@ -0,0 +1,129 @@
+package history
+
+import (
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// RestoreOptions provides the options for the restore command.
+type RestoreOptions struct {
+	// All is a flag to restore all the histories in the trash.
+	All bool
+}
+
+var (
+	// restoreOps is a variable to store the restore options with the default values for injecting the dependencies in testing.
+	restoreOps = RestoreOptions{
+		All: false,
+	}
+)
+
+// NewRestoreCommand returns a new instance of the restore command.
+func NewRestoreCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("restore")
+	cmd.SetAliases([]string{"rs", "R"})
+	cmd.SetUsageTemplate(restoreUsageTemplate)
+	cmd.SetHelpTemplate(restoreHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&restoreOps.All,
+		"all",
+		"a",
+		false,
+		"✨ restore all the histories in the trash",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runRestore(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runRestore runs the restore command.
+func runRestore(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) == 0 && !restoreOps.All {
+		o := formatter.Yellow("⚡ No ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	var ids []int
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			o := formatter.Red("🚨 The ID argument must be an integer...")
+			*output = o
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	rhuc := jrpApp.NewRestoreHistoryUseCase(historyRepo)
+
+	if err := rhuc.Run(
+		cmd.Context(),
+		ids,
+		restoreOps.All,
+	); err != nil && err.Error() == "no histories to restore" {
+		o := formatter.Yellow("⚡ No histories to restore...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Restored successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// restoreHelpTemplate is the help template of the restore command.
+	restoreHelpTemplate = `📜♻️ Restore the histories of the "generate" command from the trash.
+
+The histories removed by the "history remove" or the "history clear" command are moved to the trash.
+You can specify the histories to restore with ID arguments.
+You have to get ID from the "history --trashed" command.
+Multiple ID's can be specified separated by spaces.
+
+You can restore all the histories in the trash by flag "-a" or "--all".
+
+` + restoreUsageTemplate
+	// restoreUsageTemplate is the usage template of the restore command.
+	restoreUsageTemplate = `Usage:
+  jrp history restore [flag] [arguments]
+  jrp history rs      [flag] [arguments]
+  jrp history R       [flag] [arguments]
+
+Flags:
+  -a, --all   ✨ restore all the histories in the trash
+  -h, --help  🤝 help for restore
+
+Arguments:
+  ID  🆔 restore the history by the ID (e.g: 1 2 3)
+`
+)
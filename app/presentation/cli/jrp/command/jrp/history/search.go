@@ -1,6 +1,8 @@
 package history
 
 import (
+	"time"
+
 	c "github.com/spf13/cobra"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
@@ -16,22 +18,55 @@ type SearchOptions struct {
 	Number int
 	// And is a flag to search histories by AND condition.
 	And bool
+	// Regex is a flag to search histories by the keywords as the regular expressions.
+	Regex bool
+	// Fuzzy is a flag to search histories similar to the keywords ranked by the similarity score.
+	Fuzzy bool
 	// All is a flag to search all histories.
 	All bool
 	// Favorited is a flag to show only favorited histories.
 	Favorited bool
+	// Tag is a flag to show only histories tagged with the tag.
+	Tag string
+	// Since is a flag to show only histories created since the time.
+	Since string
+	// Until is a flag to show only histories created until the time.
+	Until string
+	// Sort is a flag to specify the key to sort the histories by.
+	Sort string
+	// Desc is a flag to sort the histories in descending order.
+	Desc bool
+	// Offset is a flag to specify the number of the most recent histories to skip.
+	Offset int
+	// Page is a flag to specify the page of the histories to show.
+	Page int
 	// Format is a flag to specify the format of the output.
 	Format string
+	// OutputTemplate is a flag to specify the template of the output for the template format.
+	OutputTemplate string
+	// OutputTemplateFile is a flag to specify the file of the template of the output for the template format.
+	OutputTemplateFile string
 }
 
 var (
 	// searchOps is a variable to store the search options with the default values for injecting the dependencies in testing.
 	searchOps = SearchOptions{
-		Number:    1,
-		And:       false,
-		All:       false,
-		Favorited: false,
-		Format:    "table",
+		Number:             1,
+		And:                false,
+		Regex:              false,
+		Fuzzy:              false,
+		All:                false,
+		Favorited:          false,
+		Tag:                "",
+		Since:              "",
+		Until:              "",
+		Sort:               "id",
+		Desc:               false,
+		Offset:             0,
+		Page:               0,
+		Format:             "table",
+		OutputTemplate:     "",
+		OutputTemplateFile: "",
 	}
 )
 
@@ -60,6 +95,20 @@ func NewSearchCommand(
 		false,
 		"🧠 search histories by AND condition",
 	)
+	cmd.Flags().BoolVarP(
+		&searchOps.Regex,
+		"regex",
+		"",
+		false,
+		"🧩 search histories by the keywords as the regular expressions",
+	)
+	cmd.Flags().BoolVarP(
+		&searchOps.Fuzzy,
+		"fuzzy",
+		"",
+		false,
+		"🌫️ search histories similar to the keywords ranked by the similarity score",
+	)
 	cmd.Flags().BoolVarP(
 		&searchOps.All,
 		"all",
@@ -74,6 +123,55 @@ func NewSearchCommand(
 		false,
 		"🌟 show only favorited histories",
 	)
+	cmd.Flags().StringVarP(
+		&searchOps.Tag,
+		"tag",
+		"t",
+		"",
+		"🏷️ show only histories tagged with the tag",
+	)
+	cmd.Flags().StringVarP(
+		&searchOps.Since,
+		"since",
+		"",
+		"",
+		"📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&searchOps.Until,
+		"until",
+		"",
+		"",
+		"📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&searchOps.Sort,
+		"sort",
+		"",
+		"id",
+		"🔀 key to sort the histories by (default \"id\", e.g. : \"created\", \"phrase\")",
+	)
+	cmd.Flags().BoolVarP(
+		&searchOps.Desc,
+		"desc",
+		"",
+		false,
+		"🔽 sort the histories in descending order",
+	)
+	cmd.Flags().IntVarP(
+		&searchOps.Offset,
+		"offset",
+		"",
+		0,
+		"⏭️ number of the most recent histories to skip (e.g. : 50)",
+	)
+	cmd.Flags().IntVarP(
+		&searchOps.Page,
+		"page",
+		"p",
+		0,
+		"📄 page of the histories to show, each page has the number of histories (e.g. : 2)",
+	)
 	cmd.Flags().StringVarP(
 		&searchOps.Format,
 		"format",
@@ -81,6 +179,20 @@ func NewSearchCommand(
 		"table",
 		"📝 format of the output (default \"table\", e.g: \"plain\")",
 	)
+	cmd.Flags().StringVarP(
+		&searchOps.OutputTemplate,
+		"output-template",
+		"",
+		"",
+		"🖨️ Go template of the output for the template format",
+	)
+	cmd.Flags().StringVarP(
+		&searchOps.OutputTemplateFile,
+		"output-template-file",
+		"",
+		"",
+		"🖨️ file of the template of the output for the template format",
+	)
 
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
@@ -107,18 +219,51 @@ func runSearch(
 		return nil
 	}
 
+	since, until, err := parsePeriod(searchOps.Since, searchOps.Until, time.Now(), output)
+	if err != nil {
+		return err
+	}
+	offset, err := pageOffset(searchOps.Offset, searchOps.Page, searchOps.Number, output)
+	if err != nil {
+		return err
+	}
+
 	historyRepo := repository.NewHistoryRepository()
 	shuc := jrpApp.NewSearchHistoryUseCase(historyRepo)
 
-	shoDtos, err := shuc.Run(
-		cmd.Context(),
-		args,
-		searchOps.And,
-		searchOps.All,
-		searchOps.Favorited,
-		searchOps.Number,
-	)
-	if err != nil {
+	shiDto := &jrpApp.SearchHistoryUseCaseInputDto{
+		Keywords:  args,
+		And:       searchOps.And,
+		Regex:     searchOps.Regex,
+		Fuzzy:     searchOps.Fuzzy,
+		All:       searchOps.All,
+		Favorited: searchOps.Favorited,
+		Number:    searchOps.Number,
+		Tag:       searchOps.Tag,
+		Since:     since,
+		Until:     until,
+		Sort:      searchOps.Sort,
+		Desc:      searchOps.Desc,
+		Offset:    offset,
+	}
+	shoDtos, err := shuc.Run(cmd.Context(), shiDto)
+	if err != nil && err.Error() == "invalid sort key" {
+		o := formatter.Red("🚨 The sort flag must be \"id\", \"created\" or \"phrase\"...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "since is not before until" {
+		o := formatter.Red("🚨 The since flag must be before the until flag...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "regex and fuzzy are both specified" {
+		o := formatter.Red("🚨 The regex flag and the fuzzy flag can not be used together...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "invalid regular expression" {
+		o := formatter.Red("🚨 The keywords must be valid regular expressions with the regex flag...")
+		*output = o
+		return err
+	} else if err != nil {
 		return err
 	}
 
@@ -128,13 +273,26 @@ func runSearch(
 		return nil
 	}
 
-	f, err := formatter.NewFormatter(searchOps.Format)
+	var f formatter.Formatter
+	if searchOps.Format == "template" {
+		f, err = formatter.NewTemplateFormatter(formatter.Fu, searchOps.OutputTemplate, searchOps.OutputTemplateFile)
+	} else {
+		f, err = formatter.NewFormatter(searchOps.Format)
+	}
 	if err != nil {
 		o := formatter.Red("❌ Failed to create a formatter...")
 		*output = o
 		return err
 	}
-	o, err := f.Format(shoDtos)
+	var result interface{} = shoDtos
+	if !searchOps.All && (searchOps.Offset > 0 || searchOps.Page > 0) {
+		total, err := shuc.RunCount(cmd.Context(), shiDto)
+		if err != nil {
+			return err
+		}
+		result = newHistoryPage(shoDtos, offset, searchOps.Number, total)
+	}
+	o, err := f.Format(result)
 	if err != nil {
 		return err
 	}
@@ -153,12 +311,38 @@ Multiple keywords are separated by a space.
 If you want to search histories by AND condition, you can use flag "-A" or "--and".
 OR condition is by default.
 
+You can search histories by the keywords as the regular expressions by flag "--regex".
+Also, you can search histories similar to the keywords by flag "--fuzzy" to find the phrases you half-remember.
+The fuzzy search compares the keywords with the phrases, the readings and the romaji,
+and ranks the histories by the similarity score from 0 to 1 instead of the flags "--sort" and "--desc".
+The number flag takes the histories of the highest scores, and the score is shown in the output.
+
 You can specify how many histories to show with flag "-n" or "--number".
 If you don't specify the number of histories, jrp will show the most recent 10 histories by default.
 
 Also, you can show all histories by flag "-a" or "--all".
 If you use the flag, the number flag will be ignored.
 
+You can show only the histories tagged with a tag by flag "-t" or "--tag".
+Tag the histories with "jrp tag add".
+
+You can show only the histories created in a period by flags "--since" and "--until".
+They accept a date (e.g. : 2026-01-02), a date and time (e.g. : "2026-01-02 15:04") or a duration ago (e.g. : 30m, 12h, 7d, 2w).
+The date or the time of the flag "--until" is included.
+
+You can page through the histories by flag "--offset" to skip the most recent histories, or by flag "-p" or "--page".
+A page has as many histories as the number flag, and the table format shows the page and the number of the pages.
+
+You can sort the histories by flag "--sort" with "id", "created" or "phrase", and in descending order by flag "--desc".
+With "created" or "phrase", the number flag and the pages still take the most recent histories, and sort them.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table", "plain", "json", "ndjson", "csv", "tsv" and "template" are available.
+With the format "template", you can shape the output by the Go template
+specified by the flag "--output-template" or the file specified by the flag "--output-template-file".
+The fields ".ID", ".Phrase", ".Reading", ".Romaji", ".WordIDs", ".Prefix", ".Suffix", ".IsFavorited", ".CreatedAt", ".UpdatedAt" and ".Score",
+and the functions "blue", "green", "red", "yellow", "formatTime" and "localTime" are available.
+
 ` + searchUsageTemplate
 	// searchUsageTemplate is the usage template of the search command.
 	searchUsageTemplate = `Usage:
@@ -167,12 +351,23 @@ If you use the flag, the number flag will be ignored.
   jrp history S      [flag] [arguments]
 
 Flags:
-  -A, --and        🧠 search histories by AND condition
-  -n, --number     🔢 number how many histories to show (default 10, e.g: 50)
-  -a, --all        📁 show all histories
-  -F, --favorited  🌟 show only favorited histories
-  -f, --format     📝 format of the output (default "table", e.g: "plain")
-  -h, --help       🤝 help for search
+  -A, --and                   🧠 search histories by AND condition
+      --regex                 🧩 search histories by the keywords as the regular expressions
+      --fuzzy                 🌫️ search histories similar to the keywords ranked by the similarity score
+  -n, --number                🔢 number how many histories to show (default 10, e.g: 50)
+  -a, --all                   📁 show all histories
+  -F, --favorited             🌟 show only favorited histories
+  -t, --tag                   🏷️ show only histories tagged with the tag
+      --since                 📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --until                 📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --sort                  🔀 key to sort the histories by (default "id", e.g. : "created", "phrase")
+      --desc                  🔽 sort the histories in descending order
+      --offset                ⏭️ number of the most recent histories to skip (e.g. : 50)
+  -p, --page                  📄 page of the histories to show, each page has the number of histories (e.g. : 2)
+  -f, --format                📝 format of the output (default "table", e.g: "plain")
+      --output-template       🖨️ Go template of the output for the template format
+      --output-template-file  🖨️ file of the template of the output for the template format
+  -h, --help                  🤝 help for search
 
 Arguments:
   keywords  🔡 search histories by keywords (multiple keywords are separated by space)
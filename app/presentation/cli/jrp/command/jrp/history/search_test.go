@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/fatih/color"
 	c "github.com/spf13/cobra"
@@ -129,7 +130,7 @@ func Test_runSearch(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
@@ -351,6 +352,576 @@ func Test_runSearch(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (format is template)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want:    "1:test",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Format = "template"
+				searchOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewTemplateFormatter(formatter.Fu, searchOps.OutputTemplate, searchOps.OutputTemplateFile) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want:    color.RedString("❌ Failed to create a formatter..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Format = "template"
+				searchOps.OutputTemplate = ""
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (tag is specified)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "1:test\n3:test3",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Tag = "tag"
+				searchOps.Format = "template"
+				searchOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				searchOps.Number = 10
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "test",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test2",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test3",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.SaveTagByIdIn(context.Background(), "tag", []int{1, 3}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (since, until, sort by phrase desc)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "2:test-c\n3:test-a",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Since = "2026-01-01 12:00"
+				searchOps.Until = "2026-01-03"
+				searchOps.Sort = "phrase"
+				searchOps.Desc = true
+				searchOps.Format = "template"
+				searchOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "test-b",
+						CreatedAt: time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-c",
+						CreatedAt: time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-a",
+						CreatedAt: time.Date(2026, 1, 3, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-d",
+						CreatedAt: time.Date(2026, 1, 4, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (parsePeriod(searchOps.Since, searchOps.Until, time.Now(), output) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The until flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				searchOps.Until = "test"
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (shuc.Run() failed, invalid sort key)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The sort flag must be \"id\", \"created\" or \"phrase\"..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Sort = "test"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (shuc.Run() failed, since is not before until)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The since flag must be before the until flag..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Since = "2026-01-02"
+				searchOps.Until = "2026-01-01"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (page is specified)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test1",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test4",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test5",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test6",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT3test3" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4test4" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:2jrps!PAGE:2of3",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 2
+				searchOps.Page = 2
+				searchOps.Format = "table"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (pageOffset(searchOps.Offset, searchOps.Page, number, output) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The offset flag and the page flag can not be used together..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				searchOps.Offset = 1
+				searchOps.Page = 1
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (regex, sort by phrase)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"^静"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "3:静かな山\n1:静かな猫",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Regex = true
+				searchOps.Sort = "phrase"
+				searchOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				searchOps.Format = "template"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "静かな猫",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "遊ぶ犬",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "静かな山",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (fuzzy)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"静かな山の"},
+				output: &output,
+			},
+			testData: nil,
+			want:     "3:静かな山:0.80\n1:静かな猫:0.60",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Fuzzy = true
+				searchOps.OutputTemplate = "{{.ID}}:{{.Phrase}}:{{printf \"%.2f\" .Score}}"
+				searchOps.Format = "template"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "静かな猫",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "遊ぶ犬",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "静かな山",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (shuc.Run() failed, regex and fuzzy are both specified)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"test"},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The regex flag and the fuzzy flag can not be used together..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Regex = true
+				searchOps.Fuzzy = true
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (shuc.Run() failed, invalid regular expression)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{"("},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The keywords must be valid regular expressions with the regex flag..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				searchOps.Number = 10
+				searchOps.Regex = true
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
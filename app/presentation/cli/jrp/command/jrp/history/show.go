@@ -2,6 +2,7 @@ package history
 
 import (
 	"strconv"
+	"time"
 
 	c "github.com/spf13/cobra"
 
@@ -20,17 +21,47 @@ type ShowOptions struct {
 	All bool
 	// Favorited is a flag to show only favorited histories.
 	Favorited bool
+	// Tag is a flag to show only histories tagged with the tag.
+	Tag string
+	// Since is a flag to show only histories created since the time.
+	Since string
+	// Until is a flag to show only histories created until the time.
+	Until string
+	// Sort is a flag to specify the key to sort the histories by.
+	Sort string
+	// Desc is a flag to sort the histories in descending order.
+	Desc bool
+	// Offset is a flag to specify the number of the most recent histories to skip.
+	Offset int
+	// Page is a flag to specify the page of the histories to show.
+	Page int
+	// Trashed is a flag to show the histories in the trash instead of the others.
+	Trashed bool
 	// Format is a flag to specify the format of the output.
 	Format string
+	// OutputTemplate is a flag to specify the template of the output for the template format.
+	OutputTemplate string
+	// OutputTemplateFile is a flag to specify the file of the template of the output for the template format.
+	OutputTemplateFile string
 }
 
 var (
 	// showOps is a variable to store the show options with the default values for injecting the dependencies in testing.
 	showOps = ShowOptions{
-		Number:    1,
-		All:       false,
-		Favorited: false,
-		Format:    "table",
+		Number:             1,
+		All:                false,
+		Favorited:          false,
+		Tag:                "",
+		Since:              "",
+		Until:              "",
+		Sort:               "id",
+		Desc:               false,
+		Offset:             0,
+		Page:               0,
+		Trashed:            false,
+		Format:             "table",
+		OutputTemplate:     "",
+		OutputTemplateFile: "",
 	}
 )
 
@@ -67,6 +98,62 @@ func NewShowCommand(
 		false,
 		"🌟 show only favorited histories",
 	)
+	cmd.Flags().StringVarP(
+		&showOps.Tag,
+		"tag",
+		"t",
+		"",
+		"🏷️ show only histories tagged with the tag",
+	)
+	cmd.Flags().StringVarP(
+		&showOps.Since,
+		"since",
+		"",
+		"",
+		"📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&showOps.Until,
+		"until",
+		"",
+		"",
+		"📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)",
+	)
+	cmd.Flags().StringVarP(
+		&showOps.Sort,
+		"sort",
+		"",
+		"id",
+		"🔀 key to sort the histories by (default \"id\", e.g. : \"created\", \"phrase\")",
+	)
+	cmd.Flags().BoolVarP(
+		&showOps.Desc,
+		"desc",
+		"",
+		false,
+		"🔽 sort the histories in descending order",
+	)
+	cmd.Flags().IntVarP(
+		&showOps.Offset,
+		"offset",
+		"",
+		0,
+		"⏭️ number of the most recent histories to skip (e.g. : 50)",
+	)
+	cmd.Flags().IntVarP(
+		&showOps.Page,
+		"page",
+		"p",
+		0,
+		"📄 page of the histories to show, each page has the number of histories (e.g. : 2)",
+	)
+	cmd.Flags().BoolVarP(
+		&showOps.Trashed,
+		"trashed",
+		"",
+		false,
+		"🗑️ show the histories in the trash instead",
+	)
 	cmd.Flags().StringVarP(
 		&showOps.Format,
 		"format",
@@ -74,6 +161,20 @@ func NewShowCommand(
 		"table",
 		"📝 format of the output (default \"table\", e.g. : \"plain\")",
 	)
+	cmd.Flags().StringVarP(
+		&showOps.OutputTemplate,
+		"output-template",
+		"",
+		"",
+		"🖨️ Go template of the output for the template format",
+	)
+	cmd.Flags().StringVarP(
+		&showOps.OutputTemplateFile,
+		"output-template-file",
+		"",
+		"",
+		"🖨️ file of the template of the output for the template format",
+	)
 
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
@@ -113,16 +214,40 @@ func runShow(
 		}
 	}
 
+	since, until, err := parsePeriod(showOps.Since, showOps.Until, time.Now(), output)
+	if err != nil {
+		return err
+	}
+	offset, err := pageOffset(showOps.Offset, showOps.Page, number, output)
+	if err != nil {
+		return err
+	}
+
 	historyRepo := repository.NewHistoryRepository()
 	ghuc := jrpApp.NewGetHistoryUseCase(historyRepo)
 
-	ghoDtos, err := ghuc.Run(
-		cmd.Context(),
-		showOps.All,
-		showOps.Favorited,
-		number,
-	)
-	if err != nil {
+	ghiDto := &jrpApp.GetHistoryUseCaseInputDto{
+		All:       showOps.All,
+		Favorited: showOps.Favorited,
+		Number:    number,
+		Tag:       showOps.Tag,
+		Since:     since,
+		Until:     until,
+		Sort:      showOps.Sort,
+		Desc:      showOps.Desc,
+		Offset:    offset,
+		Trashed:   showOps.Trashed,
+	}
+	ghoDtos, err := ghuc.Run(cmd.Context(), ghiDto)
+	if err != nil && err.Error() == "invalid sort key" {
+		o := formatter.Red("🚨 The sort flag must be \"id\", \"created\" or \"phrase\"...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "since is not before until" {
+		o := formatter.Red("🚨 The since flag must be before the until flag...")
+		*output = o
+		return err
+	} else if err != nil {
 		return err
 	}
 
@@ -132,13 +257,26 @@ func runShow(
 		return nil
 	}
 
-	f, err := formatter.NewFormatter(showOps.Format)
+	var f formatter.Formatter
+	if showOps.Format == "template" {
+		f, err = formatter.NewTemplateFormatter(formatter.Fu, showOps.OutputTemplate, showOps.OutputTemplateFile)
+	} else {
+		f, err = formatter.NewFormatter(showOps.Format)
+	}
 	if err != nil {
 		o := formatter.Red("❌ Failed to create a formatter...")
 		*output = o
 		return err
 	}
-	o, err := f.Format(ghoDtos)
+	var result interface{} = ghoDtos
+	if !showOps.All && (showOps.Offset > 0 || showOps.Page > 0) {
+		total, err := ghuc.RunCount(cmd.Context(), ghiDto)
+		if err != nil {
+			return err
+		}
+		result = newHistoryPage(ghoDtos, offset, number, total)
+	}
+	o, err := f.Format(result)
 	if err != nil {
 		return err
 	}
@@ -159,6 +297,29 @@ If both are provided, the larger number takes precedence.
 Also, you can show all the histories by flag "-a" or "--all".
 If you use the flag, the number flag or argument will be ignored.
 
+You can show only the histories tagged with a tag by flag "-t" or "--tag".
+Tag the histories with "jrp tag add".
+
+You can show only the histories created in a period by flags "--since" and "--until".
+They accept a date (e.g. : 2026-01-02), a date and time (e.g. : "2026-01-02 15:04") or a duration ago (e.g. : 30m, 12h, 7d, 2w).
+The date or the time of the flag "--until" is included.
+
+You can page through the histories by flag "--offset" to skip the most recent histories, or by flag "-p" or "--page".
+A page has as many histories as the number flag or argument, and the table format shows the page and the number of the pages.
+
+You can show the histories in the trash by flag "--trashed".
+Restore them with "jrp history restore", or remove them permanently with "jrp history purge".
+
+You can sort the histories by flag "--sort" with "id", "created" or "phrase", and in descending order by flag "--desc".
+With "created" or "phrase", the number flag or argument and the pages still take the most recent histories, and sort them.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table", "plain", "json", "ndjson", "csv", "tsv" and "template" are available.
+With the format "template", you can shape the output by the Go template
+specified by the flag "--output-template" or the file specified by the flag "--output-template-file".
+The fields ".ID", ".Phrase", ".Reading", ".Romaji", ".WordIDs", ".Prefix", ".Suffix", ".IsFavorited", ".CreatedAt" and ".UpdatedAt",
+and the functions "blue", "green", "red", "yellow", "formatTime" and "localTime" are available.
+
 ` + showUsageTemplate
 	// showUsageTemplate is the usage template of the show command.
 	showUsageTemplate = `Usage:
@@ -167,11 +328,21 @@ If you use the flag, the number flag or argument will be ignored.
   jrp history s    [flag] [argument]
 
 Flags:
-  -n, --number     🔢 number how many histories to show (default 10, e.g. : 50)
-  -a, --all        📁 show all the histories
-  -F, --favorited  🌟 show only favorited histories
-  -f, --format     📝 format of the output (default "table", e.g. : "plain")
-  -h, --help       🤝 help for show
+  -n, --number                🔢 number how many histories to show (default 10, e.g. : 50)
+  -a, --all                   📁 show all the histories
+  -F, --favorited             🌟 show only favorited histories
+  -t, --tag                   🏷️ show only histories tagged with the tag
+      --since                 📅 show only histories created since the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --until                 📅 show only histories created until the date, the time or the duration ago (e.g. : 2026-01-02, 7d)
+      --sort                  🔀 key to sort the histories by (default "id", e.g. : "created", "phrase")
+      --desc                  🔽 sort the histories in descending order
+      --offset                ⏭️ number of the most recent histories to skip (e.g. : 50)
+  -p, --page                  📄 page of the histories to show, each page has the number of histories (e.g. : 2)
+      --trashed               🗑️ show the histories in the trash instead
+  -f, --format                📝 format of the output (default "table", e.g. : "plain")
+      --output-template       🖨️ Go template of the output for the template format
+      --output-template-file  🖨️ file of the template of the output for the template format
+  -h, --help                  🤝 help for show
 
 Argument:
   number  🔢 number how many histories to show (default 10, e.g. : 50)
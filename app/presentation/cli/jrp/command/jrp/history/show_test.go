@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/fatih/color"
 	c "github.com/spf13/cobra"
@@ -129,7 +130,7 @@ func Test_runShow(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:1jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
@@ -351,7 +352,7 @@ func Test_runShow(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:9jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:9jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
@@ -538,7 +539,7 @@ func Test_runShow(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:10jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:10jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				cm := database.NewConnectionManager(proxy.NewSql())
@@ -755,7 +756,7 @@ func Test_runShow(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "11testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "12testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:12jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "11testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "12testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:12jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				showOps.Number = 12
@@ -974,7 +975,7 @@ func Test_runShow(t *testing.T) {
 					UpdatedAt:   now,
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "11testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "12testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:12jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "2testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "3testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "5testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "6testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "7testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "8testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "9testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "10testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "11testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "12testprefixsuffix○" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:12jrps!",
 			wantErr: false,
 			setup: func(_ *gomock.Controller, tt *args) {
 				showOps.Number = 11
@@ -1163,6 +1164,406 @@ func Test_runShow(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (format is template)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want:    "1:test",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Format = "template"
+				showOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewTemplateFormatter(formatter.Fu, showOps.OutputTemplate, showOps.OutputTemplateFile) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					ID:     1,
+					Phrase: "test",
+					Prefix: sql.NullString{
+						String: "prefix",
+						Valid:  true,
+					},
+					Suffix: sql.NullString{
+						String: "suffix",
+						Valid:  true,
+					},
+					IsFavorited: 1,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+			want:    color.RedString("❌ Failed to create a formatter..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Format = "template"
+				showOps.OutputTemplate = ""
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (tag is specified)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     "1:test\n3:test3",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Number = 10
+				showOps.Tag = "tag"
+				showOps.Format = "template"
+				showOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "test",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test2",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test3",
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.SaveTagByIdIn(context.Background(), "tag", []int{1, 3}); err != nil {
+					t.Errorf("Failed to save test tag: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (since, until, sort by phrase desc)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     "2:test-c\n3:test-a",
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Number = 10
+				showOps.Since = "2026-01-01 12:00"
+				showOps.Until = "2026-01-03"
+				showOps.Sort = "phrase"
+				showOps.Desc = true
+				showOps.Format = "template"
+				showOps.OutputTemplate = "{{.ID}}:{{.Phrase}}"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:    "test-b",
+						CreatedAt: time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-c",
+						CreatedAt: time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-a",
+						CreatedAt: time.Date(2026, 1, 3, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+					{
+						Phrase:    "test-d",
+						CreatedAt: time.Date(2026, 1, 4, 10, 0, 0, 0, time.Local),
+						UpdatedAt: now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (parsePeriod(showOps.Since, showOps.Until, time.Now(), output) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The until flag must be a date (e.g. : 2026-01-02), a date and time (e.g. : \"2026-01-02 15:04\") or a duration (e.g. : 7d)..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				showOps.Until = "test"
+				output = ""
+			},
+			cleanup: func() {
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ghuc.Run() failed, invalid sort key)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The sort flag must be \"id\", \"created\" or \"phrase\"..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Number = 10
+				showOps.Sort = "test"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (ghuc.Run() failed, since is not before until)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The since flag must be before the until flag..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Number = 10
+				showOps.Since = "2026-01-02"
+				showOps.Until = "2026-01-01"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (page is specified)",
+			args: args{
+				cmd:    &c.Command{},
+				args:   []string{},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test1",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test3",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test4",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test5",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test6",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT3test3" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "4test4" + now.Format("2006-01-02") + now.Format("15:04:05") + now.Format("2006-01-02") + now.Format("15:04:05") + "TOTAL:2jrps!PAGE:2of3",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				showOps.Number = 2
+				showOps.Page = 2
+				showOps.Format = "table"
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				showOps = origShowOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (pageOffset(showOps.Offset, showOps.Page, number, output) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.RedString("🚨 The offset flag and the page flag can not be used together..."),
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, _ *args) {
+				showOps.Offset = 1
+				showOps.Page = 1
+				output = ""
+			},
+			cleanup: func() {
+				showOps = origShowOps
+				output = ""
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,166 @@
+package jrp
+
+import (
+	"strconv"
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NoteOptions provides the options for the note command.
+type NoteOptions struct {
+	// Clear is a flag to remove the note.
+	Clear bool
+}
+
+var (
+	// noteOps is a variable to store the note options with the default values for injecting the dependencies in testing.
+	noteOps = NoteOptions{
+		Clear: false,
+	}
+)
+
+// NewNoteCommand returns a new instance of the note command.
+func NewNoteCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("note")
+	cmd.SetAliases([]string{"nt", "n"})
+	cmd.SetUsageTemplate(noteUsageTemplate)
+	cmd.SetHelpTemplate(noteHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&noteOps.Clear,
+		"clear",
+		"c",
+		false,
+		"🧹 remove the note of the history",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runNote(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runNote runs the note command.
+func runNote(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) == 0 {
+		o := formatter.Yellow("⚡ No ID argument specified...")
+		*output = o
+		return nil
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		o := formatter.Red("🚨 The ID argument must be an integer...")
+		*output = o
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+
+	if noteOps.Clear {
+		rnuc := jrpApp.NewRemoveNoteUseCase(historyRepo)
+		if err := rnuc.Run(
+			cmd.Context(),
+			id,
+		); err != nil && err.Error() == "no notes to remove" {
+			o := formatter.Yellow("⚡ No notes to remove...")
+			*output = o
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		o := formatter.Green("✅ Removed the note successfully!")
+		*output = o
+		return nil
+	}
+
+	if len(args) == 1 {
+		gnuc := jrpApp.NewGetNoteUseCase(historyRepo)
+		gnoDto, err := gnuc.Run(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		if gnoDto == nil {
+			o := formatter.Yellow("⚡ No note found...")
+			*output = o
+			return nil
+		}
+
+		*output = gnoDto.Note
+		return nil
+	}
+
+	snuc := jrpApp.NewSaveNoteUseCase(historyRepo)
+	if err := snuc.Run(
+		cmd.Context(),
+		id,
+		strings.Join(args[1:], " "),
+	); err != nil && err.Error() == "note is empty" {
+		o := formatter.Red("🚨 The note argument must not be empty...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "no histories to note" {
+		o := formatter.Yellow("⚡ No histories to note...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Noted successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// noteHelpTemplate is the help template of the note command.
+	noteHelpTemplate = `📝 Write a note on the history of the "generate" command.
+
+You can specify the history with the ID argument and the note with the following arguments.
+You have to get ID from the "history" command.
+The note arguments are joined with spaces, and the existing note of the history is replaced.
+
+If you do not specify the note, jrp will show the note of the history.
+
+Also, you can remove the note of the history with the "-c" or "--clear" flag.
+
+` + noteUsageTemplate
+	// noteUsageTemplate is the usage template of the note command.
+	noteUsageTemplate = `Usage:
+  jrp note [flag] [arguments]
+  jrp nt   [flag] [arguments]
+  jrp n    [flag] [arguments]
+
+Flags:
+  -c, --clear  🧹 remove the note of the history
+  -h, --help   🤝 help for note
+
+Arguments:
+  ID    🆔 ID of the history to write the note on (e.g. : 1)
+  note  📝 note of the history (e.g. : "a candidate for the next release")
+`
+)
@@ -0,0 +1,520 @@
+package jrp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewNoteCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNoteCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewNoteCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{}); err != nil {
+					t.Errorf("Failed to run the note command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runNote(t *testing.T) {
+	var output string
+	origNoteOps := noteOps
+
+	type args struct {
+		cmd    *c.Command
+		args   []string
+		output *string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		testData []*historyDomain.History
+		notes    []*historyDomain.Note
+		want     string
+		wantErr  bool
+		setup    func(tt *args)
+		cleanup  func()
+	}{
+		{
+			name: "positive testing (write the note)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1", "a", "note"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes:   nil,
+			want:    color.GreenString("✅ Noted successfully!"),
+			wantErr: false,
+			setup: func(tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (show the note)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes: []*historyDomain.Note{
+				historyDomain.NewNote(1, "note", now),
+			},
+			want:    "note",
+			wantErr: false,
+			setup: func(tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (clear the note)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes: []*historyDomain.Note{
+				historyDomain.NewNote(1, "note", now),
+			},
+			want:    color.GreenString("✅ Removed the note successfully!"),
+			wantErr: false,
+			setup: func(tt *args) {
+				noteOps.Clear = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				noteOps = origNoteOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no args)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     color.YellowString("⚡ No ID argument specified..."),
+			wantErr:  false,
+			setup: func(_ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no note found)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes:   nil,
+			want:    color.YellowString("⚡ No note found..."),
+			wantErr: false,
+			setup: func(tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no histories to note)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1", "note"},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     color.YellowString("⚡ No histories to note..."),
+			wantErr:  false,
+			setup: func(tt *args) {
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no notes to remove)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			notes:   nil,
+			want:    color.YellowString("⚡ No notes to remove..."),
+			wantErr: false,
+			setup: func(tt *args) {
+				noteOps.Clear = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				noteOps = origNoteOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (strconv.Atoi(args[0]) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"a"},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     color.RedString("🚨 The ID argument must be an integer..."),
+			wantErr:  true,
+			setup: func(_ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (the note is empty)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1", " "},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     color.RedString("🚨 The note argument must not be empty..."),
+			wantErr:  true,
+			setup: func(tt *args) {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (snuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1", "note"},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gnuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (rnuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"1"},
+				output: &output,
+			},
+			testData: nil,
+			notes:    nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(tt *args) {
+				noteOps.Clear = true
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				noteOps = origNoteOps
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			h := repository.NewHistoryRepository()
+			if len(tt.testData) > 0 {
+				if _, err := h.SaveAll(context.Background(), tt.testData); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+			}
+			for _, note := range tt.notes {
+				if _, err := h.SaveNote(context.Background(), note); err != nil {
+					t.Errorf("Failed to save test note: %v", err)
+				}
+			}
+			if err := runNote(tt.args.cmd, tt.args.args, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runNote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runNote() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package tag
+
+import (
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewAddCommand returns a new instance of the add command.
+func NewAddCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("add")
+	cmd.SetAliases([]string{"a"})
+	cmd.SetUsageTemplate(addUsageTemplate)
+	cmd.SetHelpTemplate(addHelpTemplate)
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runAdd(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runAdd runs the add command.
+func runAdd(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) < 2 {
+		o := formatter.Yellow("⚡ No tag or ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	ids, err := parseIDs(args[1:], output)
+	if err != nil {
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	tuc := jrpApp.NewTagUseCase(historyRepo)
+
+	if err := tuc.Run(
+		cmd.Context(),
+		args[0],
+		ids,
+	); err != nil && err.Error() == "tag is empty" {
+		o := formatter.Red("🚨 The tag argument must not be empty...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "no histories to tag" {
+		o := formatter.Yellow("⚡ No histories to tag...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Tagged successfully!")
+	*output = o
+
+	return nil
+}
+
+// parseIDs parses the ID arguments into the integers.
+func parseIDs(args []string, output *string) ([]int, error) {
+	var ids []int
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			o := formatter.Red("🚨 The ID argument must be an integer...")
+			*output = o
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+const (
+	// addHelpTemplate is the help template of the add command.
+	addHelpTemplate = `🏷️➕ Tag the histories of the "generate" command.
+
+You can specify the tag with the first argument and the histories to tag with the following ID arguments.
+You have to get ID from the "history" command.
+Multiple ID's can be specified separated by spaces.
+
+The histories already tagged with the tag are skipped.
+
+` + addUsageTemplate
+	// addUsageTemplate is the usage template of the add command.
+	addUsageTemplate = `Usage:
+  jrp tag add [arguments]
+  jrp tag a   [arguments]
+
+Flags:
+  -h, --help  🤝 help for add
+
+Arguments:
+  tag  🏷️ tag to add (e.g. : release-names)
+  ID   🆔 tag with the ID of the history (e.g. : 1 2 3)
+`
+)
@@ -0,0 +1,2 @@
+// Package tag provides the sub commands for the jrp tag.
+package tag
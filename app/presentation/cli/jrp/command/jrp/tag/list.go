@@ -0,0 +1,120 @@
+package tag
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// ListOptions provides the options for the list command.
+type ListOptions struct {
+	// Format is a flag to specify the format of the output.
+	Format string
+}
+
+var (
+	// listOps is a variable to store the list options with the default values for injecting the dependencies in testing.
+	listOps = ListOptions{
+		Format: "table",
+	}
+)
+
+// NewListCommand returns a new instance of the list command.
+func NewListCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("list")
+	cmd.SetAliases([]string{"ls", "l"})
+	cmd.SetUsageTemplate(listUsageTemplate)
+	cmd.SetHelpTemplate(listHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&listOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g. : \"plain\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runList(
+				cmd,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runList runs the list command.
+func runList(
+	cmd *c.Command,
+	output *string,
+) error {
+	if listOps.Format != "table" && listOps.Format != "plain" {
+		o := formatter.Red("🚨 Only the format \"table\" or \"plain\" is available...")
+		*output = o
+		return errors.New("unsupported format")
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	gtuc := jrpApp.NewGetTagsUseCase(historyRepo)
+
+	gtoDtos, err := gtuc.Run(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if len(gtoDtos) == 0 {
+		o := formatter.Yellow("⚡ No tags found...")
+		*output = o
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(listOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(gtoDtos)
+	if err != nil {
+		return err
+	}
+	*output = o
+
+	return nil
+}
+
+const (
+	// listHelpTemplate is the help template of the list command.
+	listHelpTemplate = `🏷️📋 List the tags of the histories of the "generate" command.
+
+You can list the tags with the number and the IDs of the histories tagged with them.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table" and "plain" are available.
+
+` + listUsageTemplate
+	// listUsageTemplate is the usage template of the list command.
+	listUsageTemplate = `Usage:
+  jrp tag list [flag]
+  jrp tag ls   [flag]
+  jrp tag l    [flag]
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g. : "plain")
+  -h, --help    🤝 help for list
+`
+)
@@ -0,0 +1,296 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewListCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewListCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewListCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run list command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runList(t *testing.T) {
+	var output string
+	origListOps := listOps
+	origNewFormatter := formatter.NewFormatter
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		histories []*historyDomain.History
+		tags      []*historyDomain.Tag
+		want      string
+		wantErr   bool
+		setup     func(mockCtrl *gomock.Controller, tt *args)
+		cleanup   func()
+	}{
+		{
+			name: "positive testing (no tags)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      color.YellowString("⚡ No tags found..."),
+			wantErr:   false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (tags exist)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "team-names"),
+				historyDomain.NewTag(1, "release-names"),
+				historyDomain.NewTag(2, "release-names"),
+			},
+			want:    "release-names\t2\nteam-names\t1",
+			wantErr: false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				listOps.Format = "plain"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				listOps = origListOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gtuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      "",
+			wantErr:   true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (format option is not table or plain)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+			},
+			want:    color.RedString("🚨 Only the format \"table\" or \"plain\" is available..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				listOps.Format = "json"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				listOps = origListOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter(listOps.Format) failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+			},
+			want:    color.RedString("❌ Failed to create a formatter..."),
+			wantErr: true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("formatter.NewFormatter() failed")
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				formatter.NewFormatter = origNewFormatter
+				listOps = origListOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (f.Format() failed)",
+			args: args{
+				cmd:    nil,
+				output: &output,
+			},
+			histories: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				initializeJrpDB(t)
+				mockFormatter := formatter.NewMockFormatter(mockCtrl)
+				mockFormatter.EXPECT().Format(gomock.Any()).Return("", errors.New("format error"))
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return mockFormatter, nil
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				formatter.NewFormatter = origNewFormatter
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			saveTestData(t, tt.histories, tt.tags)
+			if err := runList(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runList() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package tag
+
+import (
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// NewRemoveCommand returns a new instance of the remove command.
+func NewRemoveCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("remove")
+	cmd.SetAliases([]string{"rm", "r"})
+	cmd.SetUsageTemplate(removeUsageTemplate)
+	cmd.SetHelpTemplate(removeHelpTemplate)
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runRemove(
+				cmd,
+				args,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runRemove runs the remove command.
+func runRemove(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) < 2 {
+		o := formatter.Yellow("⚡ No tag or ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	ids, err := parseIDs(args[1:], output)
+	if err != nil {
+		return err
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	uuc := jrpApp.NewUntagUseCase(historyRepo)
+
+	if err := uuc.Run(
+		cmd.Context(),
+		args[0],
+		ids,
+	); err != nil && err.Error() == "tag is empty" {
+		o := formatter.Red("🚨 The tag argument must not be empty...")
+		*output = o
+		return err
+	} else if err != nil && err.Error() == "no histories to untag" {
+		o := formatter.Yellow("⚡ No histories to untag...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Untagged successfully!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// removeHelpTemplate is the help template of the remove command.
+	removeHelpTemplate = `🏷️➖ Untag the histories of the "generate" command.
+
+You can specify the tag with the first argument and the histories to untag with the following ID arguments.
+You have to get ID from the "history" command.
+Multiple ID's can be specified separated by spaces.
+
+` + removeUsageTemplate
+	// removeUsageTemplate is the usage template of the remove command.
+	removeUsageTemplate = `Usage:
+  jrp tag remove [arguments]
+  jrp tag rm     [arguments]
+  jrp tag r      [arguments]
+
+Flags:
+  -h, --help  🤝 help for remove
+
+Arguments:
+  tag  🏷️ tag to remove (e.g. : release-names)
+  ID   🆔 untag with the ID of the history (e.g. : 1 2 3)
+`
+)
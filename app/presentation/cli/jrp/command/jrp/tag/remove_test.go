@@ -0,0 +1,224 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+func TestNewRemoveCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRemoveCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewRemoveCommand() = %v, want not nil", got)
+			} else {
+				if err := got.RunE(nil, []string{}); err != nil {
+					t.Errorf("Failed to run the remove command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runRemove(t *testing.T) {
+	var output string
+
+	type args struct {
+		cmd    *c.Command
+		args   []string
+		output *string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		histories []*historyDomain.History
+		tags      []*historyDomain.Tag
+		want      string
+		wantErr   bool
+		setup     func(tt *args)
+		cleanup   func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    nil,
+				args:   []string{"tag", "1", "2"},
+				output: &output,
+			},
+			histories: []*historyDomain.History{
+				{
+					Phrase:    "test",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+			tags: []*historyDomain.Tag{
+				historyDomain.NewTag(1, "tag"),
+			},
+			want:    color.GreenString("✅ Untagged successfully!"),
+			wantErr: false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no args)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"tag"},
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      color.YellowString("⚡ No tag or ID arguments specified..."),
+			wantErr:   false,
+			setup: func(_ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (no histories to untag)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"tag", "1"},
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      color.YellowString("⚡ No histories to untag..."),
+			wantErr:   false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (parseIDs(args[1:], output) failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"tag", "a"},
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      color.RedString("🚨 The ID argument must be an integer..."),
+			wantErr:   true,
+			setup: func(_ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (the tag is empty)",
+			args: args{
+				cmd:    nil,
+				args:   []string{" ", "1"},
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      color.RedString("🚨 The tag argument must not be empty..."),
+			wantErr:   true,
+			setup: func(tt *args) {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (uuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{"tag", "1"},
+				output: &output,
+			},
+			histories: nil,
+			tags:      nil,
+			want:      "",
+			wantErr:   true,
+			setup: func(tt *args) {
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			saveTestData(t, tt.histories, tt.tags)
+			if err := runRemove(tt.args.cmd, tt.args.args, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runRemove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runRemove() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
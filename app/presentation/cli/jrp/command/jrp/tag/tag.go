@@ -0,0 +1,114 @@
+package tag
+
+import (
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// TagOptions provides the options for the tag command.
+type TagOptions struct {
+	ListOptions ListOptions
+}
+
+var (
+	// tagOps is a variable to store the tag options with the default values for injecting the dependencies in testing.
+	tagOps = TagOptions{
+		ListOptions: ListOptions{
+			Format: "table",
+		},
+	}
+)
+
+// NewTagCommand returns a new instance of the tag command.
+func NewTagCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("tag")
+	cmd.SetAliases([]string{"tg", "t"})
+	cmd.SetUsageTemplate(tagUsageTemplate)
+	cmd.SetHelpTemplate(tagHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&tagOps.ListOptions.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g. : \"plain\")",
+	)
+
+	listCmd := NewListCommand(
+		cobra,
+		output,
+	)
+	cmd.AddCommand(
+		NewAddCommand(
+			cobra,
+			output,
+		),
+		listCmd,
+		NewRemoveCommand(
+			cobra,
+			output,
+		),
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runTag(
+				cmd,
+				listCmd,
+				args,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runTag runs the tag command.
+func runTag(
+	cmd *c.Command,
+	listCmd proxy.Command,
+	args []string,
+) error {
+	listOps = tagOps.ListOptions
+	return listCmd.RunE(cmd, args)
+}
+
+const (
+	// tagHelpTemplate is the help template of the tag command.
+	tagHelpTemplate = `🏷️ Manage the tags of the histories of the "generate" command.
+
+You can tag the histories to organize them, for example by project.
+The tagged histories can be shown or searched with the flag "-t" or "--tag" of the "history" command.
+
+Those commands below are the same.
+  "jrp tag" : "jrp tag list"
+
+` + tagUsageTemplate
+	// tagUsageTemplate is the usage template of the tag command.
+	tagUsageTemplate = `Usage:
+  jrp tag [flag]
+  jrp tg  [flag]
+  jrp t   [flag]
+  jrp tag [command]
+  jrp tg  [command]
+  jrp t   [command]
+
+Available Subommands:
+  add,    a      🏷️➕ Tag the histories of the "generate" command.
+  remove, rm, r  🏷️➖ Untag the histories of the "generate" command.
+  list,   ls, l  🏷️📋 List the tags of the histories of the "generate" command.
+                      You can abbreviate "list" sub command. ("jrp tag" and "jrp tag list" are the same.)
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g. : "plain")
+  -h, --help    🤝 help for tag
+
+Use "jrp tag [command] --help" for more information about a command.
+`
+)
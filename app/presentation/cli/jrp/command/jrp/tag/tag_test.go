@@ -0,0 +1,174 @@
+package tag
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+var (
+	now = time.Now()
+)
+
+// initializeJrpDB initializes the connection to the jrp database in the temporary directory.
+func initializeJrpDB(t *testing.T) {
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+	cm := database.NewConnectionManager(proxy.NewSql())
+	if err := cm.InitializeConnection(
+		database.ConnectionConfig{
+			DBName: database.JrpDB,
+			DBType: database.SQLite,
+			DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+		},
+	); err != nil {
+		t.Errorf("Failed to initialize connection: %v", err)
+	}
+}
+
+// cleanupJrpDB resets the connection to the jrp database and removes the database file.
+func cleanupJrpDB(t *testing.T) {
+	if err := database.ResetConnectionManager(); err != nil {
+		t.Errorf("Failed to reset connection manager: %v", err)
+	}
+	if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+		t.Errorf("Failed to remove test database: %v", err)
+	}
+}
+
+// saveTestData saves the histories and tags them with the tags.
+func saveTestData(t *testing.T, histories []*historyDomain.History, tags []*historyDomain.Tag) {
+	h := repository.NewHistoryRepository()
+	if len(histories) > 0 {
+		if _, err := h.SaveAll(context.Background(), histories); err != nil {
+			t.Errorf("Failed to save test data: %v", err)
+		}
+	}
+	for _, tag := range tags {
+		if _, err := h.SaveTagByIdIn(context.Background(), tag.Name, []int{tag.HistoryID}); err != nil {
+			t.Errorf("Failed to save test tag: %v", err)
+		}
+	}
+}
+
+func TestNewTagCommand(t *testing.T) {
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func() {
+				initializeJrpDB(t)
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewTagCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewTagCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run tag command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runTag(t *testing.T) {
+	origListOps := listOps
+
+	type args struct {
+		cmd     *c.Command
+		listCmd proxy.Command
+		args    []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    ListOptions
+		wantErr bool
+		setup   func(tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:     nil,
+				listCmd: nil,
+				args:    []string{},
+			},
+			want: ListOptions{
+				Format: "plain",
+			},
+			wantErr: false,
+			setup: func(tt *args) {
+				initializeJrpDB(t)
+				tagOps.ListOptions.Format = "plain"
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				tt.listCmd = NewListCommand(proxy.NewCobra(), new(string))
+			},
+			cleanup: func() {
+				cleanupJrpDB(t)
+				tagOps.ListOptions.Format = "table"
+				listOps = origListOps
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runTag(tt.args.cmd, tt.args.listCmd, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if listOps != tt.want {
+				t.Errorf("runTag() : listOps = %v, want %v", listOps, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package tui provides the sub command for the jrp tui.
+package tui
@@ -0,0 +1,393 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+)
+
+const (
+	// defaultListHeight is the number of the candidates shown until the size of the terminal is known.
+	defaultListHeight = 10
+	// helpLabel is the help of the keys shown at the bottom of the TUI.
+	helpLabel = `↑/k ↓/j : move  space : select  f : favorite  p : re-roll prefix  s : re-roll suffix  r : re-roll
+n : more phrases  enter : save the selected  / : search the history  q : quit`
+)
+
+// candidate is a phrase generated in the TUI with the state of the curation.
+type candidate struct {
+	// jrp is the generated phrase.
+	jrp *jrpApp.GenerateJrpUseCaseOutputDto
+	// selected is the flag to indicate whether the phrase is selected to save.
+	selected bool
+	// saved is the flag to indicate whether the phrase is already saved.
+	saved bool
+}
+
+// savedMsg is a message that notifies the selected candidates are saved.
+type savedMsg struct {
+	// indexes are the indexes of the saved candidates.
+	indexes []int
+	// err is the error occurred while saving.
+	err error
+}
+
+// searchedMsg is a message that notifies the histories are searched.
+type searchedMsg struct {
+	// query is the query the histories are searched by.
+	query string
+	// histories are the histories found.
+	histories []*jrpApp.SearchHistoryUseCaseOutputDto
+	// err is the error occurred while searching.
+	err error
+}
+
+// model is the model of the TUI to curate the generated phrases.
+type model struct {
+	// candidates are the generated phrases.
+	candidates []*candidate
+	// cursor is the index of the candidate under the cursor.
+	cursor int
+	// offset is the index of the first candidate shown.
+	offset int
+	// height is the height of the terminal.
+	height int
+	// number is the number of the phrases to generate at once.
+	number int
+	// searching is the flag to indicate whether the query of the search is being typed.
+	searching bool
+	// query is the query to search the histories by.
+	query string
+	// histories are the histories found by the query.
+	histories []*jrpApp.SearchHistoryUseCaseOutputDto
+	// message is the result of the last action.
+	message string
+	// saved is the number of the phrases saved.
+	saved int
+	// err is the error stopping the TUI.
+	err error
+	// generate is the function to generate a phrase.
+	generate func() *jrpApp.GenerateJrpUseCaseOutputDto
+	// reroll is the function to generate a phrase keeping the prefix word or the suffix word of the given one.
+	reroll func(jrp *jrpApp.GenerateJrpUseCaseOutputDto, keepPrefix bool) *jrpApp.GenerateJrpUseCaseOutputDto
+	// save is the function to save the phrases as the histories.
+	save func(jrps []*jrpApp.GenerateJrpUseCaseOutputDto) error
+	// search is the function to search the histories by the query.
+	search func(query string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error)
+}
+
+// newModel returns a new instance of the model with the number of the generated phrases.
+func newModel(
+	number int,
+	generate func() *jrpApp.GenerateJrpUseCaseOutputDto,
+	reroll func(jrp *jrpApp.GenerateJrpUseCaseOutputDto, keepPrefix bool) *jrpApp.GenerateJrpUseCaseOutputDto,
+	save func(jrps []*jrpApp.GenerateJrpUseCaseOutputDto) error,
+	search func(query string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error),
+) *model {
+	m := &model{
+		number:   number,
+		generate: generate,
+		reroll:   reroll,
+		save:     save,
+		search:   search,
+	}
+	m.appendCandidates()
+
+	return m
+}
+
+// Init returns no command to run at the start.
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+// Update updates the model by the message and returns the command to run.
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		m.scroll()
+	case savedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		for _, i := range msg.indexes {
+			m.candidates[i].selected = false
+			m.candidates[i].saved = true
+		}
+		m.saved += len(msg.indexes)
+		m.message = formatter.Green("✅ Saved " + strconv.Itoa(len(msg.indexes)) + " phrases!")
+	case searchedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		if msg.query == m.query {
+			m.histories = msg.histories
+		}
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		return m.updateList(msg)
+	}
+
+	return m, nil
+}
+
+// updateList updates the model by the key pressed on the list of the candidates.
+func (m *model) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.message = ""
+	switch msg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.candidates)-1 {
+			m.cursor++
+		}
+	case " ":
+		if c := m.current(); c != nil {
+			c.selected = !c.selected
+		}
+	case "f":
+		if c := m.current(); c != nil {
+			if c.jrp.IsFavorited == 0 {
+				c.jrp.IsFavorited = 1
+				c.selected = true
+			} else {
+				c.jrp.IsFavorited = 0
+			}
+		}
+	case "p":
+		m.rerollCurrent(false)
+	case "s":
+		m.rerollCurrent(true)
+	case "r":
+		if c := m.current(); c != nil {
+			if jrp := m.generate(); jrp != nil {
+				m.candidates[m.cursor] = &candidate{jrp: jrp}
+			}
+		}
+	case "n":
+		m.appendCandidates()
+	case "enter":
+		return m, m.saveSelected()
+	case "/":
+		m.searching = true
+	}
+	m.scroll()
+
+	return m, nil
+}
+
+// updateSearch updates the model by the key pressed while typing the query.
+func (m *model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.searching = false
+		m.query = ""
+		m.histories = nil
+		return m, nil
+	case tea.KeyEnter:
+		m.searching = false
+		return m, nil
+	case tea.KeyBackspace:
+		if m.query == "" {
+			return m, nil
+		}
+		runes := []rune(m.query)
+		m.query = string(runes[:len(runes)-1])
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(msg.Runes)
+	default:
+		return m, nil
+	}
+
+	return m, m.searchHistories()
+}
+
+// current returns the candidate under the cursor, or nil if it is already saved.
+func (m *model) current() *candidate {
+	if len(m.candidates) == 0 {
+		return nil
+	}
+	c := m.candidates[m.cursor]
+	if c.saved {
+		m.message = formatter.Yellow("⚡ The phrase is already saved...")
+		return nil
+	}
+
+	return c
+}
+
+// rerollCurrent replaces the candidate under the cursor with a phrase keeping the prefix word or the suffix word.
+func (m *model) rerollCurrent(keepPrefix bool) {
+	c := m.current()
+	if c == nil {
+		return
+	}
+	jrp := m.reroll(c.jrp, keepPrefix)
+	if jrp == nil {
+		m.message = formatter.Yellow("⚡ The phrase can not be re-rolled...")
+		return
+	}
+	m.candidates[m.cursor] = &candidate{jrp: jrp}
+}
+
+// appendCandidates appends the number of the generated phrases to the candidates.
+func (m *model) appendCandidates() {
+	for i := 0; i < m.number; i++ {
+		if jrp := m.generate(); jrp != nil {
+			m.candidates = append(m.candidates, &candidate{jrp: jrp})
+		}
+	}
+}
+
+// saveSelected returns the command to save the selected candidates.
+func (m *model) saveSelected() tea.Cmd {
+	var indexes []int
+	var jrps []*jrpApp.GenerateJrpUseCaseOutputDto
+	for i, c := range m.candidates {
+		if c.selected && !c.saved {
+			indexes = append(indexes, i)
+			jrps = append(jrps, c.jrp)
+		}
+	}
+	if len(jrps) == 0 {
+		m.message = formatter.Yellow("⚡ No phrases selected...")
+		return nil
+	}
+
+	return func() tea.Msg {
+		return savedMsg{indexes: indexes, err: m.save(jrps)}
+	}
+}
+
+// searchHistories returns the command to search the histories by the query.
+func (m *model) searchHistories() tea.Cmd {
+	query := m.query
+	if strings.TrimSpace(query) == "" {
+		m.histories = nil
+		return nil
+	}
+
+	return func() tea.Msg {
+		histories, err := m.search(query)
+		return searchedMsg{query: query, histories: histories, err: err}
+	}
+}
+
+// scroll moves the offset to show the candidate under the cursor.
+func (m *model) scroll() {
+	height := m.listHeight()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+height {
+		m.offset = m.cursor - height + 1
+	}
+}
+
+// listHeight returns the number of the candidates to show in the terminal.
+func (m *model) listHeight() int {
+	if m.height == 0 {
+		return defaultListHeight
+	}
+
+	return max(1, m.height-2-len(m.footer()))
+}
+
+// View returns the TUI rendered by the model.
+func (m *model) View() string {
+	var selected int
+	for _, c := range m.candidates {
+		if c.selected {
+			selected++
+		}
+	}
+
+	lines := []string{
+		formatter.Blue(
+			"🎲 jrp tui : " +
+				strconv.Itoa(len(m.candidates)) + " phrases, " +
+				strconv.Itoa(selected) + " selected, " +
+				strconv.Itoa(m.saved) + " saved",
+		),
+		"",
+	}
+	end := min(len(m.candidates), m.offset+m.listHeight())
+	for i := m.offset; i < end; i++ {
+		lines = append(lines, m.row(i))
+	}
+	lines = append(lines, m.footer()...)
+
+	return strings.Join(lines, "\n")
+}
+
+// row returns the rendered candidate of the index.
+func (m *model) row(i int) string {
+	c := m.candidates[i]
+	pointer := "  "
+	if i == m.cursor {
+		pointer = "▶ "
+	}
+	check := "[ ]"
+	if c.saved {
+		check = "[✓]"
+	} else if c.selected {
+		check = "[x]"
+	}
+	favorite := "  "
+	if c.jrp.IsFavorited == 1 {
+		favorite = "⭐"
+	}
+	row := pointer + check + " " + favorite + " " + c.jrp.Phrase + "  " + c.jrp.Reading
+
+	switch {
+	case c.saved:
+		return formatter.Green(row)
+	case i == m.cursor:
+		return formatter.Blue(row)
+	case c.selected:
+		return formatter.Yellow(row)
+	default:
+		return row
+	}
+}
+
+// footer returns the rendered lines below the candidates.
+func (m *model) footer() []string {
+	lines := []string{""}
+	if m.searching || m.query != "" {
+		query := "🔍 Search : " + m.query
+		if m.searching {
+			query += "█"
+		}
+		lines = append(lines, query)
+		if len(m.histories) == 0 && m.query != "" {
+			lines = append(lines, "  No histories found...")
+		}
+		for _, h := range m.histories {
+			history := "  " + strconv.Itoa(h.ID) + "  " + h.Phrase
+			if h.IsFavorited == 1 {
+				history += "  ⭐"
+			}
+			lines = append(lines, history)
+		}
+		lines = append(lines, "")
+	}
+	lines = append(lines, m.message)
+	lines = append(lines, strings.Split(helpLabel, "\n")...)
+
+	return lines
+}
@@ -0,0 +1,459 @@
+package tui
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+)
+
+// newTestModel returns a new instance of the model generating the phrases "phrase1", "phrase2", ... in order.
+func newTestModel(number int) *model {
+	generated := 0
+	return newModel(
+		number,
+		func() *jrpApp.GenerateJrpUseCaseOutputDto {
+			generated++
+			return &jrpApp.GenerateJrpUseCaseOutputDto{
+				Phrase:  "phrase" + strconv.Itoa(generated),
+				WordIDs: []int{generated, generated},
+			}
+		},
+		func(jrp *jrpApp.GenerateJrpUseCaseOutputDto, keepPrefix bool) *jrpApp.GenerateJrpUseCaseOutputDto {
+			if keepPrefix {
+				return &jrpApp.GenerateJrpUseCaseOutputDto{Phrase: jrp.Phrase + "-suffix"}
+			}
+			return &jrpApp.GenerateJrpUseCaseOutputDto{Phrase: jrp.Phrase + "-prefix"}
+		},
+		func(_ []*jrpApp.GenerateJrpUseCaseOutputDto) error {
+			return nil
+		},
+		func(query string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error) {
+			return []*jrpApp.SearchHistoryUseCaseOutputDto{
+				{
+					ID:          1,
+					Phrase:      query + "-history",
+					IsFavorited: 1,
+				},
+			}, nil
+		},
+	)
+}
+
+// keys returns the messages of the keys pressed in order.
+func keys(keys ...string) []tea.Msg {
+	var msgs []tea.Msg
+	for _, k := range keys {
+		switch k {
+		case "up":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyUp})
+		case "down":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyDown})
+		case "enter":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyEnter})
+		case "esc":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyEsc})
+		case "backspace":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyBackspace})
+		case "ctrl+c":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyCtrlC})
+		case " ":
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+		default:
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		}
+	}
+
+	return msgs
+}
+
+// update updates the model by the messages in order running the commands returned but quitting.
+// it returns whether the model quits.
+func update(m *model, msgs []tea.Msg) bool {
+	for _, msg := range msgs {
+		_, cmd := m.Update(msg)
+		for cmd != nil {
+			result := cmd()
+			if _, ok := result.(tea.QuitMsg); ok {
+				return true
+			}
+			_, cmd = m.Update(result)
+		}
+	}
+
+	return false
+}
+
+func Test_newModel(t *testing.T) {
+	type args struct {
+		number int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				number: 3,
+			},
+			want: 3,
+		},
+		{
+			name: "positive testing (number is 0)",
+			args: args{
+				number: 0,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newTestModel(tt.args.number)
+			if len(got.candidates) != tt.want {
+				t.Errorf("newModel() = %v, want %v", len(got.candidates), tt.want)
+			}
+			if got.Init() != nil {
+				t.Errorf("model.Init() = not nil, want nil")
+			}
+		})
+	}
+}
+
+func Test_model_Update(t *testing.T) {
+	tests := []struct {
+		name         string
+		number       int
+		msgs         []tea.Msg
+		wantQuit     bool
+		wantCursor   int
+		wantOffset   int
+		wantPhrases  []string
+		wantSelected []bool
+		wantSaved    int
+		wantFavorite []int
+		wantQuery    string
+		wantMessage  string
+		wantErr      bool
+		setup        func(m *model)
+	}{
+		{
+			name:         "positive testing (move the cursor)",
+			number:       3,
+			msgs:         keys("down", "j", "j", "up"),
+			wantQuit:     false,
+			wantCursor:   1,
+			wantPhrases:  []string{"phrase1", "phrase2", "phrase3"},
+			wantSelected: []bool{false, false, false},
+			wantFavorite: []int{0, 0, 0},
+		},
+		{
+			name:         "positive testing (move the cursor up at the top)",
+			number:       2,
+			msgs:         keys("k"),
+			wantQuit:     false,
+			wantCursor:   0,
+			wantPhrases:  []string{"phrase1", "phrase2"},
+			wantSelected: []bool{false, false},
+			wantFavorite: []int{0, 0},
+		},
+		{
+			name:         "positive testing (scroll the list)",
+			number:       20,
+			msgs:         append([]tea.Msg{tea.WindowSizeMsg{Height: 12}}, keys("j", "j", "j", "j", "j", "j")...),
+			wantQuit:     false,
+			wantCursor:   6,
+			wantOffset:   1,
+			wantPhrases:  nil,
+			wantSelected: nil,
+			wantFavorite: nil,
+		},
+		{
+			name:         "positive testing (select and deselect)",
+			number:       2,
+			msgs:         keys(" ", "j", " ", " "),
+			wantQuit:     false,
+			wantCursor:   1,
+			wantPhrases:  []string{"phrase1", "phrase2"},
+			wantSelected: []bool{true, false},
+			wantFavorite: []int{0, 0},
+		},
+		{
+			name:         "positive testing (favorite and unfavorite)",
+			number:       2,
+			msgs:         keys("f", "j", "f", "f"),
+			wantQuit:     false,
+			wantCursor:   1,
+			wantPhrases:  []string{"phrase1", "phrase2"},
+			wantSelected: []bool{true, true},
+			wantFavorite: []int{1, 0},
+		},
+		{
+			name:         "positive testing (re-roll the prefix, the suffix and the whole phrase)",
+			number:       3,
+			msgs:         keys("p", "j", "s", "j", "r"),
+			wantQuit:     false,
+			wantCursor:   2,
+			wantPhrases:  []string{"phrase1-prefix", "phrase2-suffix", "phrase4"},
+			wantSelected: []bool{false, false, false},
+			wantFavorite: []int{0, 0, 0},
+		},
+		{
+			name:         "positive testing (re-roll failed)",
+			number:       1,
+			msgs:         keys("p"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+			wantMessage:  "⚡ The phrase can not be re-rolled...",
+			setup: func(m *model) {
+				m.reroll = func(_ *jrpApp.GenerateJrpUseCaseOutputDto, _ bool) *jrpApp.GenerateJrpUseCaseOutputDto {
+					return nil
+				}
+			},
+		},
+		{
+			name:         "positive testing (generate more phrases)",
+			number:       2,
+			msgs:         keys("n"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1", "phrase2", "phrase3", "phrase4"},
+			wantSelected: []bool{false, false, false, false},
+			wantFavorite: []int{0, 0, 0, 0},
+		},
+		{
+			name:         "positive testing (save the selected)",
+			number:       3,
+			msgs:         keys(" ", "j", "j", "f", "enter"),
+			wantQuit:     false,
+			wantCursor:   2,
+			wantPhrases:  []string{"phrase1", "phrase2", "phrase3"},
+			wantSelected: []bool{false, false, false},
+			wantSaved:    2,
+			wantFavorite: []int{0, 0, 1},
+			wantMessage:  "✅ Saved 2 phrases!",
+		},
+		{
+			name:         "positive testing (change the saved)",
+			number:       1,
+			msgs:         keys(" ", "enter", "f"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantSaved:    1,
+			wantFavorite: []int{0},
+			wantMessage:  "⚡ The phrase is already saved...",
+		},
+		{
+			name:         "positive testing (save no phrases)",
+			number:       1,
+			msgs:         keys("enter"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+			wantMessage:  "⚡ No phrases selected...",
+		},
+		{
+			name:         "positive testing (search the histories)",
+			number:       1,
+			msgs:         keys("/", "a", "b", " ", "c", "backspace", "backspace", "enter", "j"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+			wantQuery:    "ab",
+		},
+		{
+			name:         "positive testing (clear the search)",
+			number:       1,
+			msgs:         keys("/", "a", "esc"),
+			wantQuit:     false,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+			wantQuery:    "",
+		},
+		{
+			name:         "positive testing (quit)",
+			number:       1,
+			msgs:         keys("q"),
+			wantQuit:     true,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+		},
+		{
+			name:         "positive testing (quit while searching)",
+			number:       1,
+			msgs:         keys("/", "ctrl+c"),
+			wantQuit:     true,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+		},
+		{
+			name:         "negative testing (m.save() failed)",
+			number:       1,
+			msgs:         keys(" ", "enter"),
+			wantQuit:     true,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{true},
+			wantFavorite: []int{0},
+			wantErr:      true,
+			setup: func(m *model) {
+				m.save = func(_ []*jrpApp.GenerateJrpUseCaseOutputDto) error {
+					return errors.New("save() failed")
+				}
+			},
+		},
+		{
+			name:         "negative testing (m.search() failed)",
+			number:       1,
+			msgs:         keys("/", "a"),
+			wantQuit:     true,
+			wantPhrases:  []string{"phrase1"},
+			wantSelected: []bool{false},
+			wantFavorite: []int{0},
+			wantQuery:    "a",
+			wantErr:      true,
+			setup: func(m *model) {
+				m.search = func(_ string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error) {
+					return nil, errors.New("search() failed")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(tt.number)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			if got := update(m, tt.msgs); got != tt.wantQuit {
+				t.Errorf("model.Update() quit = %v, want %v", got, tt.wantQuit)
+			}
+			if m.cursor != tt.wantCursor {
+				t.Errorf("model.Update() cursor = %v, want %v", m.cursor, tt.wantCursor)
+			}
+			if m.offset != tt.wantOffset {
+				t.Errorf("model.Update() offset = %v, want %v", m.offset, tt.wantOffset)
+			}
+			if tt.wantPhrases != nil {
+				if len(m.candidates) != len(tt.wantPhrases) {
+					t.Fatalf("model.Update() candidates = %v, want %v", len(m.candidates), len(tt.wantPhrases))
+				}
+				for i, c := range m.candidates {
+					if c.jrp.Phrase != tt.wantPhrases[i] {
+						t.Errorf("model.Update() phrase[%d] = %v, want %v", i, c.jrp.Phrase, tt.wantPhrases[i])
+					}
+					if c.selected != tt.wantSelected[i] {
+						t.Errorf("model.Update() selected[%d] = %v, want %v", i, c.selected, tt.wantSelected[i])
+					}
+					if c.jrp.IsFavorited != tt.wantFavorite[i] {
+						t.Errorf("model.Update() favorited[%d] = %v, want %v", i, c.jrp.IsFavorited, tt.wantFavorite[i])
+					}
+				}
+			}
+			if m.saved != tt.wantSaved {
+				t.Errorf("model.Update() saved = %v, want %v", m.saved, tt.wantSaved)
+			}
+			if m.query != tt.wantQuery {
+				t.Errorf("model.Update() query = %v, want %v", m.query, tt.wantQuery)
+			}
+			if !strings.Contains(m.message, tt.wantMessage) {
+				t.Errorf("model.Update() message = %v, want %v", m.message, tt.wantMessage)
+			}
+			if (m.err != nil) != tt.wantErr {
+				t.Errorf("model.Update() error = %v, wantErr %v", m.err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_model_View(t *testing.T) {
+	tests := []struct {
+		name        string
+		number      int
+		msgs        []tea.Msg
+		wantLines   int
+		want        []string
+		wantMissing []string
+		setup       func(m *model)
+	}{
+		{
+			name:        "positive testing",
+			number:      3,
+			msgs:        keys(" ", "j", "f"),
+			wantLines:   0,
+			want:        []string{"🎲 jrp tui : 3 phrases, 2 selected, 0 saved", "[x]    phrase1", "▶ [x] ⭐ phrase2", "[ ]    phrase3", "q : quit"},
+			wantMissing: []string{"🔍 Search"},
+		},
+		{
+			name:        "positive testing (saved)",
+			number:      1,
+			msgs:        keys(" ", "enter"),
+			wantLines:   0,
+			want:        []string{"0 selected, 1 saved", "[✓]    phrase1", "✅ Saved 1 phrases!"},
+			wantMissing: nil,
+		},
+		{
+			name:        "positive testing (searching)",
+			number:      1,
+			msgs:        keys("/", "a"),
+			wantLines:   0,
+			want:        []string{"🔍 Search : a█", "1  a-history  ⭐"},
+			wantMissing: nil,
+		},
+		{
+			name:        "positive testing (no histories found)",
+			number:      1,
+			msgs:        keys("/", "a", "enter"),
+			wantLines:   0,
+			want:        []string{"🔍 Search : a\n", "No histories found..."},
+			wantMissing: []string{"█"},
+			setup: func(m *model) {
+				m.search = func(_ string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error) {
+					return nil, nil
+				}
+			},
+		},
+		{
+			name:        "positive testing (fit in the terminal)",
+			number:      20,
+			msgs:        []tea.Msg{tea.WindowSizeMsg{Height: 12}},
+			wantLines:   12,
+			want:        []string{"phrase1 ", "phrase6 "},
+			wantMissing: []string{"phrase7 "},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(tt.number)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			update(m, tt.msgs)
+			got := m.View()
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("model.View() = %v, want containing %v", got, w)
+				}
+			}
+			for _, w := range tt.wantMissing {
+				if strings.Contains(got, w) {
+					t.Errorf("model.View() = %v, want not containing %v", got, w)
+				}
+			}
+			if tt.wantLines != 0 && len(strings.Split(got, "\n")) != tt.wantLines {
+				t.Errorf("model.View() lines = %v, want %v", len(strings.Split(got, "\n")), tt.wantLines)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package tui
+
+import (
+	"context"
+	"strconv"
+
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
+	historyDomain "github.com/yanosea/jrp/v2/app/domain/jrp/history"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/infrastructure/jrp/repository"
+	"github.com/yanosea/jrp/v2/app/infrastructure/wnjpn/query_service"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/formatter"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// TuiOptions provides the options for the tui command.
+type TuiOptions struct {
+	// Number is a flag to specify the number of the phrases to generate at once.
+	Number int
+	// Raw is a flag to generate phrases without conjugating the adjectives and the verbs.
+	Raw bool
+	// Seed is a flag to specify the seed to generate the same phrases reproducibly.
+	Seed int64
+}
+
+const (
+	// tuiSearchNumber is the number of the most recent histories shown by the search in the TUI.
+	tuiSearchNumber = 10
+)
+
+var (
+	// tuiOps is a variable to store the tui options with the default values for injecting the dependencies in testing.
+	tuiOps = TuiOptions{
+		Number: 20,
+		Raw:    false,
+		Seed:   0,
+	}
+)
+
+// NewTuiCommand returns a new instance of the tui command.
+func NewTuiCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("tui")
+	cmd.SetUsageTemplate(tuiUsageTemplate)
+	cmd.SetHelpTemplate(tuiHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().IntVarP(
+		&tuiOps.Number,
+		"number",
+		"n",
+		20,
+		"🔢 number of phrases to generate at once (default 20, e.g. : 50)",
+	)
+	cmd.Flags().BoolVarP(
+		&tuiOps.Raw,
+		"raw",
+		"r",
+		false,
+		"🪨 generate phrases without conjugating adjectives and verbs",
+	)
+	cmd.Flags().Int64VarP(
+		&tuiOps.Seed,
+		"seed",
+		"",
+		0,
+		"🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runTui(
+				cmd,
+				output,
+			)
+		},
+	)
+
+	return cmd
+}
+
+// runTui runs the tui command.
+func runTui(
+	cmd *c.Command,
+	output *string,
+) error {
+	connManager := database.GetConnectionManager()
+	if connManager == nil {
+		o := formatter.Red("❌ Connection manager is not initialized...")
+		*output = o
+		return nil
+	}
+
+	_, err := connManager.GetConnection(database.WNJpnDB)
+	if err != nil && err.Error() == "connection not initialized" {
+		o := formatter.Yellow("⚡ You have to execute \"download\" to use jrp...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if tuiOps.Number <= 0 {
+		o := formatter.Yellow("⚡ The number of phrases must be greater than 0...")
+		*output = o
+		return nil
+	}
+
+	wordQueryService := query_service.NewWordQueryService()
+	fwuc := wnjpnApp.NewFetchWordsUseCase(wordQueryService)
+
+	fwoDtos, err := fwuc.Run(
+		cmd.Context(),
+		"jpn",
+		[]string{"a", "v", "n"},
+	)
+	if err != nil {
+		return err
+	}
+
+	var gjiDtos []*jrpApp.GenerateJrpUseCaseInputDto
+	for _, fwDto := range fwoDtos {
+		gjiDto := &jrpApp.GenerateJrpUseCaseInputDto{
+			WordID: fwDto.WordID,
+			Lang:   fwDto.Lang,
+			Lemma:  fwDto.Lemma,
+			Pron:   fwDto.Pron,
+			Pos:    fwDto.Pos,
+		}
+		gjiDtos = append(gjiDtos, gjiDto)
+	}
+
+	pool := jrpApp.NewWordPool(gjiDtos)
+
+	gjuc := jrpApp.NewGenerateJrpUseCase(!tuiOps.Raw)
+	if tuiOps.Seed != 0 {
+		gjuc = gjuc.WithSeed(tuiOps.Seed)
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	m := newModel(
+		tuiOps.Number,
+		func() *jrpApp.GenerateJrpUseCaseOutputDto {
+			return gjuc.RunWithRandom(pool)
+		},
+		func(jrp *jrpApp.GenerateJrpUseCaseOutputDto, keepPrefix bool) *jrpApp.GenerateJrpUseCaseOutputDto {
+			if keepPrefix {
+				return gjuc.RunWithWords(pool, jrp.PrefixWord, nil)
+			}
+			return gjuc.RunWithWords(pool, nil, jrp.SuffixWord)
+		},
+		func(jrps []*jrpApp.GenerateJrpUseCaseOutputDto) error {
+			return saveJrps(cmd.Context(), historyRepo, jrps)
+		},
+		func(query string) ([]*jrpApp.SearchHistoryUseCaseOutputDto, error) {
+			shuc := jrpApp.NewSearchHistoryUseCase(historyRepo)
+			return shuc.Run(
+				cmd.Context(),
+				&jrpApp.SearchHistoryUseCaseInputDto{
+					Keywords: []string{query},
+					Number:   tuiSearchNumber,
+					Desc:     true,
+				},
+			)
+		},
+	)
+	if len(m.candidates) == 0 {
+		o := formatter.Yellow("⚡ The words can not generate phrases...")
+		*output = o
+		return nil
+	}
+
+	if _, err := presenter.RunTui(m); err != nil {
+		return err
+	}
+	if m.err != nil {
+		return m.err
+	}
+
+	if m.saved == 0 {
+		o := formatter.Yellow("⏩ No phrases saved.")
+		*output = o
+		return nil
+	}
+
+	o := formatter.Green("✅ Saved " + strconv.Itoa(m.saved) + " phrases!")
+	*output = o
+
+	return nil
+}
+
+// saveJrps saves the jrps as the histories.
+func saveJrps(
+	ctx context.Context,
+	historyRepo historyDomain.HistoryRepository,
+	jrps []*jrpApp.GenerateJrpUseCaseOutputDto,
+) error {
+	var shiDtos []*jrpApp.SaveHistoryUseCaseInputDto
+	for _, jrp := range jrps {
+		shiDto := &jrpApp.SaveHistoryUseCaseInputDto{
+			Phrase:      jrp.Phrase,
+			Reading:     jrp.Reading,
+			Romaji:      jrp.Romaji,
+			WordIDs:     jrp.WordIDs,
+			Prefix:      jrp.Prefix,
+			Suffix:      jrp.Suffix,
+			IsFavorited: jrp.IsFavorited,
+			CreatedAt:   jrp.CreatedAt,
+			UpdatedAt:   jrp.UpdatedAt,
+		}
+		shiDtos = append(shiDtos, shiDto)
+	}
+
+	shuc := jrpApp.NewSaveHistoryUseCase(historyRepo)
+	_, err := shuc.Run(ctx, shiDtos)
+
+	return err
+}
+
+const (
+	// tuiHelpTemplate is the help template of the tui command.
+	tuiHelpTemplate = `🖥️ Curate Japanese random phrases in the full-screen TUI.
+
+You can browse the generated phrases in a scrollable list, select the phrases you like and save them at once.
+You can specify how many phrases to generate at once by flag "-n" or "--number".
+The adjectives and verbs are conjugated unless you specify the flag "-r" or "--raw".
+And you can generate the same phrases reproducibly by specifying the same seed by the flag "--seed".
+
+Press the keys below in the TUI:
+  "↑" / "k"  : Move up.
+  "↓" / "j"  : Move down.
+  "space"    : Select or deselect the phrase.
+  "f"        : Favorite or unfavorite the phrase. The favorited phrase is selected.
+  "p"        : Re-roll the prefix of the phrase keeping the suffix.
+  "s"        : Re-roll the suffix of the phrase keeping the prefix.
+  "r"        : Re-roll the whole phrase.
+  "n"        : Generate more phrases.
+  "enter"    : Save the selected phrases as the histories.
+  "/"        : Search the histories. "enter" to close the search, "esc" to clear it.
+  "q", "esc" : Quit.
+
+` + tuiUsageTemplate
+	// tuiUsageTemplate is the usage template of the tui command.
+	tuiUsageTemplate = `Usage:
+  jrp tui [flags]
+
+Flags:
+  -n, --number  🔢 number of phrases to generate at once (default 20, e.g. : 50)
+  -r, --raw     🪨 generate phrases without conjugating adjectives and verbs
+      --seed    🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)
+  -h, --help    🤝 help for tui
+`
+)
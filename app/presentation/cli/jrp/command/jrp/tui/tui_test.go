@@ -0,0 +1,609 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fatih/color"
+	c "github.com/spf13/cobra"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/presenter"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewTuiCommand(t *testing.T) {
+	origTu := presenter.Tu
+	duc := jrpApp.NewDownloadUseCase()
+	if err := duc.Run(filepath.Join(os.TempDir(), "wnjpn.db")); err != nil && err.Error() != "wnjpn.db already exists" {
+		t.Errorf("Failed to download WordNet Japan DB file: %v", err)
+	}
+
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: new(string),
+			},
+			setup: func(mockCtrl *gomock.Controller) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).Return(nil, nil)
+				presenter.Tu = mockTuiUtil
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Tu = origTu
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got := NewTuiCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewTuiCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the tui command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runTui(t *testing.T) {
+	var output string
+	origTuiOps := tuiOps
+	origTu := presenter.Tu
+	origFunc := database.GetConnectionManagerFunc
+	origNewFetchWordsUseCase := wnjpnApp.NewFetchWordsUseCase
+	duc := jrpApp.NewDownloadUseCase()
+	if err := duc.Run(filepath.Join(os.TempDir(), "wnjpn.db")); err != nil && err.Error() != "wnjpn.db already exists" {
+		t.Errorf("Failed to download WordNet Japan DB file: %v", err)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (no phrases saved)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⏩ No phrases saved."),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).Return(nil, nil)
+				presenter.Tu = mockTuiUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Tu = origTu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (phrases saved)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.GreenString("✅ Saved 1 phrases!"),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).DoAndReturn(func(m tea.Model) (tea.Model, error) {
+					for _, msg := range []tea.Msg{tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, tea.KeyMsg{Type: tea.KeyEnter}} {
+						_, cmd := m.Update(msg)
+						if cmd != nil {
+							m.Update(cmd())
+						}
+					}
+					return m, nil
+				})
+				presenter.Tu = mockTuiUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Tu = origTu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (seed and raw are set)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⏩ No phrases saved."),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				tuiOps.Seed = 42
+				tuiOps.Raw = true
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).Return(nil, nil)
+				presenter.Tu = mockTuiUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tuiOps = origTuiOps
+				presenter.Tu = origTu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager == nil)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.RedString("❌ Connection manager is not initialized..."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connManager.GetConnection(WNJpnDB) == connection not initialized)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⚡ You have to execute \"download\" to use jrp..."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (connectionManager.GetConnection(WNJpnDB) failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, _ *args) {
+				mockConnManager := database.NewMockConnectionManager(mockCtrl)
+				mockConnManager.EXPECT().GetConnection(database.WNJpnDB).Return(nil, errors.New("ConnectionManager.GetConnection() failed"))
+				database.GetConnectionManagerFunc = func() database.ConnectionManager {
+					return mockConnManager
+				}
+				output = ""
+			},
+			cleanup: func() {
+				database.GetConnectionManagerFunc = origFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (number <= 0)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⚡ The number of phrases must be greater than 0..."),
+			wantErr: false,
+			setup: func(_ *gomock.Controller, _ *args) {
+				tuiOps.Number = 0
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				tuiOps = origTuiOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (fwuc.Run() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return(nil, errors.New("WordQueryService.FindByLangIsAndPosIn() failed"))
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (the words can not generate phrases)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    color.YellowString("⚡ The words can not generate phrases..."),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockWordQueryService := wnjpnApp.NewMockWordQueryService(mockCtrl)
+				mockWordQueryService.EXPECT().
+					FindByLangIsAndPosIn(gomock.Any(), "jpn", gomock.Any()).
+					Return(nil, nil)
+				wnjpnApp.NewFetchWordsUseCase = func(wordQueryService wnjpnApp.WordQueryService) *wnjpnApp.FetchWordsUseCaseStruct {
+					return origNewFetchWordsUseCase(mockWordQueryService)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				wnjpnApp.NewFetchWordsUseCase = origNewFetchWordsUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (presenter.RunTui() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).Return(nil, errors.New("TuiUtil.RunTui() failed"))
+				presenter.Tu = mockTuiUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Tu = origTu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (saveJrps() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.WNJpnDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "wnjpn.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				mockTuiUtil := utility.NewMockTuiUtil(mockCtrl)
+				mockTuiUtil.EXPECT().RunTui(gomock.Any()).DoAndReturn(func(m tea.Model) (tea.Model, error) {
+					for _, msg := range []tea.Msg{tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, tea.KeyMsg{Type: tea.KeyEnter}} {
+						_, cmd := m.Update(msg)
+						if cmd != nil {
+							m.Update(cmd())
+						}
+					}
+					return m, nil
+				})
+				presenter.Tu = mockTuiUtil
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				presenter.Tu = origTu
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if err := runTui(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runTui() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.want {
+				t.Errorf("runTui() = %v, want %v", *tt.args.output, tt.want)
+			}
+		})
+	}
+}
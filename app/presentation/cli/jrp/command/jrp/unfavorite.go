@@ -19,6 +19,8 @@ type UnfavoriteOptions struct {
 	All bool
 	// NoConfirm is a flag to not confirm before unfavoriting all the historyies.
 	NoConfirm bool
+	// Undo is a flag to favorite again the histories unfavorited by the all flag last time.
+	Undo bool
 }
 
 var (
@@ -26,6 +28,7 @@ var (
 	unfavoriteOps = UnfavoriteOptions{
 		All:       false,
 		NoConfirm: false,
+		Undo:      false,
 	}
 )
 
@@ -54,6 +57,13 @@ func NewUnfavoriteCommand(
 		false,
 		"🚫 do not confirm before removing all the favorited phrases",
 	)
+	cmd.Flags().BoolVarP(
+		&unfavoriteOps.Undo,
+		"undo",
+		"",
+		false,
+		"↩️ favorite again the phrases unfavorited by the all flag last time",
+	)
 
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
@@ -74,6 +84,10 @@ func runUnfavorite(
 	args []string,
 	output *string,
 ) error {
+	if unfavoriteOps.Undo {
+		return runUndoUnfavorite(cmd, args, output)
+	}
+
 	if len(args) == 0 && !unfavoriteOps.All {
 		o := formatter.Yellow("⚡ No ID arguments specified...")
 		*output = o
@@ -124,6 +138,35 @@ func runUnfavorite(
 	return nil
 }
 
+// runUndoUnfavorite favorites again the histories unfavorited by the all flag last time.
+func runUndoUnfavorite(
+	cmd *c.Command,
+	args []string,
+	output *string,
+) error {
+	if len(args) > 0 || unfavoriteOps.All {
+		o := formatter.Yellow("⚡ You can't specify the undo flag with ID arguments or the all flag...")
+		*output = o
+		return nil
+	}
+
+	historyRepo := repository.NewHistoryRepository()
+	rfuc := jrpApp.NewRestoreFavoriteUseCase(historyRepo)
+
+	if err := rfuc.Run(cmd.Context()); err != nil && err.Error() == "no favorites to restore" {
+		o := formatter.Yellow("⚡ No favorites to restore...")
+		*output = o
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	o := formatter.Green("✅ Restored the favorites successfully!")
+	*output = o
+
+	return nil
+}
+
 const (
 	// unfavoriteHelpTemplatep is the help template of the unfavorite command.
 	unfavoriteHelpTemplatep = `⭐🧹 Unfavorite the favorited histories with the "favorite" command.
@@ -135,6 +178,7 @@ Multiple ID's can be specified separated by spaces.
 This does not remove the history of the "generate" command, just unfavorite.
 
 Also, you can unfavorite all the favorited histories with the "-a" or "--all" flag.
+You can undo it with the "--undo" flag, which favorites again the histories unfavorited by the "--all" flag last time.
 
 ` + unfavoriteUsageTemplate
 	// unfavoriteUsageTemplate is the usage template of the unfavorite command.
@@ -146,6 +190,7 @@ Also, you can unfavorite all the favorited histories with the "-a" or "--all" fl
 Flags:
   -a, --all    ✨ unfavorite all the favorited histories
   -no-confirm  🚫 do not confirm before unfavoriting all the favorited histories
+  --undo       ↩️ favorite again the histories unfavorited by the all flag last time
   -h, --help   🤝 help for unfavorite
 
 Arguments:
@@ -416,6 +416,157 @@ func Test_runUnfavorite(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (undo, favorites to restore exist)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.GreenString("✅ Restored the favorites successfully!"),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				unfavoriteOps.Undo = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				h := repository.NewHistoryRepository()
+				if _, err := h.SaveAll(context.Background(), []*historyDomain.History{
+					{
+						Phrase:      "test",
+						IsFavorited: 1,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+					},
+				}); err != nil {
+					t.Errorf("Failed to save test data: %v", err)
+				}
+				if _, err := h.UnfavoriteAll(context.Background()); err != nil {
+					t.Errorf("Failed to unfavorite test data: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				unfavoriteOps = origUnunfavoriteOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (undo, no favorites to restore)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.YellowString("⚡ No favorites to restore..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				unfavoriteOps.Undo = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				cm := database.NewConnectionManager(proxy.NewSql())
+				if err := cm.InitializeConnection(
+					database.ConnectionConfig{
+						DBName: database.JrpDB,
+						DBType: database.SQLite,
+						DSN:    filepath.Join(os.TempDir(), "jrp.db"),
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize connection: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				unfavoriteOps = origUnunfavoriteOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (undo with the all flag)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     color.YellowString("⚡ You can't specify the undo flag with ID arguments or the all flag..."),
+			wantErr:  false,
+			setup: func(_ *gomock.Controller, tt *args) {
+				unfavoriteOps.Undo = true
+				unfavoriteOps.All = true
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				unfavoriteOps = origUnunfavoriteOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (rfuc.Run() failed)",
+			args: args{
+				cmd:    nil,
+				args:   []string{},
+				output: &output,
+			},
+			testData: nil,
+			want:     "",
+			wantErr:  true,
+			setup: func(_ *gomock.Controller, tt *args) {
+				unfavoriteOps.Undo = true
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				database.NewConnectionManager(proxy.NewSql())
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := database.ResetConnectionManager(); err != nil {
+					t.Errorf("Failed to reset connection manager: %v", err)
+				}
+				if err := os.Remove(filepath.Join(os.TempDir(), "jrp.db")); err != nil && !os.IsNotExist(err) {
+					t.Errorf("Failed to remove test database: %v", err)
+				}
+				unfavoriteOps = origUnunfavoriteOps
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (uuc.Run() failed)",
 			args: args{
@@ -5,8 +5,12 @@ import (
 
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/completion"
+	configCommand "github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/config"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/db"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/generate"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/history"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/tag"
+	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/command/jrp/tui"
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
@@ -25,13 +29,19 @@ var (
 	rootOps = RootOptions{
 		Version: false,
 		GenerateOptions: generate.GenerateOptions{
-			Number:      1,
-			Prefix:      "",
-			Suffix:      "",
-			DryRun:      false,
-			Format:      "table",
-			Interactive: false,
-			Timeout:     30,
+			Number:             1,
+			Prefix:             "",
+			Suffix:             "",
+			DryRun:             false,
+			Format:             "table",
+			Interactive:        false,
+			Timeout:            30,
+			Template:           "",
+			Raw:                false,
+			Seed:               0,
+			OutputTemplate:     "",
+			OutputTemplateFile: "",
+			Unique:             false,
 		},
 	}
 )
@@ -41,6 +51,7 @@ func NewRootCommand(
 	cobra proxy.Cobra,
 	version string,
 	conf *config.JrpCliConfig,
+	configurator config.JrpCliConfigurator,
 	output *string,
 ) proxy.Command {
 	cmd := cobra.NewCommand()
@@ -60,7 +71,7 @@ func NewRootCommand(
 		&rootOps.GenerateOptions.Number,
 		"number",
 		"n",
-		1,
+		conf.Generate.Number,
 		"🔢 number of phrases to generate (default 1, e.g. : 10)",
 	)
 	cmd.Flags().StringVarP(
@@ -81,14 +92,14 @@ func NewRootCommand(
 		&rootOps.GenerateOptions.DryRun,
 		"dry-run",
 		"d",
-		false,
+		conf.Generate.DryRun,
 		"🧪 generate phrases without saving to the history",
 	)
 	cmd.Flags().StringVarP(
 		&rootOps.GenerateOptions.Format,
 		"format",
 		"f",
-		"table",
+		conf.Generate.Format,
 		"📝 format of the output (default \"table\", e.g. : \"plain\")",
 	)
 	cmd.Flags().BoolVarP(
@@ -102,15 +113,59 @@ func NewRootCommand(
 		&rootOps.GenerateOptions.Timeout,
 		"timeout",
 		"t",
-		30,
+		conf.Generate.Timeout,
 		"⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)",
 	)
+	cmd.Flags().StringVarP(
+		&rootOps.GenerateOptions.Template,
+		"template",
+		"T",
+		"",
+		"🧩 template of phrases to generate (e.g. : \"{a}{n}の{n}\")",
+	)
+	cmd.Flags().BoolVarP(
+		&rootOps.GenerateOptions.Raw,
+		"raw",
+		"r",
+		false,
+		"🪨 generate phrases without conjugating adjectives and verbs",
+	)
+	cmd.Flags().Int64VarP(
+		&rootOps.GenerateOptions.Seed,
+		"seed",
+		"",
+		0,
+		"🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)",
+	)
+	cmd.Flags().StringVarP(
+		&rootOps.GenerateOptions.OutputTemplate,
+		"output-template",
+		"",
+		"",
+		"🖨️ Go template of the output for the template format",
+	)
+	cmd.Flags().StringVarP(
+		&rootOps.GenerateOptions.OutputTemplateFile,
+		"output-template-file",
+		"",
+		"",
+		"🖨️ file of the template of the output for the template format",
+	)
+	cmd.Flags().BoolVarP(
+		&rootOps.GenerateOptions.Unique,
+		"unique",
+		"",
+		false,
+		"🦄 generate only phrases not duplicated and not in the history",
+	)
 	interactiveCmd := generate.NewInteractiveCommand(
 		cobra,
+		conf,
 		output,
 	)
 	generateCmd := generate.NewGenerateCommand(
 		cobra,
+		conf,
 		interactiveCmd,
 		output,
 	)
@@ -124,11 +179,25 @@ func NewRootCommand(
 			cobra,
 			output,
 		),
+		configCommand.NewConfigCommand(
+			cobra,
+			conf,
+			configurator,
+			output,
+		),
+		db.NewDbCommand(
+			cobra,
+			output,
+		),
 		jrp.NewDownloadCommand(
 			cobra,
 			conf,
 			output,
 		),
+		jrp.NewExplainCommand(
+			cobra,
+			output,
+		),
 		jrp.NewFavoriteCommand(
 			cobra,
 			output,
@@ -139,6 +208,18 @@ func NewRootCommand(
 			output,
 		),
 		interactiveCmd,
+		jrp.NewNoteCommand(
+			cobra,
+			output,
+		),
+		tag.NewTagCommand(
+			cobra,
+			output,
+		),
+		tui.NewTuiCommand(
+			cobra,
+			output,
+		),
 		jrp.NewUnfavoriteCommand(
 			cobra,
 			output,
@@ -181,6 +262,27 @@ If both are provided, the larger number takes precedence.
 And you can specify the prefix or suffix of the phrases to generate
 by the flag "-p" or "--prefix" and "-s" or "--suffix".
 
+Also, you can specify the template of the phrases to generate by the flag "-T" or "--template".
+The slots "{a}", "{v}" and "{n}" in the template are filled with a random adjective, verb and noun.
+(e.g. : "{a}{n}の{n}", "{v}{n}")
+
+The adjectives and verbs modifying the nouns are conjugated into the attributive form (e.g. : "静か" -> "静かな").
+You can generate phrases without the conjugation by the flag "-r" or "--raw".
+
+And you can generate the same phrases reproducibly by specifying the same seed by the flag "--seed".
+
+With the flag "--unique", the phrases duplicated or already in the history are not generated.
+If the words can not generate enough unique phrases, an error is shown.
+
+You can specify the format of the output by the flag "-f" or "--format".
+"table", "plain", "json", "ndjson", "csv", "tsv" and "template" are available.
+With the format "template", you can shape the output by the Go template
+specified by the flag "--output-template" or the file specified by the flag "--output-template-file".
+The fields ".ID", ".Phrase", ".Reading", ".Romaji", ".WordIDs", ".Prefix", ".Suffix", ".IsFavorited", ".CreatedAt" and ".UpdatedAt",
+and the functions "blue", "green", "red", "yellow", "formatTime" and "localTime" are available.
+
+The defaults of the flags "-n", "-d", "-f" and "-t" can be changed by the config file. (See "jrp config --help".)
+
 Those commands below are the same.
   "jrp" : "jrp generate"
   "jrp interactive" : "jrp --interactive" : "jrp generate interactive" : "jrp generate --interactive"
@@ -197,23 +299,35 @@ Available Subcommands:
   generate,    gen,  g  ✨ Generate Japanese random phrases.
                            You can abbreviate "generate" sub command. ("jrp" and "jrp generate" are the same.)
   interactive, int,  i  💬 Generate Japanese random phrases interactively.
+  tui                   🖥️ Curate Japanese random phrases in the full-screen TUI.
   history,     hist, h  📜 Manage the histories of the "generate" command.
   favorite,    fav,  f  ⭐ Favorite the histories of the "generate" command.
   unfavorite,  unf,  u  ❌ Unfavorite the favorited histories of the "generate" command.
+  tag,         tg,   t  🏷️ Manage the tags of the histories of the "generate" command.
+  note,        nt,   n  📝 Write a note on the history of the "generate" command.
+  explain,     exp,  e  📖 Explain the history of the "generate" command with the source words.
+  db                    🗄️ Manage the schema of the jrp database.
+  config,      conf, C  ⚙️ Manage the config file of jrp.
   completion   comp, c  🔧 Generate the autocompletion script for the specified shell.
   version      ver,  v  🔖 Show the version of jrp.
   help                  🤝 Help for jrp.
 
 Flags:
-  -n, --number       🔢 number of phrases to generate (default 1, e.g. : 10)
-  -p, --prefix       🔡 prefix of phrases to generate
-  -s, --suffix       🔡 suffix of phrases to generate
-  -d, --dry-run      🧪 generate phrases without saving as the histories
-  -f, --format       📝 format of the output (default "table", e.g. : "plain")
-  -i, --interactive  💬 generate Japanese random phrases interactively
-  -t, --timeout      ⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)
-  -h, --help         🤝 help for jrp
-  -v, --version      🔖 version for jrp
+  -n, --number                🔢 number of phrases to generate (default 1, e.g. : 10)
+  -p, --prefix                🔡 prefix of phrases to generate
+  -s, --suffix                🔡 suffix of phrases to generate
+  -d, --dry-run               🧪 generate phrases without saving as the histories
+  -f, --format                📝 format of the output (default "table", e.g. : "plain")
+      --output-template       🖨️ Go template of the output for the template format
+      --output-template-file  🖨️ file of the template of the output for the template format
+  -i, --interactive           💬 generate Japanese random phrases interactively
+  -t, --timeout               ⌛ timeout in seconds for the interactive mode (default 30, e.g. : 10)
+  -T, --template              🧩 template of phrases to generate (e.g. : "{a}{n}の{n}")
+  -r, --raw                   🪨 generate phrases without conjugating adjectives and verbs
+      --seed                  🌱 seed to generate the same phrases reproducibly (default 0 : random, e.g. : 42)
+      --unique                🦄 generate only phrases not duplicated and not in the history
+  -h, --help                  🤝 help for jrp
+  -v, --version               🔖 version for jrp
 
 Argument:
   number  🔢 number of phrases to generate (e.g. : 10)
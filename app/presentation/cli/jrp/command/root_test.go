@@ -16,6 +16,7 @@ import (
 	"github.com/yanosea/jrp/v2/app/presentation/cli/jrp/config"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
 )
 
 func TestNewRootCommand(t *testing.T) {
@@ -25,10 +26,11 @@ func TestNewRootCommand(t *testing.T) {
 	}
 
 	type args struct {
-		cobra   proxy.Cobra
-		version string
-		conf    *config.JrpCliConfig
-		output  *string
+		cobra        proxy.Cobra
+		version      string
+		conf         *config.JrpCliConfig
+		configurator config.JrpCliConfigurator
+		output       *string
 	}
 	tests := []struct {
 		name  string
@@ -46,9 +48,19 @@ func TestNewRootCommand(t *testing.T) {
 						WNJpnDBType: "sqlite",
 						WNJpnDBDsn:  filepath.Join(os.TempDir(), "wnjpn.db"),
 					},
-					JrpDBType: "sqlite",
-					JrpDBDsn:  filepath.Join(os.TempDir(), "jrp.db"),
+					JrpDBType:   "sqlite",
+					JrpDBDsn:    filepath.Join(os.TempDir(), "jrp.db"),
+					Generate:    config.DefaultGenerateConfig(),
+					Keybindings: config.DefaultKeybindings(),
 				},
+				configurator: config.NewJrpCliConfigurator(
+					proxy.NewEnvconfig(),
+					utility.NewFileUtil(
+						proxy.NewGzip(),
+						proxy.NewIo(),
+						proxy.NewOs(),
+					),
+				),
 				output: &output,
 			},
 			setup: func() {
@@ -61,7 +73,7 @@ func TestNewRootCommand(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := NewRootCommand(tt.args.cobra, tt.args.version, tt.args.conf, tt.args.output)
+			got := NewRootCommand(tt.args.cobra, tt.args.version, tt.args.conf, tt.args.configurator, tt.args.output)
 			if got == nil {
 				t.Errorf("NewRootCommand() = %v, want not nil", got)
 			} else {
@@ -95,7 +107,9 @@ func Test_runRoot(t *testing.T) {
 				cmdArgs: []string{},
 				generateCmd: generate.NewGenerateCommand(
 					proxy.NewCobra(),
+					&config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 					generate.NewInteractiveCommand(proxy.NewCobra(),
+						&config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 						&output,
 					),
 					&output,
@@ -124,7 +138,9 @@ func Test_runRoot(t *testing.T) {
 				cmdArgs: []string{},
 				generateCmd: generate.NewGenerateCommand(
 					proxy.NewCobra(),
+					&config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 					generate.NewInteractiveCommand(proxy.NewCobra(),
+						&config.JrpCliConfig{Generate: config.DefaultGenerateConfig(), Keybindings: config.DefaultKeybindings()},
 						&output,
 					),
 					&output,
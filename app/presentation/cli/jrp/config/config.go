@@ -1,6 +1,7 @@
 package config
 
 import (
+	"cmp"
 	"path/filepath"
 	"strings"
 
@@ -14,6 +15,8 @@ import (
 // JrpCliConfigurator is an interface that gets the configuration of the Jrp cli application.
 type JrpCliConfigurator interface {
 	GetConfig() (*JrpCliConfig, error)
+	GetConfigFilePath() (string, error)
+	SetConfigFileValue(key string, value string) error
 }
 
 // cliConfigurator is a struct that implements the JrpCliConfigurator interface.
@@ -37,32 +40,51 @@ func NewJrpCliConfigurator(
 // JrpCliConfig is a struct that contains the configuration of the Jrp cli application.
 type JrpCliConfig struct {
 	baseConfig.JrpConfig
-	JrpDBType database.DBType
-	JrpDBDsn  string
+	JrpDBType   database.DBType
+	JrpDBDsn    string
+	Generate    GenerateConfig
+	Keybindings Keybindings
 }
 
 // envConfig is a struct that contains the environment variables.
 type envConfig struct {
-	JrpDBType   database.DBType `envconfig:"JRP_DB_TYPE" default:"sqlite"`
-	JrpDBDsn    string          `envconfig:"JRP_DB" default:"XDG_DATA_HOME/jrp/jrp.db"`
-	WnJpnDBType database.DBType `envconfig:"JRP_WNJPN_DB_TYPE" default:"sqlite"`
-	WnJpnDBDsn  string          `envconfig:"JRP_WNJPN_DB" default:"XDG_DATA_HOME/jrp/wnjpn.db"`
+	JrpDBType   database.DBType `envconfig:"JRP_DB_TYPE"`
+	JrpDBDsn    string          `envconfig:"JRP_DB"`
+	WnJpnDBType database.DBType `envconfig:"JRP_WNJPN_DB_TYPE"`
+	WnJpnDBDsn  string          `envconfig:"JRP_WNJPN_DB"`
 }
 
 // GetConfig gets the configuration of the Jrp cli application.
+// the environment variables take precedence over the config file, and the config file takes precedence over the defaults.
 func (c *cliConfigurator) GetConfig() (*JrpCliConfig, error) {
 	var env envConfig
 	if err := c.Envconfig.Process("", &env); err != nil {
 		return nil, err
 	}
 
+	var file FileConfig
+	if err := c.ReadConfigFile(&file); err != nil {
+		return nil, err
+	}
+	if err := file.Validate(); err != nil {
+		return nil, err
+	}
+
+	defaultGenerate := DefaultGenerateConfig()
 	config := &JrpCliConfig{
 		JrpConfig: baseConfig.JrpConfig{
-			WNJpnDBType: env.WnJpnDBType,
-			WNJpnDBDsn:  env.WnJpnDBDsn,
+			WNJpnDBType: cmp.Or(env.WnJpnDBType, file.WNJpnDB.Type, database.SQLite),
+			WNJpnDBDsn:  cmp.Or(env.WnJpnDBDsn, file.WNJpnDB.Dsn, defaultWNJpnDBDsn),
+		},
+		JrpDBType: cmp.Or(env.JrpDBType, file.JrpDB.Type, database.SQLite),
+		JrpDBDsn:  cmp.Or(env.JrpDBDsn, file.JrpDB.Dsn, defaultJrpDBDsn),
+		Generate: GenerateConfig{
+			Number:  cmp.Or(file.Generate.Number, defaultGenerate.Number),
+			Format:  cmp.Or(file.Generate.Format, defaultGenerate.Format),
+			DryRun:  cmp.Or(file.Generate.DryRun, defaultGenerate.DryRun),
+			Timeout: cmp.Or(file.Generate.Timeout, defaultGenerate.Timeout),
 		},
-		JrpDBType: env.JrpDBType,
-		JrpDBDsn:  env.JrpDBDsn,
+		Keybindings: file.Interactive.keybindings(),
 	}
 
 	if config.JrpDBType == database.SQLite || config.WNJpnDBType == database.SQLite {
@@ -102,3 +124,16 @@ func (c *cliConfigurator) GetConfig() (*JrpCliConfig, error) {
 
 	return config, nil
 }
+
+// SetConfigFileValue sets the value to the key of the config file and writes it.
+func (c *cliConfigurator) SetConfigFileValue(key string, value string) error {
+	var file FileConfig
+	if err := c.ReadConfigFile(&file); err != nil {
+		return err
+	}
+	if err := file.Set(key, value); err != nil {
+		return err
+	}
+
+	return c.WriteConfigFile(&file)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./app/presentation/cli/jrp/config/config.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./app/presentation/cli/jrp/config/config.go -destination=./app/presentation/cli/jrp/config/config_mock.go -package=config
+//
+
+// Package config is a generated GoMock package.
+package config
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJrpCliConfigurator is a mock of JrpCliConfigurator interface.
+type MockJrpCliConfigurator struct {
+	ctrl     *gomock.Controller
+	recorder *MockJrpCliConfiguratorMockRecorder
+	isgomock struct{}
+}
+
+// MockJrpCliConfiguratorMockRecorder is the mock recorder for MockJrpCliConfigurator.
+type MockJrpCliConfiguratorMockRecorder struct {
+	mock *MockJrpCliConfigurator
+}
+
+// NewMockJrpCliConfigurator creates a new mock instance.
+func NewMockJrpCliConfigurator(ctrl *gomock.Controller) *MockJrpCliConfigurator {
+	mock := &MockJrpCliConfigurator{ctrl: ctrl}
+	mock.recorder = &MockJrpCliConfiguratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJrpCliConfigurator) EXPECT() *MockJrpCliConfiguratorMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockJrpCliConfigurator) GetConfig() (*JrpCliConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*JrpCliConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockJrpCliConfiguratorMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockJrpCliConfigurator)(nil).GetConfig))
+}
+
+// GetConfigFilePath mocks base method.
+func (m *MockJrpCliConfigurator) GetConfigFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigFilePath indicates an expected call of GetConfigFilePath.
+func (mr *MockJrpCliConfiguratorMockRecorder) GetConfigFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigFilePath", reflect.TypeOf((*MockJrpCliConfigurator)(nil).GetConfigFilePath))
+}
+
+// SetConfigFileValue mocks base method.
+func (m *MockJrpCliConfigurator) SetConfigFileValue(key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfigFileValue", key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetConfigFileValue indicates an expected call of SetConfigFileValue.
+func (mr *MockJrpCliConfiguratorMockRecorder) SetConfigFileValue(key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfigFileValue", reflect.TypeOf((*MockJrpCliConfigurator)(nil).SetConfigFileValue), key, value)
+}
@@ -71,6 +71,46 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
 					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			want: &JrpCliConfig{
+				JrpConfig: baseConfig.JrpConfig{
+					WNJpnDBType: database.SQLite,
+					WNJpnDBDsn:  "~/.local/share/jrp/wnjpn.db",
+				},
+				JrpDBType:   database.SQLite,
+				JrpDBDsn:    "~/.local/share/jrp/jrp.db",
+				Generate:    DefaultGenerateConfig(),
+				Keybindings: DefaultKeybindings(),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.JrpDBType = database.SQLite
+						cfg.WnJpnDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
+						cfg.WnJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
+						return nil
+					})
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
+				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the config file exists)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
 				}},
 			want: &JrpCliConfig{
 				JrpConfig: baseConfig.JrpConfig{
@@ -79,6 +119,18 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				},
 				JrpDBType: database.SQLite,
 				JrpDBDsn:  "~/.local/share/jrp/jrp.db",
+				Generate:  DefaultGenerateConfig(),
+				Keybindings: Keybindings{
+					ActionFavoriteContinue: "g",
+					ActionFavoriteExit:     "c",
+					ActionSaveContinue:     "t",
+					ActionSaveExit:         "n",
+					ActionSkipContinue:     "m",
+					ActionRerollPrefix:     "p",
+					ActionRerollSuffix:     "s",
+					ActionLockPrefix:       "h",
+					ActionLockSuffix:       "l",
+				},
 			},
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields) {
@@ -92,6 +144,100 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 						return nil
 					})
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`interactive:
+  keybindings:
+    favorite_continue: g
+    favorite_exit: c
+    save_continue: t
+    save_exit: n
+`), nil)
+				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the environment variables are not set and the config file exists)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			want: &JrpCliConfig{
+				JrpConfig: baseConfig.JrpConfig{
+					WNJpnDBType: database.SQLite,
+					WNJpnDBDsn:  "~/.local/share/jrp/wnjpn.db",
+				},
+				JrpDBType: database.PostgreSQL,
+				JrpDBDsn:  "postgres://localhost:5432/jrp",
+				Generate: GenerateConfig{
+					Number:  5,
+					Format:  "plain",
+					DryRun:  true,
+					Timeout: 30,
+				},
+				Keybindings: DefaultKeybindings(),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).Return(nil)
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`jrp_db:
+  type: postgres
+  dsn: postgres://localhost:5432/jrp
+generate:
+  number: 5
+  format: plain
+  dry_run: true
+`), nil)
+				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the environment variables take precedence over the config file)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			want: &JrpCliConfig{
+				JrpConfig: baseConfig.JrpConfig{
+					WNJpnDBType: database.SQLite,
+					WNJpnDBDsn:  "~/.local/share/jrp/wnjpn.db",
+				},
+				JrpDBType:   database.SQLite,
+				JrpDBDsn:    "~/.local/share/jrp/env.db",
+				Generate:    DefaultGenerateConfig(),
+				Keybindings: DefaultKeybindings(),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.JrpDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/env.db"
+						return nil
+					})
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`jrp_db:
+  type: postgres
+  dsn: postgres://localhost:5432/jrp
+`), nil)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
 				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
 				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
@@ -99,12 +245,70 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				tt.BaseConfigurator.FileUtil = mockFileUtil
 			},
 		},
+		{
+			name: "negative testing (c.ReadConfigFile(&file) failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.JrpDBType = database.SQLite
+						cfg.WnJpnDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
+						cfg.WnJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
+						return nil
+					})
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("", errors.New("FileUtil.GetXDGConfigHome() failed"))
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (file.Validate() failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.JrpDBType = database.SQLite
+						cfg.WnJpnDBType = database.SQLite
+						cfg.JrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
+						cfg.WnJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
+						return nil
+					})
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`interactive:
+  keybindings:
+    save_exit: j
+`), nil)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
 		{
 			name: "negative testing (c.Envconfig.Process(\"\", &config) failed)",
 			fields: fields{
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
 					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
 				}},
 			want:    nil,
 			wantErr: true,
@@ -120,6 +324,7 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
 					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
 				}},
 			want:    nil,
 			wantErr: true,
@@ -134,6 +339,8 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 						return nil
 					})
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("", errors.New("FileUtil.GetXDGDataHome() failed"))
 				tt.BaseConfigurator.Envconfig = mockEnvconfig
 				tt.BaseConfigurator.FileUtil = mockFileUtil
@@ -145,6 +352,7 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
 					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
 				}},
 			want:    nil,
 			wantErr: true,
@@ -159,6 +367,8 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 						return nil
 					})
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
 				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(errors.New("FileUtil.MkdirIfNotExist() failed"))
 				tt.BaseConfigurator.Envconfig = mockEnvconfig
@@ -171,6 +381,7 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
 					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
 				},
 			},
 			want:    nil,
@@ -186,6 +397,8 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 						return nil
 					})
 				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
 				mockFileUtil.EXPECT().GetXDGDataHome().Return("~/.local/share", nil)
 				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(nil)
 				mockFileUtil.EXPECT().MkdirIfNotExist("~/.local/share/jrp").Return(errors.New("FileUtil.MkdirIfNotExist() failed"))
@@ -215,3 +428,185 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_cliConfigurator_SetConfigFileValue(t *testing.T) {
+	type fields struct {
+		BaseConfigurator *baseConfig.BaseConfigurator
+	}
+	type args struct {
+		key   string
+		value string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (the config file does not exist)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "generate.number",
+				value: "3",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil).Times(2)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockFileUtil.EXPECT().WriteFile("~/.config/jrp/config.yaml", []byte(`generate:
+    number: 3
+`)).Return(nil)
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the config file exists)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "interactive.keybindings.save_exit",
+				value: "n",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil).Times(2)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`generate:
+  number: 3
+`), nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockFileUtil.EXPECT().WriteFile("~/.config/jrp/config.yaml", []byte(`generate:
+    number: 3
+interactive:
+    keybindings:
+        save_exit: "n"
+`)).Return(nil)
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "positive testing (the other keys of the config file are broken)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "generate.format",
+				value: "json",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil).Times(2)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(true)
+				mockFileUtil.EXPECT().ReadFile("~/.config/jrp/config.yaml").Return([]byte(`generate:
+  format: xml
+  timeout: -1
+interactive:
+  keybindings:
+    save_exit: j
+`), nil)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(nil)
+				mockFileUtil.EXPECT().WriteFile("~/.config/jrp/config.yaml", []byte(`generate:
+    format: json
+    timeout: -1
+interactive:
+    keybindings:
+        save_exit: j
+`)).Return(nil)
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.ReadConfigFile(&file) failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "generate.number",
+				value: "3",
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("", errors.New("FileUtil.GetXDGConfigHome() failed"))
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (file.Set(key, value) failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "generate.number",
+				value: "three",
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (c.WriteConfigFile(&file) failed)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+					FileUtil:  nil,
+					Yaml:      proxy.NewYaml(),
+				}},
+			args: args{
+				key:   "generate.number",
+				value: "3",
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().GetXDGConfigHome().Return("~/.config", nil).Times(2)
+				mockFileUtil.EXPECT().IsExist("~/.config/jrp/config.yaml").Return(false)
+				mockFileUtil.EXPECT().MkdirIfNotExist("~/.config/jrp").Return(errors.New("FileUtil.MkdirIfNotExist() failed"))
+				tt.BaseConfigurator.FileUtil = mockFileUtil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			c := &cliConfigurator{
+				BaseConfigurator: tt.fields.BaseConfigurator,
+			}
+			if err := c.SetConfigFileValue(tt.args.key, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("cliConfigurator.SetConfigFileValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
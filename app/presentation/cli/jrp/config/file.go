@@ -0,0 +1,228 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	baseConfig "github.com/yanosea/jrp/v2/app/config"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+)
+
+const (
+	// defaultJrpDBDsn is the default dsn of the jrp database.
+	defaultJrpDBDsn = "XDG_DATA_HOME/jrp/jrp.db"
+	// defaultWNJpnDBDsn is the default dsn of the WordNet Japan database.
+	defaultWNJpnDBDsn = "XDG_DATA_HOME/jrp/wnjpn.db"
+	// keybindingsKeyPrefix is the prefix of the keys of the config file to bind the keys to the actions of the interactive mode.
+	keybindingsKeyPrefix = "interactive.keybindings."
+)
+
+var (
+	// dbTypes are the types of the databases which can be set in the config file.
+	dbTypes = []database.DBType{
+		database.SQLite,
+		database.PostgreSQL,
+		database.MySQL,
+	}
+	// generateFormats are the formats of the output which can be set as the default of generating the phrases.
+	generateFormats = []string{
+		"table",
+		"plain",
+		"json",
+		"ndjson",
+		"csv",
+		"tsv",
+	}
+)
+
+// FileConfig is a struct that contains the configuration in the config file.
+type FileConfig struct {
+	JrpDB       baseConfig.DBFileConfig `yaml:"jrp_db,omitempty"`
+	WNJpnDB     baseConfig.DBFileConfig `yaml:"wnjpn_db,omitempty"`
+	Generate    GenerateConfig          `yaml:"generate,omitempty"`
+	Interactive InteractiveConfig       `yaml:"interactive,omitempty"`
+}
+
+// GenerateConfig is a struct that contains the default options of generating the phrases.
+type GenerateConfig struct {
+	Number  int    `yaml:"number,omitempty"`
+	Format  string `yaml:"format,omitempty"`
+	DryRun  bool   `yaml:"dry_run,omitempty"`
+	Timeout int    `yaml:"timeout,omitempty"`
+}
+
+// InteractiveConfig is a struct that contains the configuration of the interactive mode.
+type InteractiveConfig struct {
+	Keybindings Keybindings `yaml:"keybindings,omitempty"`
+}
+
+// DefaultGenerateConfig returns the default options of generating the phrases.
+func DefaultGenerateConfig() GenerateConfig {
+	return GenerateConfig{
+		Number:  1,
+		Format:  "table",
+		DryRun:  false,
+		Timeout: 30,
+	}
+}
+
+// NewFileConfig returns the config file representation of the configuration.
+func NewFileConfig(conf *JrpCliConfig) *FileConfig {
+	return &FileConfig{
+		JrpDB: baseConfig.DBFileConfig{
+			Type: conf.JrpDBType,
+			Dsn:  conf.JrpDBDsn,
+		},
+		WNJpnDB: baseConfig.DBFileConfig{
+			Type: conf.WNJpnDBType,
+			Dsn:  conf.WNJpnDBDsn,
+		},
+		Generate: conf.Generate,
+		Interactive: InteractiveConfig{
+			Keybindings: conf.Keybindings,
+		},
+	}
+}
+
+// Set sets the value to the key of the config file.
+// it unsets the key to use the default if the value is empty.
+// only the value of the key is validated, so the other keys can be broken to be repaired one by one.
+func (f *FileConfig) Set(key string, value string) error {
+	switch key {
+	case "jrp_db.type":
+		f.JrpDB.Type = database.DBType(value)
+	case "jrp_db.dsn":
+		f.JrpDB.Dsn = value
+	case "wnjpn_db.type":
+		f.WNJpnDB.Type = database.DBType(value)
+	case "wnjpn_db.dsn":
+		f.WNJpnDB.Dsn = value
+	case "generate.number":
+		number, err := parseInt(key, value)
+		if err != nil {
+			return err
+		}
+		f.Generate.Number = number
+	case "generate.format":
+		f.Generate.Format = value
+	case "generate.dry_run":
+		dryRun, err := parseBool(key, value)
+		if err != nil {
+			return err
+		}
+		f.Generate.DryRun = dryRun
+	case "generate.timeout":
+		timeout, err := parseInt(key, value)
+		if err != nil {
+			return err
+		}
+		f.Generate.Timeout = timeout
+	default:
+		action, ok := strings.CutPrefix(key, keybindingsKeyPrefix)
+		known := slices.Contains(InteractiveActions, action)
+		// the unknown action can only be unset to repair the config file.
+		if !ok || (!known && value != "") {
+			return fmt.Errorf("the key \"%s\" of the config file is unknown", key)
+		}
+		if value == "" {
+			delete(f.Interactive.Keybindings, action)
+			if !known {
+				return nil
+			}
+			break
+		}
+		if f.Interactive.Keybindings == nil {
+			f.Interactive.Keybindings = Keybindings{}
+		}
+		f.Interactive.Keybindings[action] = value
+	}
+
+	return f.validate(key)
+}
+
+// Validate validates the values of the config file.
+func (f *FileConfig) Validate() error {
+	for _, key := range []string{
+		"jrp_db.type",
+		"wnjpn_db.type",
+		"generate.number",
+		"generate.format",
+		"generate.timeout",
+	} {
+		if err := f.validate(key); err != nil {
+			return err
+		}
+	}
+
+	return f.Interactive.keybindings().Validate()
+}
+
+// validate validates the value of the key of the config file.
+func (f *FileConfig) validate(key string) error {
+	switch key {
+	case "jrp_db.type":
+		if f.JrpDB.Type != "" && !slices.Contains(dbTypes, f.JrpDB.Type) {
+			return fmt.Errorf("the value \"%s\" of the key \"jrp_db.type\" must be one of %v", f.JrpDB.Type, dbTypes)
+		}
+	case "wnjpn_db.type":
+		if f.WNJpnDB.Type != "" && !slices.Contains(dbTypes, f.WNJpnDB.Type) {
+			return fmt.Errorf("the value \"%s\" of the key \"wnjpn_db.type\" must be one of %v", f.WNJpnDB.Type, dbTypes)
+		}
+	case "generate.number":
+		if f.Generate.Number < 0 {
+			return fmt.Errorf("the value \"%d\" of the key \"generate.number\" must be positive", f.Generate.Number)
+		}
+	case "generate.format":
+		if f.Generate.Format != "" && !slices.Contains(generateFormats, f.Generate.Format) {
+			return fmt.Errorf("the value \"%s\" of the key \"generate.format\" must be one of %v", f.Generate.Format, generateFormats)
+		}
+	case "generate.timeout":
+		if f.Generate.Timeout < 0 {
+			return fmt.Errorf("the value \"%d\" of the key \"generate.timeout\" must be positive", f.Generate.Timeout)
+		}
+	default:
+		if action, ok := strings.CutPrefix(key, keybindingsKeyPrefix); ok {
+			return f.Interactive.keybindings().validateAction(action)
+		}
+	}
+
+	return nil
+}
+
+// keybindings returns the default keybindings overridden by the keybindings in the config file.
+func (i InteractiveConfig) keybindings() Keybindings {
+	keybindings := DefaultKeybindings()
+	for action, key := range i.Keybindings {
+		keybindings[action] = key
+	}
+
+	return keybindings
+}
+
+// parseInt parses the value of the key of the config file as an integer.
+func parseInt(key string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("the value \"%s\" of the key \"%s\" must be an integer", value, key)
+	}
+
+	return i, nil
+}
+
+// parseBool parses the value of the key of the config file as a boolean.
+func parseBool(key string, value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("the value \"%s\" of the key \"%s\" must be a boolean", value, key)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,545 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	baseConfig "github.com/yanosea/jrp/v2/app/config"
+	"github.com/yanosea/jrp/v2/app/infrastructure/database"
+)
+
+func TestDefaultGenerateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		want GenerateConfig
+	}{
+		{
+			name: "positive testing",
+			want: GenerateConfig{
+				Number:  1,
+				Format:  "table",
+				DryRun:  false,
+				Timeout: 30,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultGenerateConfig(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DefaultGenerateConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFileConfig(t *testing.T) {
+	type args struct {
+		conf *JrpCliConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want *FileConfig
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				conf: &JrpCliConfig{
+					JrpConfig: baseConfig.JrpConfig{
+						WNJpnDBType: database.SQLite,
+						WNJpnDBDsn:  "~/.local/share/jrp/wnjpn.db",
+					},
+					JrpDBType:   database.SQLite,
+					JrpDBDsn:    "~/.local/share/jrp/jrp.db",
+					Generate:    DefaultGenerateConfig(),
+					Keybindings: DefaultKeybindings(),
+				},
+			},
+			want: &FileConfig{
+				JrpDB: baseConfig.DBFileConfig{
+					Type: database.SQLite,
+					Dsn:  "~/.local/share/jrp/jrp.db",
+				},
+				WNJpnDB: baseConfig.DBFileConfig{
+					Type: database.SQLite,
+					Dsn:  "~/.local/share/jrp/wnjpn.db",
+				},
+				Generate: DefaultGenerateConfig(),
+				Interactive: InteractiveConfig{
+					Keybindings: DefaultKeybindings(),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFileConfig(tt.args.conf); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFileConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileConfig_Set(t *testing.T) {
+	type args struct {
+		key   string
+		value string
+	}
+	tests := []struct {
+		name    string
+		f       *FileConfig
+		args    args
+		want    *FileConfig
+		wantErr bool
+	}{
+		{
+			name: "positive testing (jrp_db.type)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "jrp_db.type",
+				value: "postgres",
+			},
+			want: &FileConfig{
+				JrpDB: baseConfig.DBFileConfig{
+					Type: database.PostgreSQL,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (jrp_db.dsn)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "jrp_db.dsn",
+				value: "XDG_DATA_HOME/jrp/team.db",
+			},
+			want: &FileConfig{
+				JrpDB: baseConfig.DBFileConfig{
+					Dsn: "XDG_DATA_HOME/jrp/team.db",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (wnjpn_db.type)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "wnjpn_db.type",
+				value: "sqlite",
+			},
+			want: &FileConfig{
+				WNJpnDB: baseConfig.DBFileConfig{
+					Type: database.SQLite,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (wnjpn_db.dsn)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "wnjpn_db.dsn",
+				value: "/opt/jrp/wnjpn.db",
+			},
+			want: &FileConfig{
+				WNJpnDB: baseConfig.DBFileConfig{
+					Dsn: "/opt/jrp/wnjpn.db",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (generate.number)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.number",
+				value: "10",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Number: 10,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (generate.format)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.format",
+				value: "json",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "json",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (generate.dry_run)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.dry_run",
+				value: "true",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					DryRun: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (generate.timeout)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.timeout",
+				value: "60",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Timeout: 60,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (the value is empty)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Number:  10,
+					Timeout: 60,
+				},
+			},
+			args: args{
+				key:   "generate.number",
+				value: "",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Timeout: 60,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (interactive.keybindings)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "interactive.keybindings.save_exit",
+				value: "n",
+			},
+			want: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "n",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (interactive.keybindings with the empty value)",
+			f: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "n",
+					},
+				},
+			},
+			args: args{
+				key:   "interactive.keybindings.save_exit",
+				value: "",
+			},
+			want: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (the other keys are broken)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "xml",
+				},
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "j",
+					},
+				},
+			},
+			args: args{
+				key:   "generate.number",
+				value: "10",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Number: 10,
+					Format: "xml",
+				},
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "j",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (the broken key is repaired)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "xml",
+				},
+			},
+			args: args{
+				key:   "generate.format",
+				value: "json",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "json",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (the unknown action of the keybindings is unset)",
+			f: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						"unknown": "x",
+					},
+				},
+			},
+			args: args{
+				key:   "interactive.keybindings.unknown",
+				value: "",
+			},
+			want: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (the keybinding conflicts with the other action)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "interactive.keybindings.save_exit",
+				value: "j",
+			},
+			want: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "j",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (the default keybinding of the unset action conflicts with the other action)",
+			f: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveContinue: "k",
+						ActionSaveExit:     "j",
+					},
+				},
+			},
+			args: args{
+				key:   "interactive.keybindings.save_exit",
+				value: "",
+			},
+			want: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveContinue: "k",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (the key is unknown)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "unknown",
+				value: "test",
+			},
+			want:    &FileConfig{},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (the action of the keybindings is unknown)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "interactive.keybindings.unknown",
+				value: "x",
+			},
+			want:    &FileConfig{},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.number is not an integer)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.number",
+				value: "ten",
+			},
+			want:    &FileConfig{},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.dry_run is not a boolean)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.dry_run",
+				value: "yes",
+			},
+			want:    &FileConfig{},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.timeout is not an integer)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.timeout",
+				value: "1m",
+			},
+			want:    &FileConfig{},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (f.Validate() failed)",
+			f:    &FileConfig{},
+			args: args{
+				key:   "generate.format",
+				value: "xml",
+			},
+			want: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "xml",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f.Set(tt.args.key, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("FileConfig.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.f, tt.want) {
+				t.Errorf("FileConfig.Set() = %v, want %v", tt.f, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       *FileConfig
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (the config file is empty)",
+			f:       &FileConfig{},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (all the values are set)",
+			f: &FileConfig{
+				JrpDB: baseConfig.DBFileConfig{
+					Type: database.MySQL,
+					Dsn:  "user:password@tcp(localhost:3306)/jrp",
+				},
+				WNJpnDB: baseConfig.DBFileConfig{
+					Type: database.SQLite,
+					Dsn:  "XDG_DATA_HOME/jrp/wnjpn.db",
+				},
+				Generate: GenerateConfig{
+					Number:  10,
+					Format:  "csv",
+					DryRun:  true,
+					Timeout: 60,
+				},
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "n",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (jrp_db.type is unknown)",
+			f: &FileConfig{
+				JrpDB: baseConfig.DBFileConfig{
+					Type: "oracle",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (wnjpn_db.type is unknown)",
+			f: &FileConfig{
+				WNJpnDB: baseConfig.DBFileConfig{
+					Type: "oracle",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.number is negative)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Number: -1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.format is unknown)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Format: "xml",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (generate.timeout is negative)",
+			f: &FileConfig{
+				Generate: GenerateConfig{
+					Timeout: -1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative testing (the keybindings conflict)",
+			f: &FileConfig{
+				Interactive: InteractiveConfig{
+					Keybindings: Keybindings{
+						ActionSaveExit: "j",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("FileConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
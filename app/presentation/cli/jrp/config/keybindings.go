@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// ActionFavoriteContinue is the action of the interactive mode to favorite the phrases and continue.
+	ActionFavoriteContinue = "favorite_continue"
+	// ActionFavoriteExit is the action of the interactive mode to favorite the phrases and exit.
+	ActionFavoriteExit = "favorite_exit"
+	// ActionSaveContinue is the action of the interactive mode to save the phrases and continue.
+	ActionSaveContinue = "save_continue"
+	// ActionSaveExit is the action of the interactive mode to save the phrases and exit.
+	ActionSaveExit = "save_exit"
+	// ActionSkipContinue is the action of the interactive mode to skip the phrases and continue.
+	ActionSkipContinue = "skip_continue"
+	// ActionRerollPrefix is the action of the interactive mode to re-roll the prefix keeping the suffix.
+	ActionRerollPrefix = "reroll_prefix"
+	// ActionRerollSuffix is the action of the interactive mode to re-roll the suffix keeping the prefix.
+	ActionRerollSuffix = "reroll_suffix"
+	// ActionLockPrefix is the action of the interactive mode to lock or unlock the prefix.
+	ActionLockPrefix = "lock_prefix"
+	// ActionLockSuffix is the action of the interactive mode to lock or unlock the suffix.
+	ActionLockSuffix = "lock_suffix"
+)
+
+var (
+	// InteractiveActions are the actions of the interactive mode which can be bound to the keys in the order shown in the prompt.
+	InteractiveActions = []string{
+		ActionFavoriteContinue,
+		ActionFavoriteExit,
+		ActionSaveContinue,
+		ActionSaveExit,
+		ActionSkipContinue,
+		ActionRerollPrefix,
+		ActionRerollSuffix,
+		ActionLockPrefix,
+		ActionLockSuffix,
+	}
+)
+
+// Keybindings is a map of the actions of the interactive mode to the keys bound to them.
+type Keybindings map[string]string
+
+// DefaultKeybindings returns the default keybindings of the interactive mode.
+func DefaultKeybindings() Keybindings {
+	return Keybindings{
+		ActionFavoriteContinue: "u",
+		ActionFavoriteExit:     "i",
+		ActionSaveContinue:     "j",
+		ActionSaveExit:         "k",
+		ActionSkipContinue:     "m",
+		ActionRerollPrefix:     "p",
+		ActionRerollSuffix:     "s",
+		ActionLockPrefix:       "h",
+		ActionLockSuffix:       "l",
+	}
+}
+
+// Action returns the action bound to the key ignoring the case, or an empty string if no action is bound.
+func (k Keybindings) Action(key string) string {
+	for _, action := range InteractiveActions {
+		if k[action] != "" && strings.EqualFold(k[action], key) {
+			return action
+		}
+	}
+
+	return ""
+}
+
+// Validate validates that the keybindings bind a single character except for the digits to each action without conflicts.
+func (k Keybindings) Validate() error {
+	for action := range k {
+		if !slices.Contains(InteractiveActions, action) {
+			return fmt.Errorf("the action \"%s\" of the keybindings is unknown", action)
+		}
+	}
+
+	for _, action := range InteractiveActions {
+		if err := k.validateAction(action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAction validates that the keybindings bind a single character except for the digits to the action without conflicts with the other actions.
+func (k Keybindings) validateAction(action string) error {
+	key := k[action]
+	if utf8.RuneCountInString(key) != 1 {
+		return fmt.Errorf("the key \"%s\" of the action \"%s\" must be a single character", key, action)
+	}
+	if r, _ := utf8.DecodeRuneInString(key); unicode.IsDigit(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+		return fmt.Errorf("the key \"%s\" of the action \"%s\" is reserved", key, action)
+	}
+	for _, other := range InteractiveActions {
+		if other != action && strings.EqualFold(k[other], key) {
+			return fmt.Errorf("the key \"%s\" of the action \"%s\" conflicts with the action \"%s\"", key, action, other)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultKeybindings(t *testing.T) {
+	tests := []struct {
+		name string
+		want Keybindings
+	}{
+		{
+			name: "positive testing",
+			want: Keybindings{
+				ActionFavoriteContinue: "u",
+				ActionFavoriteExit:     "i",
+				ActionSaveContinue:     "j",
+				ActionSaveExit:         "k",
+				ActionSkipContinue:     "m",
+				ActionRerollPrefix:     "p",
+				ActionRerollSuffix:     "s",
+				ActionLockPrefix:       "h",
+				ActionLockSuffix:       "l",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultKeybindings(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DefaultKeybindings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeybindings_Action(t *testing.T) {
+	type args struct {
+		key string
+	}
+	tests := []struct {
+		name string
+		k    Keybindings
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (the key is bound)",
+			k:    DefaultKeybindings(),
+			args: args{
+				key: "j",
+			},
+			want: ActionSaveContinue,
+		},
+		{
+			name: "positive testing (the key is bound in the upper case)",
+			k:    DefaultKeybindings(),
+			args: args{
+				key: "J",
+			},
+			want: ActionSaveContinue,
+		},
+		{
+			name: "positive testing (the key is not bound)",
+			k:    DefaultKeybindings(),
+			args: args{
+				key: ",",
+			},
+			want: "",
+		},
+		{
+			name: "positive testing (the key is empty)",
+			k:    Keybindings{},
+			args: args{
+				key: "",
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.k.Action(tt.args.key); got != tt.want {
+				t.Errorf("Keybindings.Action() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeybindings_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		k       Keybindings
+		wantErr bool
+		setup   func(k Keybindings)
+	}{
+		{
+			name:    "positive testing (default keybindings)",
+			k:       DefaultKeybindings(),
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "positive testing (the keys are customized)",
+			k:       DefaultKeybindings(),
+			wantErr: false,
+			setup: func(k Keybindings) {
+				k[ActionFavoriteContinue] = "g"
+				k[ActionSaveContinue] = "t"
+				k[ActionSaveExit] = ";"
+			},
+		},
+		{
+			name:    "negative testing (the action is unknown)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k["unknown"] = "x"
+			},
+		},
+		{
+			name:    "negative testing (the key is not bound)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				delete(k, ActionLockSuffix)
+			},
+		},
+		{
+			name:    "negative testing (the key is not a single character)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "kk"
+			},
+		},
+		{
+			name:    "negative testing (the key is a digit)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "1"
+			},
+		},
+		{
+			name:    "negative testing (the key is a space)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = " "
+			},
+		},
+		{
+			name:    "negative testing (the keys conflict)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "j"
+			},
+		},
+		{
+			name:    "negative testing (the keys conflict in the upper case)",
+			k:       DefaultKeybindings(),
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "J"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.k)
+			}
+			if err := tt.k.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Keybindings.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeybindings_validateAction(t *testing.T) {
+	type args struct {
+		action string
+	}
+	tests := []struct {
+		name    string
+		k       Keybindings
+		args    args
+		wantErr bool
+		setup   func(k Keybindings)
+	}{
+		{
+			name: "positive testing",
+			k:    DefaultKeybindings(),
+			args: args{
+				action: ActionSaveExit,
+			},
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name: "positive testing (the other actions conflict)",
+			k:    DefaultKeybindings(),
+			args: args{
+				action: ActionLockSuffix,
+			},
+			wantErr: false,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "j"
+			},
+		},
+		{
+			name: "negative testing (the key is not a single character)",
+			k:    DefaultKeybindings(),
+			args: args{
+				action: ActionSaveExit,
+			},
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "kk"
+			},
+		},
+		{
+			name: "negative testing (the key is a digit)",
+			k:    DefaultKeybindings(),
+			args: args{
+				action: ActionSaveExit,
+			},
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "1"
+			},
+		},
+		{
+			name: "negative testing (the key conflicts with the other action)",
+			k:    DefaultKeybindings(),
+			args: args{
+				action: ActionSaveExit,
+			},
+			wantErr: true,
+			setup: func(k Keybindings) {
+				k[ActionSaveExit] = "J"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.k)
+			}
+			if err := tt.k.validateAction(tt.args.action); (err != nil) != tt.wantErr {
+				t.Errorf("Keybindings.validateAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
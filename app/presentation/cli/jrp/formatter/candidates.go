@@ -0,0 +1,18 @@
+package formatter
+
+import (
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+)
+
+// JrpCandidates is a struct that holds the numbered candidates generated in a phase of the interactive mode.
+type JrpCandidates struct {
+	// Jrps is the output of the GenerateJrp use case numbered from 1.
+	Jrps []*jrpApp.GenerateJrpUseCaseOutputDto
+	// Selected is the flags to indicate whether each candidate is selected.
+	Selected []bool
+}
+
+// isSelected returns whether the candidate of the index is selected.
+func (c *JrpCandidates) isSelected(i int) bool {
+	return i < len(c.Selected) && c.Selected[i]
+}
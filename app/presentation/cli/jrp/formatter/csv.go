@@ -0,0 +1,53 @@
+package formatter
+
+import (
+	"encoding/csv"
+	"slices"
+	"strings"
+)
+
+// CsvFormatter is a struct that formats the output of jrp cli.
+type CsvFormatter struct {
+	comma rune
+}
+
+// NewCsvFormatter returns a new instance of the CsvFormatter struct separating the fields by commas.
+func NewCsvFormatter() *CsvFormatter {
+	return &CsvFormatter{
+		comma: ',',
+	}
+}
+
+// NewTsvFormatter returns a new instance of the CsvFormatter struct separating the fields by tabs.
+func NewTsvFormatter() *CsvFormatter {
+	return &CsvFormatter{
+		comma: '\t',
+	}
+}
+
+// Format formats the output of jrp cli into the header and the rows separated by the comma of the formatter.
+func (f *CsvFormatter) Format(result interface{}) (string, error) {
+	records, ok := toJrpRecords(result)
+	if !ok {
+		return "", errUnsupportedResult
+	}
+
+	withScore := hasScores(records)
+	header := jrpRecordHeader
+	if withScore {
+		header = append(slices.Clone(jrpRecordHeader), jrpRecordScoreHeader)
+	}
+	rows := [][]string{header}
+	for _, record := range records {
+		rows = append(rows, record.toRow(withScore))
+	}
+
+	formatted := &strings.Builder{}
+	w := csv.NewWriter(formatted)
+	w.Comma = f.comma
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(formatted.String(), "\n"), nil
+}
@@ -0,0 +1,207 @@
+package formatter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+)
+
+func TestNewCsvFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		want *CsvFormatter
+	}{
+		{
+			name: "positive testing",
+			want: &CsvFormatter{
+				comma: ',',
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCsvFormatter(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCsvFormatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTsvFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		want *CsvFormatter
+	}{
+		{
+			name: "positive testing",
+			want: &CsvFormatter{
+				comma: '\t',
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewTsvFormatter(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTsvFormatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCsvFormatter_Format(t *testing.T) {
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		result interface{}
+	}
+	tests := []struct {
+		name    string
+		f       *CsvFormatter
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "positive testing (csv)",
+			f:    NewCsvFormatter(),
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:          1,
+						Phrase:      "test,1",
+						Reading:     "てすと",
+						Romaji:      "tesuto",
+						WordIDs:     []int{1, 2},
+						Prefix:      "prefix",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+					},
+				},
+			},
+			want: "id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at\n" +
+				"1,\"test,1\",てすと,tesuto,1 2,prefix,,true,2006-01-02T15:04:05Z,2006-01-02T15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (csv with the timestamps in the sub-seconds)",
+			f:    NewCsvFormatter(),
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:        1,
+						Phrase:    "test",
+						CreatedAt: ti.Add(123456789 * time.Nanosecond),
+						UpdatedAt: ti.Add(123456789 * time.Nanosecond),
+					},
+				},
+			},
+			want: "id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at\n" +
+				"1,test,,,,,,false,2006-01-02T15:04:05.123456789Z,2006-01-02T15:04:05.123456789Z",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (tsv)",
+			f:    NewTsvFormatter(),
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						Phrase:    "test",
+						Suffix:    "suffix",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+				},
+			},
+			want: "id\tphrase\treading\tromaji\tword_ids\tprefix\tsuffix\tis_favorited\tcreated_at\tupdated_at\n" +
+				"0\ttest\t\t\t\t\tsuffix\tfalse\t2006-01-02T15:04:05Z\t2006-01-02T15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto)",
+			f:    NewCsvFormatter(),
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:        2,
+						Phrase:    "test",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+				},
+			},
+			want: "id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at\n" +
+				"2,test,,,,,,false,2006-01-02T15:04:05Z,2006-01-02T15:04:05Z",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto with the scores)",
+			f:    NewTsvFormatter(),
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:        2,
+						Phrase:    "test",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+						Score:     0.75,
+					},
+					{
+						ID:        1,
+						Phrase:    "tset",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+						Score:     0.5,
+					},
+				},
+			},
+			want: "id\tphrase\treading\tromaji\tword_ids\tprefix\tsuffix\tis_favorited\tcreated_at\tupdated_at\tscore\n" +
+				"2\ttest\t\t\t\t\t\tfalse\t2006-01-02T15:04:05Z\t2006-01-02T15:04:05Z\t0.75\n" +
+				"1\ttset\t\t\t\t\t\tfalse\t2006-01-02T15:04:05Z\t2006-01-02T15:04:05Z\t0.5",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is empty)",
+			f:    NewCsvFormatter(),
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{},
+			},
+			want:    "id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at",
+			wantErr: false,
+		},
+		{
+			name: "negative testing (result is not supported)",
+			f:    NewCsvFormatter(),
+			args: args{
+				result: "invalid",
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (w.WriteAll(rows) failed)",
+			f: &CsvFormatter{
+				comma: '"',
+			},
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.Format(tt.args.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CsvFormatter.Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CsvFormatter.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
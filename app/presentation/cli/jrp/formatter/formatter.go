@@ -17,10 +17,18 @@ type NewFormatterFunc func(format string) (Formatter, error)
 var NewFormatter NewFormatterFunc = func(format string) (Formatter, error) {
 	var f Formatter
 	switch format {
+	case "csv":
+		f = NewCsvFormatter()
+	case "json":
+		f = NewJsonFormatter()
+	case "ndjson":
+		f = NewNdjsonFormatter()
 	case "plain":
 		f = NewPlainFormatter()
 	case "table":
 		f = NewTableFormatter()
+	case "tsv":
+		f = NewTsvFormatter()
 	default:
 		return nil, errors.New("invalid format")
 	}
@@ -40,6 +40,38 @@ func TestNewFormatter(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "positive testing (format is json)",
+			args: args{
+				format: "json",
+			},
+			want:    &JsonFormatter{},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is ndjson)",
+			args: args{
+				format: "ndjson",
+			},
+			want:    &NdjsonFormatter{},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is csv)",
+			args: args{
+				format: "csv",
+			},
+			want:    NewCsvFormatter(),
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is tsv)",
+			args: args{
+				format: "tsv",
+			},
+			want:    NewTsvFormatter(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
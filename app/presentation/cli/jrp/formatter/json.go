@@ -0,0 +1,34 @@
+package formatter
+
+import (
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+)
+
+// JsonFormatter is a struct that formats the output of jrp cli.
+type JsonFormatter struct{}
+
+var (
+	// Ju is a variable that contains the JsonUtil struct for injecting dependencies in testing.
+	Ju = utility.NewJsonUtil(proxy.NewJson())
+)
+
+// NewJsonFormatter returns a new instance of the JsonFormatter struct.
+func NewJsonFormatter() *JsonFormatter {
+	return &JsonFormatter{}
+}
+
+// Format formats the output of jrp cli into a JSON array.
+func (f *JsonFormatter) Format(result interface{}) (string, error) {
+	records, ok := toJrpRecords(result)
+	if !ok {
+		return "", errUnsupportedResult
+	}
+
+	formatted, err := Ju.Marshal(records)
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
@@ -0,0 +1,230 @@
+package formatter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewJsonFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		want *JsonFormatter
+	}{
+		{
+			name: "positive testing",
+			want: &JsonFormatter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewJsonFormatter(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewJsonFormatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJsonFormatter_Format(t *testing.T) {
+	origJu := Ju
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		result interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing (result is []*jrpApp.GenerateJrpUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						Phrase:    "test",
+						Reading:   "てすと",
+						Romaji:    "tesuto",
+						WordIDs:   []int{1, 2},
+						Suffix:    "suffix",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+				},
+			},
+			want:    `[{"id":0,"phrase":"test","reading":"てすと","romaji":"tesuto","word_ids":[1,2],"prefix":"","suffix":"suffix","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetHistoryUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:          1,
+						Phrase:      "test",
+						Prefix:      "prefix",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+					},
+				},
+			},
+			want:    `[{"id":1,"phrase":"test","reading":"","romaji":"","word_ids":[],"prefix":"prefix","suffix":"","is_favorited":true,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto)",
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:        2,
+						Phrase:    "test",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+				},
+			},
+			want:    `[{"id":2,"phrase":"test","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto with the score)",
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:        2,
+						Phrase:    "test",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+						Score:     0.75,
+					},
+				},
+			},
+			want:    `[{"id":2,"phrase":"test","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z","score":0.75}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is empty)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{},
+			},
+			want:    `[]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result is not supported)",
+			args: args{
+				result: "invalid",
+			},
+			want:    "",
+			wantErr: true,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (Ju.Marshal(records) failed)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:     1,
+						Phrase: "test",
+					},
+				},
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
+		{
+			name: "positive testing (result is *JrpCandidates)",
+			args: args{
+				result: &JrpCandidates{
+					Jrps: []*jrpApp.GenerateJrpUseCaseOutputDto{
+						{
+							Phrase:    "test",
+							CreatedAt: ti,
+							UpdatedAt: ti,
+						},
+					},
+					Selected: []bool{true},
+				},
+			},
+			want:    `[{"id":0,"phrase":"test","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is *HistoryPage)",
+			args: args{
+				result: &HistoryPage{
+					Histories: []*jrpApp.GetHistoryUseCaseOutputDto{
+						{
+							ID:        2,
+							Phrase:    "test",
+							CreatedAt: ti,
+							UpdatedAt: ti,
+						},
+					},
+					Page:  1,
+					Pages: 2,
+				},
+			},
+			want:    `[{"id":2,"phrase":"test","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			f := &JsonFormatter{}
+			got, err := f.Format(tt.args.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JsonFormatter.Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("JsonFormatter.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
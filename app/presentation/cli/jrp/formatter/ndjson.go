@@ -0,0 +1,32 @@
+package formatter
+
+import (
+	"strings"
+)
+
+// NdjsonFormatter is a struct that formats the output of jrp cli.
+type NdjsonFormatter struct{}
+
+// NewNdjsonFormatter returns a new instance of the NdjsonFormatter struct.
+func NewNdjsonFormatter() *NdjsonFormatter {
+	return &NdjsonFormatter{}
+}
+
+// Format formats the output of jrp cli into JSON objects separated by newlines.
+func (f *NdjsonFormatter) Format(result interface{}) (string, error) {
+	records, ok := toJrpRecords(result)
+	if !ok {
+		return "", errUnsupportedResult
+	}
+
+	lines := make([]string, len(records))
+	for i, record := range records {
+		line, err := Ju.Marshal(record)
+		if err != nil {
+			return "", err
+		}
+		lines[i] = string(line)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,142 @@
+package formatter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewNdjsonFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		want *NdjsonFormatter
+	}{
+		{
+			name: "positive testing",
+			want: &NdjsonFormatter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewNdjsonFormatter(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewNdjsonFormatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNdjsonFormatter_Format(t *testing.T) {
+	origJu := Ju
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		result interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:        1,
+						Phrase:    "test1",
+						WordIDs:   []int{1},
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+					{
+						ID:          2,
+						Phrase:      "test2",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+					},
+				},
+			},
+			want: `{"id":1,"phrase":"test1","reading":"","romaji":"","word_ids":[1],"prefix":"","suffix":"","is_favorited":false,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}` + "\n" +
+				`{"id":2,"phrase":"test2","reading":"","romaji":"","word_ids":[],"prefix":"","suffix":"","is_favorited":true,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}`,
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (result is empty)",
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{},
+			},
+			want:    "",
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (result is not supported)",
+			args: args{
+				result: "invalid",
+			},
+			want:    "",
+			wantErr: true,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (Ju.Marshal(record) failed)",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:     1,
+						Phrase: "test",
+					},
+				},
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Marshal(gomock.Any()).Return(nil, errors.New("JsonUtil.Marshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			f := &NdjsonFormatter{}
+			got, err := f.Format(tt.args.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NdjsonFormatter.Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NdjsonFormatter.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package formatter
+
+// HistoryPage is a struct that holds the histories of a page and the position of the page.
+type HistoryPage struct {
+	// Histories is the output of the GetHistory or SearchHistory use case of the page.
+	Histories interface{}
+	// Page is the number of the page starting from 1.
+	Page int
+	// Pages is the number of all the pages.
+	Pages int
+}
@@ -0,0 +1,221 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+)
+
+// Parser is an interface that parses the input of jrp cli into the histories to save.
+type Parser interface {
+	Parse(data []byte) ([]*jrpApp.SaveHistoryUseCaseInputDto, error)
+}
+
+// NewParserFunc is a function type that defines the signature for creating a new Parser.
+type NewParserFunc func(format string) (Parser, error)
+
+// NewParser is a function that returns a new instance of the Parser interface.
+var NewParser NewParserFunc = func(format string) (Parser, error) {
+	var p Parser
+	switch format {
+	case "csv":
+		p = NewCsvParser()
+	case "json":
+		p = NewJsonParser()
+	case "ndjson":
+		p = NewNdjsonParser()
+	case "tsv":
+		p = NewTsvParser()
+	default:
+		return nil, errors.New("invalid format")
+	}
+	return p, nil
+}
+
+// JsonParser is a struct that parses the JSON array formatted by the JsonFormatter.
+type JsonParser struct{}
+
+// NewJsonParser returns a new instance of the JsonParser struct.
+func NewJsonParser() *JsonParser {
+	return &JsonParser{}
+}
+
+// Parse parses the JSON array into the histories to save.
+func (p *JsonParser) Parse(data []byte) ([]*jrpApp.SaveHistoryUseCaseInputDto, error) {
+	var records []*jrpRecord
+	if err := Ju.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	return toSaveHistoryUseCaseInputDtos(records)
+}
+
+// NdjsonParser is a struct that parses the newline delimited JSON formatted by the NdjsonFormatter.
+type NdjsonParser struct{}
+
+// NewNdjsonParser returns a new instance of the NdjsonParser struct.
+func NewNdjsonParser() *NdjsonParser {
+	return &NdjsonParser{}
+}
+
+// Parse parses the newline delimited JSON into the histories to save.
+func (p *NdjsonParser) Parse(data []byte) ([]*jrpApp.SaveHistoryUseCaseInputDto, error) {
+	var records []*jrpRecord
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		record := &jrpRecord{}
+		if err := Ju.Unmarshal(line, record); err != nil {
+			return nil, fmt.Errorf("line %d : %w", i+1, err)
+		}
+		records = append(records, record)
+	}
+
+	return toSaveHistoryUseCaseInputDtos(records)
+}
+
+// CsvParser is a struct that parses the header and the rows formatted by the CsvFormatter.
+type CsvParser struct {
+	comma rune
+}
+
+// NewCsvParser returns a new instance of the CsvParser struct parsing the fields separated by commas.
+func NewCsvParser() *CsvParser {
+	return &CsvParser{
+		comma: ',',
+	}
+}
+
+// NewTsvParser returns a new instance of the CsvParser struct parsing the fields separated by tabs.
+func NewTsvParser() *CsvParser {
+	return &CsvParser{
+		comma: '\t',
+	}
+}
+
+// Parse parses the header and the rows into the histories to save.
+// the columns are looked up by the header, so the columns except "phrase" can be omitted.
+func (p *CsvParser) Parse(data []byte) ([]*jrpApp.SaveHistoryUseCaseInputDto, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = p.comma
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*jrpApp.SaveHistoryUseCaseInputDto{}, nil
+	}
+
+	columns := make(map[string]int)
+	for i, column := range rows[0] {
+		columns[strings.TrimSpace(column)] = i
+	}
+	if _, ok := columns["phrase"]; !ok {
+		return nil, errors.New("the header does not have the column \"phrase\"")
+	}
+
+	var records []*jrpRecord
+	for i, row := range rows[1:] {
+		record, err := newJrpRecordFromRow(columns, row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d : %w", i+2, err)
+		}
+		records = append(records, record)
+	}
+
+	return toSaveHistoryUseCaseInputDtos(records)
+}
+
+// newJrpRecordFromRow returns a new instance of the jrpRecord struct from the row of the delimited formats.
+func newJrpRecordFromRow(columns map[string]int, row []string) (*jrpRecord, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	record := &jrpRecord{
+		Phrase:  field("phrase"),
+		Reading: field("reading"),
+		Romaji:  field("romaji"),
+		Prefix:  field("prefix"),
+		Suffix:  field("suffix"),
+	}
+	for _, s := range strings.Fields(field("word_ids")) {
+		wordID, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		record.WordIDs = append(record.WordIDs, wordID)
+	}
+	if s := field("is_favorited"); s != "" {
+		isFavorited, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		record.IsFavorited = isFavorited
+	}
+	if s := field("created_at"); s != "" {
+		createdAt, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		record.CreatedAt = createdAt
+	}
+	if s := field("updated_at"); s != "" {
+		updatedAt, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		record.UpdatedAt = updatedAt
+	}
+
+	return record, nil
+}
+
+// toSaveHistoryUseCaseInputDtos converts the jrp records into the input of the SaveHistoryUseCase.
+// the timestamps not recorded are filled with the current time.
+func toSaveHistoryUseCaseInputDtos(records []*jrpRecord) ([]*jrpApp.SaveHistoryUseCaseInputDto, error) {
+	now := time.Now()
+	dtos := []*jrpApp.SaveHistoryUseCaseInputDto{}
+	for i, record := range records {
+		if record == nil || record.Phrase == "" {
+			return nil, fmt.Errorf("record %d : the phrase is empty", i+1)
+		}
+
+		isFavorited := 0
+		if record.IsFavorited {
+			isFavorited = 1
+		}
+		createdAt := record.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		updatedAt := record.UpdatedAt
+		if updatedAt.IsZero() {
+			updatedAt = createdAt
+		}
+
+		dtos = append(dtos, &jrpApp.SaveHistoryUseCaseInputDto{
+			Phrase:      record.Phrase,
+			Reading:     record.Reading,
+			Romaji:      record.Romaji,
+			WordIDs:     record.WordIDs,
+			Prefix:      record.Prefix,
+			Suffix:      record.Suffix,
+			IsFavorited: isFavorited,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		})
+	}
+
+	return dtos, nil
+}
@@ -0,0 +1,438 @@
+package formatter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewParser(t *testing.T) {
+	type args struct {
+		format string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Parser
+		wantErr bool
+	}{
+		{
+			name: "positive testing (format is csv)",
+			args: args{
+				format: "csv",
+			},
+			want:    NewCsvParser(),
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is json)",
+			args: args{
+				format: "json",
+			},
+			want:    &JsonParser{},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is ndjson)",
+			args: args{
+				format: "ndjson",
+			},
+			want:    &NdjsonParser{},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (format is tsv)",
+			args: args{
+				format: "tsv",
+			},
+			want:    NewTsvParser(),
+			wantErr: false,
+		},
+		{
+			name: "negative testing (format is invalid)",
+			args: args{
+				format: "table",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.args.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewParser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewParser() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJsonParser_Parse(t *testing.T) {
+	origJu := Ju
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*jrpApp.SaveHistoryUseCaseInputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				data: []byte(`[{"id":1,"phrase":"test","reading":"てすと","romaji":"tesuto","word_ids":[1,2],"prefix":"prefix","suffix":"","is_favorited":true,"created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}]`),
+			},
+			want: []*jrpApp.SaveHistoryUseCaseInputDto{
+				{
+					Phrase:      "test",
+					Reading:     "てすと",
+					Romaji:      "tesuto",
+					WordIDs:     []int{1, 2},
+					Prefix:      "prefix",
+					Suffix:      "",
+					IsFavorited: 1,
+					CreatedAt:   ti,
+					UpdatedAt:   ti,
+				},
+			},
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "positive testing (data is empty array)",
+			args: args{
+				data: []byte(`[]`),
+			},
+			want:    []*jrpApp.SaveHistoryUseCaseInputDto{},
+			wantErr: false,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (phrase is empty)",
+			args: args{
+				data: []byte(`[{"id":1}]`),
+			},
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+			cleanup: nil,
+		},
+		{
+			name: "negative testing (Ju.Unmarshal(data, &records) failed)",
+			args: args{
+				data: []byte(`[]`),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockJson := proxy.NewMockJson(mockCtrl)
+				mockJson.EXPECT().Unmarshal(gomock.Any(), gomock.Any()).Return(errors.New("JsonUtil.Unmarshal() failed"))
+				Ju = utility.NewJsonUtil(mockJson)
+			},
+			cleanup: func() {
+				Ju = origJu
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			p := &JsonParser{}
+			got, err := p.Parse(tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JsonParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JsonParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNdjsonParser_Parse(t *testing.T) {
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*jrpApp.SaveHistoryUseCaseInputDto
+		wantErr bool
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				data: []byte(`{"phrase":"test1","created_at":"2006-01-02T15:04:05Z"}` + "\n\n" + `{"phrase":"test2","created_at":"2006-01-02T15:04:05Z","updated_at":"2006-01-02T15:04:05Z"}` + "\n"),
+			},
+			want: []*jrpApp.SaveHistoryUseCaseInputDto{
+				{
+					Phrase:    "test1",
+					CreatedAt: ti,
+					UpdatedAt: ti,
+				},
+				{
+					Phrase:    "test2",
+					CreatedAt: ti,
+					UpdatedAt: ti,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (data is empty)",
+			args: args{
+				data: []byte(""),
+			},
+			want:    []*jrpApp.SaveHistoryUseCaseInputDto{},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (Ju.Unmarshal(line, record) failed)",
+			args: args{
+				data: []byte(`{"phrase":"test1"}` + "\n" + `{"phrase":`),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &NdjsonParser{}
+			got, err := p.Parse(tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NdjsonParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NdjsonParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCsvParser_Parse(t *testing.T) {
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		p       *CsvParser
+		args    args
+		want    []*jrpApp.SaveHistoryUseCaseInputDto
+		wantErr bool
+	}{
+		{
+			name: "positive testing (csv)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("id,phrase,reading,romaji,word_ids,prefix,suffix,is_favorited,created_at,updated_at\n" +
+					"1,\"test,1\",てすと,tesuto,1 2,prefix,,true,2006-01-02T15:04:05Z,2006-01-02T15:04:05Z\n"),
+			},
+			want: []*jrpApp.SaveHistoryUseCaseInputDto{
+				{
+					Phrase:      "test,1",
+					Reading:     "てすと",
+					Romaji:      "tesuto",
+					WordIDs:     []int{1, 2},
+					Prefix:      "prefix",
+					IsFavorited: 1,
+					CreatedAt:   ti,
+					UpdatedAt:   ti,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (csv with the timestamps in the sub-seconds)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,created_at,updated_at\n" +
+					"test,2006-01-02T15:04:05.123456789Z,2006-01-02T15:04:05.123456789Z\n"),
+			},
+			want: []*jrpApp.SaveHistoryUseCaseInputDto{
+				{
+					Phrase:    "test",
+					CreatedAt: ti.Add(123456789 * time.Nanosecond),
+					UpdatedAt: ti.Add(123456789 * time.Nanosecond),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (tsv with the columns omitted)",
+			p:    NewTsvParser(),
+			args: args{
+				data: []byte("created_at\tphrase\n2006-01-02T15:04:05Z\ttest\n"),
+			},
+			want: []*jrpApp.SaveHistoryUseCaseInputDto{
+				{
+					Phrase:    "test",
+					CreatedAt: ti,
+					UpdatedAt: ti,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (data is empty)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte(""),
+			},
+			want:    []*jrpApp.SaveHistoryUseCaseInputDto{},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (r.ReadAll() failed)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,romaji\ntest\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (the header does not have the column phrase)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("romaji\ntesuto\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (strconv.Atoi(word_ids) failed)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,word_ids\ntest,a\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (strconv.ParseBool(is_favorited) failed)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,is_favorited\ntest,a\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (time.Parse(created_at) failed)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,created_at\ntest,a\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (time.Parse(updated_at) failed)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,updated_at\ntest,a\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative testing (phrase is empty)",
+			p:    NewCsvParser(),
+			args: args{
+				data: []byte("phrase,romaji\n,tesuto\n"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Parse(tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CsvParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CsvParser.Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_toSaveHistoryUseCaseInputDtos(t *testing.T) {
+	type args struct {
+		records []*jrpRecord
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "positive testing (timestamps are filled)",
+			args: args{
+				records: []*jrpRecord{
+					{
+						Phrase: "test",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (record is nil)",
+			args: args{
+				records: []*jrpRecord{nil},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toSaveHistoryUseCaseInputDtos(tt.args.records)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("toSaveHistoryUseCaseInputDtos() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for _, dto := range got {
+				if dto.CreatedAt.IsZero() || !dto.UpdatedAt.Equal(dto.CreatedAt) {
+					t.Errorf("toSaveHistoryUseCaseInputDtos() = %v, want the timestamps filled", dto)
+				}
+			}
+		})
+	}
+}
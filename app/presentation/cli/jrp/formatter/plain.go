@@ -2,8 +2,10 @@ package formatter
 
 import (
 	"fmt"
+	"strings"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 )
 
 // PlainFormatter is a struct that formats the output of jrp cli.
@@ -22,21 +24,61 @@ func (f *PlainFormatter) Format(result interface{}) (string, error) {
 		formatted = fmt.Sprintf("jrp version %s", v.Version)
 	case []*jrpApp.GenerateJrpUseCaseOutputDto:
 		for i, item := range v {
-			formatted += item.Phrase
+			formatted += f.withReading(item.Phrase, item.Reading)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
 	case []*jrpApp.GetHistoryUseCaseOutputDto:
 		for i, item := range v {
-			formatted += item.Phrase
+			formatted += f.withReading(item.Phrase, item.Reading)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
 	case []*jrpApp.SearchHistoryUseCaseOutputDto:
 		for i, item := range v {
-			formatted += item.Phrase
+			formatted += f.withReading(item.Phrase, item.Reading)
+			if item.Score > 0 {
+				formatted += fmt.Sprintf("\t%.2f", item.Score)
+			}
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto:
+		for i, item := range v {
+			formatted += f.withDefinitions(item.Lemma, item.Definition, item.Gloss)
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*jrpApp.GetMigrationStatusUseCaseOutputDto:
+		for i, item := range v {
+			status := "pending"
+			if item.IsApplied {
+				status = "applied"
+			}
+			formatted += fmt.Sprintf("%d\t%s\t%s", item.Version, item.Description, status)
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case *JrpCandidates:
+		for i, item := range v.Jrps {
+			formatted += fmt.Sprintf("%d\t%s", i+1, f.withReading(item.Phrase, item.Reading))
+			if v.isSelected(i) {
+				formatted += "\t○"
+			}
+			if i < len(v.Jrps)-1 {
+				formatted += "\n"
+			}
+		}
+	case *HistoryPage:
+		return f.Format(v.Histories)
+	case []*jrpApp.GetTagsUseCaseOutputDto:
+		for i, item := range v {
+			formatted += fmt.Sprintf("%s\t%d", item.Name, len(item.HistoryIDs))
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
@@ -46,3 +88,24 @@ func (f *PlainFormatter) Format(result interface{}) (string, error) {
 	}
 	return formatted, nil
 }
+
+// withReading returns the phrase followed by the reading separated by a tab if the reading exists.
+func (f *PlainFormatter) withReading(phrase string, reading string) string {
+	if reading == "" {
+		return phrase
+	}
+
+	return phrase + "\t" + reading
+}
+
+// withDefinitions returns the lemma followed by the existing definition and gloss separated by tabs.
+func (f *PlainFormatter) withDefinitions(lemma string, definition string, gloss string) string {
+	fields := []string{lemma}
+	for _, d := range []string{definition, gloss} {
+		if d != "" {
+			fields = append(fields, d)
+		}
+	}
+
+	return strings.Join(fields, "\t")
+}
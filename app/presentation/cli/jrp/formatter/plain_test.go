@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 )
 
 func TestNewPlainFormatter(t *testing.T) {
@@ -64,6 +65,24 @@ func TestPlainFormatter_Format(t *testing.T) {
 			want:    "phrase1\nphrase2",
 			wantErr: false,
 		},
+		{
+			name: "positive testing (result is []*jrpApp.GenerateJrpUseCaseOutputDto with the reading)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						Phrase:  "phrase1",
+						Reading: "reading1",
+					},
+					{
+						Phrase:  "phrase2",
+						Reading: "reading2",
+					},
+				},
+			},
+			want:    "phrase1\treading1\nphrase2\treading2",
+			wantErr: false,
+		},
 		{
 			name: "positive testing (result is []*jrpApp.GetHistoryUseCaseOutputDto)",
 			f:    &PlainFormatter{},
@@ -96,6 +115,25 @@ func TestPlainFormatter_Format(t *testing.T) {
 			want:    "phrase1\nphrase2",
 			wantErr: false,
 		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto with the scores)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						Phrase: "phrase1",
+						Score:  1,
+					},
+					{
+						Phrase:  "phrase2",
+						Reading: "reading2",
+						Score:   0.75,
+					},
+				},
+			},
+			want:    "phrase1\t1.00\nphrase2\treading2\t0.75",
+			wantErr: false,
+		},
 		{
 			name: "negative testing (result is invalid)",
 			f:    &PlainFormatter{},
@@ -105,6 +143,100 @@ func TestPlainFormatter_Format(t *testing.T) {
 			want:    "",
 			wantErr: false,
 		},
+
+		{
+			name: "positive testing (result is []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto{
+					{
+						Lemma:      "lemma1",
+						Definition: "definition1",
+						Gloss:      "gloss1",
+					},
+					{
+						Lemma: "lemma2",
+					},
+				},
+			},
+			want:    "lemma1\tdefinition1\tgloss1\nlemma2",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetMigrationStatusUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*jrpApp.GetMigrationStatusUseCaseOutputDto{
+					{
+						Version:     1,
+						Description: "create",
+						IsApplied:   true,
+					},
+					{
+						Version:     2,
+						Description: "alter",
+						IsApplied:   false,
+					},
+				},
+			},
+			want:    "1\tcreate\tapplied\n2\talter\tpending",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetTagsUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*jrpApp.GetTagsUseCaseOutputDto{
+					{
+						Name:       "release-names",
+						HistoryIDs: []int{1, 3},
+					},
+					{
+						Name:       "team-names",
+						HistoryIDs: []int{2},
+					},
+				},
+			},
+			want:    "release-names\t2\nteam-names\t1",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is *JrpCandidates)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: &JrpCandidates{
+					Jrps: []*jrpApp.GenerateJrpUseCaseOutputDto{
+						{
+							Phrase:  "phrase1",
+							Reading: "reading1",
+						},
+						{
+							Phrase: "phrase2",
+						},
+					},
+					Selected: []bool{true},
+				},
+			},
+			want:    "1\tphrase1\treading1\t○\n2\tphrase2",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is *HistoryPage)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: &HistoryPage{
+					Histories: []*jrpApp.SearchHistoryUseCaseOutputDto{
+						{
+							Phrase: "phrase1",
+						},
+					},
+					Page:  1,
+					Pages: 2,
+				},
+			},
+			want:    "phrase1",
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,130 @@
+package formatter
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+)
+
+// jrpRecord is a struct that represents a jrp serialized by the machine readable formatters.
+type jrpRecord struct {
+	ID          int       `json:"id"`
+	Phrase      string    `json:"phrase"`
+	Reading     string    `json:"reading"`
+	Romaji      string    `json:"romaji"`
+	WordIDs     []int     `json:"word_ids"`
+	Prefix      string    `json:"prefix"`
+	Suffix      string    `json:"suffix"`
+	IsFavorited bool      `json:"is_favorited"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Score       float64   `json:"score,omitempty"`
+}
+
+var (
+	// errUnsupportedResult is the error returned when the result can not be converted into the jrp records.
+	errUnsupportedResult = errors.New("unsupported result for this format")
+	// jrpRecordHeader is the header of the jrp records for the delimited formats.
+	jrpRecordHeader = []string{"id", "phrase", "reading", "romaji", "word_ids", "prefix", "suffix", "is_favorited", "created_at", "updated_at"}
+	// jrpRecordScoreHeader is the header of the similarity scores added to the jrp records for the delimited formats.
+	jrpRecordScoreHeader = "score"
+)
+
+// toJrpRecords converts the output of the use cases into the jrp records.
+// it returns false if the output can not be converted.
+func toJrpRecords(result interface{}) ([]*jrpRecord, bool) {
+	records := []*jrpRecord{}
+	switch v := result.(type) {
+	case *HistoryPage:
+		return toJrpRecords(v.Histories)
+	case *JrpCandidates:
+		return toJrpRecords(v.Jrps)
+	case []*jrpApp.GenerateJrpUseCaseOutputDto:
+		for _, dto := range v {
+			records = append(records, newJrpRecord(dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.WordIDs, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt))
+		}
+	case []*jrpApp.GetHistoryUseCaseOutputDto:
+		for _, dto := range v {
+			records = append(records, newJrpRecord(dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.WordIDs, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt))
+		}
+	case []*jrpApp.SearchHistoryUseCaseOutputDto:
+		for _, dto := range v {
+			record := newJrpRecord(dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.WordIDs, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt)
+			record.Score = dto.Score
+			records = append(records, record)
+		}
+	default:
+		return nil, false
+	}
+
+	return records, true
+}
+
+// newJrpRecord returns a new instance of the jrpRecord struct.
+func newJrpRecord(
+	id int,
+	phrase string,
+	reading string,
+	romaji string,
+	wordIDs []int,
+	prefix string,
+	suffix string,
+	isFavorited int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *jrpRecord {
+	if wordIDs == nil {
+		wordIDs = []int{}
+	}
+
+	return &jrpRecord{
+		ID:          id,
+		Phrase:      phrase,
+		Reading:     reading,
+		Romaji:      romaji,
+		WordIDs:     wordIDs,
+		Prefix:      prefix,
+		Suffix:      suffix,
+		IsFavorited: isFavorited == 1,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// hasScores returns whether the jrp records have the similarity scores of the fuzzy search.
+func hasScores(records []*jrpRecord) bool {
+	return slices.ContainsFunc(records, func(r *jrpRecord) bool {
+		return r.Score > 0
+	})
+}
+
+// toRow converts the jrp record into the row of the delimited formats.
+// the similarity score is added at the end of the row if withScore is true.
+func (r *jrpRecord) toRow(withScore bool) []string {
+	wordIDs := make([]string, len(r.WordIDs))
+	for i, wordID := range r.WordIDs {
+		wordIDs[i] = strconv.Itoa(wordID)
+	}
+
+	row := []string{
+		strconv.Itoa(r.ID),
+		r.Phrase,
+		r.Reading,
+		r.Romaji,
+		strings.Join(wordIDs, " "),
+		r.Prefix,
+		r.Suffix,
+		strconv.FormatBool(r.IsFavorited),
+		r.CreatedAt.Format(time.RFC3339Nano),
+		r.UpdatedAt.Format(time.RFC3339Nano),
+	}
+	if withScore {
+		row = append(row, strconv.FormatFloat(r.Score, 'f', -1, 64))
+	}
+
+	return row
+}
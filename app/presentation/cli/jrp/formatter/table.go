@@ -8,6 +8,7 @@ import (
 	"time"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
 	"github.com/yanosea/jrp/v2/pkg/utility"
@@ -34,31 +35,61 @@ type tableData struct {
 
 // Format formats the output of jrp cli.
 func (f *TableFormatter) Format(result interface{}) (string, error) {
+	data, ok := f.toTableData(result)
+	if !ok {
+		return "", nil
+	}
+
+	return f.getTableString(data)
+}
+
+// toTableData converts the output of the use cases into the table data.
+// it returns false if the output can not be converted.
+func (f *TableFormatter) toTableData(result interface{}) (tableData, bool) {
 	var data tableData
 
 	switch v := result.(type) {
 	case []*jrpApp.GenerateJrpUseCaseOutputDto:
 		data = f.formatGenerateJrp(v)
 	case []*jrpApp.GetHistoryUseCaseOutputDto:
-		data = f.formatHistory(v, func(h interface{}) (int, string, string, string, int, time.Time, time.Time) {
+		data = f.formatHistory(v, func(h interface{}) (int, string, string, string, string, string, int, time.Time, time.Time) {
 			dto := h.(*jrpApp.GetHistoryUseCaseOutputDto)
-			return dto.ID, dto.Phrase, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
+			return dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
 		})
 	case []*jrpApp.SearchHistoryUseCaseOutputDto:
-		data = f.formatHistory(v, func(h interface{}) (int, string, string, string, int, time.Time, time.Time) {
+		data = f.formatHistory(v, func(h interface{}) (int, string, string, string, string, string, int, time.Time, time.Time) {
 			dto := h.(*jrpApp.SearchHistoryUseCaseOutputDto)
-			return dto.ID, dto.Phrase, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
+			return dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
 		})
+		if slices.ContainsFunc(v, func(dto *jrpApp.SearchHistoryUseCaseOutputDto) bool {
+			return dto.Score > 0
+		}) {
+			data = f.addScoreColumn(data, v)
+		}
+	case []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto:
+		data = f.formatWordDefinitions(v)
+	case []*jrpApp.GetMigrationStatusUseCaseOutputDto:
+		data = f.formatMigrationStatus(v)
+	case []*jrpApp.GetTagsUseCaseOutputDto:
+		data = f.formatTags(v)
+	case *JrpCandidates:
+		data = f.formatJrpCandidates(v)
+	case *HistoryPage:
+		histories, ok := f.toTableData(v.Histories)
+		if !ok {
+			return tableData{}, false
+		}
+		data = tableData{header: histories.header, rows: f.addPageRow(histories.rows, v.Page, v.Pages)}
 	default:
-		return "", nil
+		return tableData{}, false
 	}
 
-	return f.getTableString(data)
+	return data, true
 }
 
 // formatGenerateJrp formats the output of the GenerateJrp use case.
 func (f *TableFormatter) formatGenerateJrp(items []*jrpApp.GenerateJrpUseCaseOutputDto) tableData {
-	header := []string{"phrase", "prefix", "suffix", "created_at"}
+	header := []string{"phrase", "reading", "romaji", "prefix", "suffix", "created_at"}
 
 	noId := slices.ContainsFunc(items, func(dto *jrpApp.GenerateJrpUseCaseOutputDto) bool {
 		return dto.ID == 0
@@ -69,7 +100,7 @@ func (f *TableFormatter) formatGenerateJrp(items []*jrpApp.GenerateJrpUseCaseOut
 
 	var rows [][]string
 	for _, jrp := range items {
-		row := []string{jrp.Phrase, jrp.Prefix, jrp.Suffix, jrp.CreatedAt.Format("2006-01-02 15:04:05")}
+		row := []string{jrp.Phrase, jrp.Reading, jrp.Romaji, jrp.Prefix, jrp.Suffix, jrp.CreatedAt.Format("2006-01-02 15:04:05")}
 		if !noId {
 			row = append([]string{strconv.Itoa(jrp.ID)}, row...)
 		}
@@ -83,16 +114,41 @@ func (f *TableFormatter) formatGenerateJrp(items []*jrpApp.GenerateJrpUseCaseOut
 	return tableData{header: header, rows: rows}
 }
 
+// formatJrpCandidates formats the numbered candidates of the interactive mode.
+func (f *TableFormatter) formatJrpCandidates(candidates *JrpCandidates) tableData {
+	header := []string{"no", "phrase", "reading", "romaji", "prefix", "suffix", "created_at", "selected"}
+
+	var rows [][]string
+	for i, jrp := range candidates.Jrps {
+		selected := ""
+		if candidates.isSelected(i) {
+			selected = "○"
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			jrp.Phrase,
+			jrp.Reading,
+			jrp.Romaji,
+			jrp.Prefix,
+			jrp.Suffix,
+			jrp.CreatedAt.Format("2006-01-02 15:04:05"),
+			selected,
+		})
+	}
+
+	return tableData{header: header, rows: rows}
+}
+
 // formatHistory formats the output of the GetHistory and SearchHistory use cases.
-func (f *TableFormatter) formatHistory(items interface{}, getData func(interface{}) (int, string, string, string, int, time.Time, time.Time)) tableData {
-	header := []string{"id", "phrase", "prefix", "suffix", "is_favorited", "created_at", "updated_at"}
+func (f *TableFormatter) formatHistory(items interface{}, getData func(interface{}) (int, string, string, string, string, string, int, time.Time, time.Time)) tableData {
+	header := []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "is_favorited", "created_at", "updated_at"}
 	var rows [][]string
 
 	addRows := func(v interface{}) {
 		switch v := v.(type) {
 		case []*jrpApp.GetHistoryUseCaseOutputDto:
 			for _, item := range v {
-				id, phrase, prefix, suffix, isFavorited, createdAt, updatedAt := getData(item)
+				id, phrase, reading, romaji, prefix, suffix, isFavorited, createdAt, updatedAt := getData(item)
 				favorited := ""
 				if isFavorited == 1 {
 					favorited = "○"
@@ -100,6 +156,8 @@ func (f *TableFormatter) formatHistory(items interface{}, getData func(interface
 				rows = append(rows, []string{
 					strconv.Itoa(id),
 					phrase,
+					reading,
+					romaji,
 					prefix,
 					suffix,
 					favorited,
@@ -109,7 +167,7 @@ func (f *TableFormatter) formatHistory(items interface{}, getData func(interface
 			}
 		case []*jrpApp.SearchHistoryUseCaseOutputDto:
 			for _, item := range v {
-				id, phrase, prefix, suffix, isFavorited, createdAt, updatedAt := getData(item)
+				id, phrase, reading, romaji, prefix, suffix, isFavorited, createdAt, updatedAt := getData(item)
 				favorited := ""
 				if isFavorited == 1 {
 					favorited = "○"
@@ -117,6 +175,8 @@ func (f *TableFormatter) formatHistory(items interface{}, getData func(interface
 				rows = append(rows, []string{
 					strconv.Itoa(id),
 					phrase,
+					reading,
+					romaji,
 					prefix,
 					suffix,
 					favorited,
@@ -138,6 +198,87 @@ func (f *TableFormatter) formatHistory(items interface{}, getData func(interface
 	return tableData{header: header, rows: rows}
 }
 
+// addScoreColumn adds a column of the similarity scores of the fuzzy search to the table of the histories.
+func (f *TableFormatter) addScoreColumn(data tableData, items []*jrpApp.SearchHistoryUseCaseOutputDto) tableData {
+	if len(data.rows) == 0 {
+		return data
+	}
+
+	data.header = append(data.header, "score")
+	for i := range data.rows {
+		score := ""
+		if i < len(items) {
+			score = strconv.FormatFloat(items[i].Score, 'f', 2, 64)
+		}
+		data.rows[i] = append(data.rows[i], score)
+	}
+
+	return data
+}
+
+// formatWordDefinitions formats the output of the FetchWordDefinitions use case.
+func (f *TableFormatter) formatWordDefinitions(items []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto) tableData {
+	header := []string{"word_id", "lemma", "pron", "pos", "synset", "definition", "gloss"}
+
+	var rows [][]string
+	for _, word := range items {
+		rows = append(rows, []string{
+			strconv.Itoa(word.WordID),
+			word.Lemma,
+			word.Pron,
+			word.Pos,
+			word.Synset,
+			word.Definition,
+			word.Gloss,
+		})
+	}
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatMigrationStatus formats the output of the GetMigrationStatus use case.
+func (f *TableFormatter) formatMigrationStatus(items []*jrpApp.GetMigrationStatusUseCaseOutputDto) tableData {
+	header := []string{"version", "description", "status", "applied_at"}
+
+	var rows [][]string
+	for _, migration := range items {
+		status := "pending"
+		appliedAt := ""
+		if migration.IsApplied {
+			status = "applied"
+			appliedAt = migration.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(migration.Version),
+			migration.Description,
+			status,
+			appliedAt,
+		})
+	}
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatTags formats the output of the GetTags use case.
+func (f *TableFormatter) formatTags(items []*jrpApp.GetTagsUseCaseOutputDto) tableData {
+	header := []string{"tag", "count", "ids"}
+
+	var rows [][]string
+	for _, tag := range items {
+		ids := make([]string, 0, len(tag.HistoryIDs))
+		for _, id := range tag.HistoryIDs {
+			ids = append(ids, strconv.Itoa(id))
+		}
+		rows = append(rows, []string{
+			tag.Name,
+			strconv.Itoa(len(tag.HistoryIDs)),
+			strings.Join(ids, ","),
+		})
+	}
+
+	return tableData{header: header, rows: rows}
+}
+
 // addTotalRow adds a total row to the table.
 func (f *TableFormatter) addTotalRow(rows [][]string) [][]string {
 	if len(rows) == 0 {
@@ -158,6 +299,18 @@ func (f *TableFormatter) addTotalRow(rows [][]string) [][]string {
 	return rows
 }
 
+// addPageRow adds a page row to the table.
+func (f *TableFormatter) addPageRow(rows [][]string, page int, pages int) [][]string {
+	if len(rows) == 0 {
+		return [][]string{}
+	}
+
+	pageRow := make([]string, len(rows[0]))
+	pageRow[0] = fmt.Sprintf("PAGE : %d of %d", page, pages)
+
+	return append(rows, pageRow)
+}
+
 // getTableString returns a string representation of a table.
 func (f *TableFormatter) getTableString(data tableData) (string, error) {
 	if len(data.header) == 0 || len(data.rows) == 0 {
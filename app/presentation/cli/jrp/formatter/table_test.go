@@ -7,6 +7,7 @@ import (
 	"time"
 
 	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+	wnjpnApp "github.com/yanosea/jrp/v2/app/application/wnjpn"
 
 	"github.com/yanosea/jrp/v2/pkg/proxy"
 	"github.com/yanosea/jrp/v2/pkg/utility"
@@ -64,6 +65,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          1,
 						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
 						Prefix:      "prefix1",
 						Suffix:      "suffix1",
 						IsFavorited: 1,
@@ -73,6 +76,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          2,
 						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
 						Prefix:      "prefix2",
 						Suffix:      "suffix2",
 						IsFavorited: 1,
@@ -81,7 +86,7 @@ func TestTableFormatter_Format(t *testing.T) {
 					},
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXCREATEDAT1phrase1prefix1suffix12006-01-0215:04:052phrase2prefix2suffix22006-01-0215:04:05TOTAL:2jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXCREATEDAT1phrase1reading1romaji1prefix1suffix12006-01-0215:04:052phrase2reading2romaji2prefix2suffix22006-01-0215:04:05TOTAL:2jrps!",
 			wantErr: false,
 		},
 		{
@@ -92,6 +97,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          1,
 						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
 						Prefix:      "prefix1",
 						Suffix:      "suffix1",
 						IsFavorited: 1,
@@ -101,6 +108,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          2,
 						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
 						Prefix:      "prefix2",
 						Suffix:      "suffix2",
 						IsFavorited: 1,
@@ -109,7 +118,7 @@ func TestTableFormatter_Format(t *testing.T) {
 					},
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1phrase1prefix1suffix1○2006-01-0215:04:052006-01-0215:04:052phrase2prefix2suffix2○2006-01-0215:04:052006-01-0215:04:05TOTAL:2jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1phrase1reading1romaji1prefix1suffix1○2006-01-0215:04:052006-01-0215:04:052phrase2reading2romaji2prefix2suffix2○2006-01-0215:04:052006-01-0215:04:05TOTAL:2jrps!",
 			wantErr: false,
 		},
 		{
@@ -120,6 +129,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          1,
 						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
 						Prefix:      "prefix1",
 						Suffix:      "suffix1",
 						IsFavorited: 1,
@@ -129,6 +140,8 @@ func TestTableFormatter_Format(t *testing.T) {
 					{
 						ID:          2,
 						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
 						Prefix:      "prefix2",
 						Suffix:      "suffix2",
 						IsFavorited: 1,
@@ -137,7 +150,41 @@ func TestTableFormatter_Format(t *testing.T) {
 					},
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1phrase1prefix1suffix1○2006-01-0215:04:052006-01-0215:04:052phrase2prefix2suffix2○2006-01-0215:04:052006-01-0215:04:05TOTAL:2jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT1phrase1reading1romaji1prefix1suffix1○2006-01-0215:04:052006-01-0215:04:052phrase2reading2romaji2prefix2suffix2○2006-01-0215:04:052006-01-0215:04:05TOTAL:2jrps!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto with the scores)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:          1,
+						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
+						Prefix:      "prefix1",
+						Suffix:      "suffix1",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+						Score:       1,
+					},
+					{
+						ID:          2,
+						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
+						Prefix:      "prefix2",
+						Suffix:      "suffix2",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+						Score:       0.75,
+					},
+				},
+			},
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDATSCORE1phrase1reading1romaji1prefix1suffix1○2006-01-0215:04:052006-01-0215:04:051.002phrase2reading2romaji2prefix2suffix2○2006-01-0215:04:052006-01-0215:04:050.75TOTAL:2jrps!",
 			wantErr: false,
 		},
 		{
@@ -149,6 +196,131 @@ func TestTableFormatter_Format(t *testing.T) {
 			want:    "",
 			wantErr: false,
 		},
+
+		{
+			name: "positive testing (result is []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*wnjpnApp.FetchWordDefinitionsUseCaseOutputDto{
+					{
+						WordID:     1,
+						Lemma:      "lemma1",
+						Pron:       "pron1",
+						Pos:        "n",
+						Synset:     "synset1",
+						Name:       "name1",
+						Definition: "definition1",
+						Gloss:      "gloss1",
+					},
+				},
+			},
+			want:    "WORDIDLEMMAPRONPOSSYNSETDEFINITIONGLOSS1lemma1pron1nsynset1definition1gloss1",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetMigrationStatusUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*jrpApp.GetMigrationStatusUseCaseOutputDto{
+					{
+						Version:     1,
+						Description: "create",
+						IsApplied:   true,
+						AppliedAt:   time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+					},
+					{
+						Version:     2,
+						Description: "alter",
+						IsApplied:   false,
+					},
+				},
+			},
+			want:    "VERSIONDESCRIPTIONSTATUSAPPLIEDAT1createapplied2006-01-0215:04:052alterpending",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetTagsUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*jrpApp.GetTagsUseCaseOutputDto{
+					{
+						Name:       "release-names",
+						HistoryIDs: []int{1, 3},
+					},
+					{
+						Name:       "team-names",
+						HistoryIDs: []int{2},
+					},
+				},
+			},
+			want:    "TAGCOUNTIDSrelease-names21,3team-names12",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is *JrpCandidates)",
+			f:    &TableFormatter{},
+			args: args{
+				result: &JrpCandidates{
+					Jrps: []*jrpApp.GenerateJrpUseCaseOutputDto{
+						{
+							Phrase:    "phrase1",
+							Reading:   "reading1",
+							Romaji:    "romaji1",
+							Prefix:    "prefix1",
+							CreatedAt: ti,
+						},
+						{
+							Phrase:    "phrase2",
+							Reading:   "reading2",
+							Romaji:    "romaji2",
+							Suffix:    "suffix2",
+							CreatedAt: ti,
+						},
+					},
+					Selected: []bool{false, true},
+				},
+			},
+			want:    "NOPHRASEREADINGROMAJIPREFIXSUFFIXCREATEDATSELECTED1phrase1reading1romaji1prefix12006-01-0215:04:052phrase2reading2romaji2suffix22006-01-0215:04:05○",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is *HistoryPage)",
+			f:    &TableFormatter{},
+			args: args{
+				result: &HistoryPage{
+					Histories: []*jrpApp.GetHistoryUseCaseOutputDto{
+						{
+							ID:          11,
+							Phrase:      "phrase11",
+							Reading:     "reading11",
+							Romaji:      "romaji11",
+							Prefix:      "prefix11",
+							Suffix:      "suffix11",
+							IsFavorited: 0,
+							CreatedAt:   ti,
+							UpdatedAt:   ti,
+						},
+					},
+					Page:  2,
+					Pages: 3,
+				},
+			},
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXISFAVORITEDCREATEDATUPDATEDAT11phrase11reading11romaji11prefix11suffix112006-01-0215:04:052006-01-0215:04:05TOTAL:1jrps!PAGE:2of3",
+			wantErr: false,
+		},
+		{
+			name: "negative testing (result is *HistoryPage, histories are invalid)",
+			f:    &TableFormatter{},
+			args: args{
+				result: &HistoryPage{
+					Histories: "invalid",
+					Page:      1,
+					Pages:     1,
+				},
+			},
+			want:    "",
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -184,12 +356,16 @@ func TestTableFormatter_formatGenerateJrp(t *testing.T) {
 				items: []*jrpApp.GenerateJrpUseCaseOutputDto{
 					{
 						Phrase:    "phrase1",
+						Reading:   "reading1",
+						Romaji:    "romaji1",
 						Prefix:    "prefix1",
 						Suffix:    "suffix1",
 						CreatedAt: ti,
 					},
 					{
 						Phrase:    "phrase2",
+						Reading:   "reading2",
+						Romaji:    "romaji2",
 						Prefix:    "prefix2",
 						Suffix:    "suffix2",
 						CreatedAt: ti,
@@ -197,10 +373,10 @@ func TestTableFormatter_formatGenerateJrp(t *testing.T) {
 				},
 			},
 			want: tableData{
-				header: []string{"phrase", "prefix", "suffix", "created_at"},
+				header: []string{"phrase", "reading", "romaji", "prefix", "suffix", "created_at"},
 				rows: [][]string{
-					{"phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-					{"phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+					{"phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+					{"phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
 				},
 			},
 		},
@@ -212,6 +388,8 @@ func TestTableFormatter_formatGenerateJrp(t *testing.T) {
 					{
 						ID:        1,
 						Phrase:    "phrase1",
+						Reading:   "reading1",
+						Romaji:    "romaji1",
 						Prefix:    "prefix1",
 						Suffix:    "suffix1",
 						CreatedAt: ti,
@@ -219,6 +397,8 @@ func TestTableFormatter_formatGenerateJrp(t *testing.T) {
 					{
 						ID:        2,
 						Phrase:    "phrase2",
+						Reading:   "reading2",
+						Romaji:    "romaji2",
 						Prefix:    "prefix2",
 						Suffix:    "suffix2",
 						CreatedAt: ti,
@@ -226,12 +406,12 @@ func TestTableFormatter_formatGenerateJrp(t *testing.T) {
 				},
 			},
 			want: tableData{
-				header: []string{"id", "phrase", "prefix", "suffix", "created_at"},
+				header: []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "created_at"},
 				rows: [][]string{
-					{"1", "phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-					{"2", "phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
-					{"", "", "", "", ""},
-					{"TOTAL : 2 jrps!", "", "", "", ""},
+					{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+					{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+					{"", "", "", "", "", "", ""},
+					{"TOTAL : 2 jrps!", "", "", "", "", "", ""},
 				},
 			},
 		},
@@ -250,7 +430,7 @@ func Test_formatHistory(t *testing.T) {
 
 	type args struct {
 		items   interface{}
-		getData func(interface{}) (int, string, string, string, int, time.Time, time.Time)
+		getData func(interface{}) (int, string, string, string, string, string, int, time.Time, time.Time)
 	}
 	tests := []struct {
 		name string
@@ -266,6 +446,8 @@ func Test_formatHistory(t *testing.T) {
 					{
 						ID:          1,
 						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
 						Prefix:      "prefix1",
 						Suffix:      "suffix1",
 						IsFavorited: 1,
@@ -275,6 +457,8 @@ func Test_formatHistory(t *testing.T) {
 					{
 						ID:          2,
 						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
 						Prefix:      "prefix2",
 						Suffix:      "suffix2",
 						IsFavorited: 1,
@@ -282,18 +466,18 @@ func Test_formatHistory(t *testing.T) {
 						UpdatedAt:   ti,
 					},
 				},
-				getData: func(v interface{}) (int, string, string, string, int, time.Time, time.Time) {
+				getData: func(v interface{}) (int, string, string, string, string, string, int, time.Time, time.Time) {
 					dto := v.(*jrpApp.GetHistoryUseCaseOutputDto)
-					return dto.ID, dto.Phrase, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
+					return dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
 				},
 			},
 			want: tableData{
-				header: []string{"id", "phrase", "prefix", "suffix", "is_favorited", "created_at", "updated_at"},
+				header: []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "is_favorited", "created_at", "updated_at"},
 				rows: [][]string{
-					{"1", "phrase1", "prefix1", "suffix1", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
-					{"2", "phrase2", "prefix2", "suffix2", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
-					{"", "", "", "", "", "", ""},
-					{"TOTAL : 2 jrps!", "", "", "", "", "", ""},
+					{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
+					{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
+					{"", "", "", "", "", "", "", "", ""},
+					{"TOTAL : 2 jrps!", "", "", "", "", "", "", "", ""},
 				},
 			},
 		},
@@ -305,6 +489,8 @@ func Test_formatHistory(t *testing.T) {
 					{
 						ID:          1,
 						Phrase:      "phrase1",
+						Reading:     "reading1",
+						Romaji:      "romaji1",
 						Prefix:      "prefix1",
 						Suffix:      "suffix1",
 						IsFavorited: 1,
@@ -314,6 +500,8 @@ func Test_formatHistory(t *testing.T) {
 					{
 						ID:          2,
 						Phrase:      "phrase2",
+						Reading:     "reading2",
+						Romaji:      "romaji2",
 						Prefix:      "prefix2",
 						Suffix:      "suffix2",
 						IsFavorited: 1,
@@ -321,18 +509,18 @@ func Test_formatHistory(t *testing.T) {
 						UpdatedAt:   ti,
 					},
 				},
-				getData: func(v interface{}) (int, string, string, string, int, time.Time, time.Time) {
+				getData: func(v interface{}) (int, string, string, string, string, string, int, time.Time, time.Time) {
 					dto := v.(*jrpApp.SearchHistoryUseCaseOutputDto)
-					return dto.ID, dto.Phrase, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
+					return dto.ID, dto.Phrase, dto.Reading, dto.Romaji, dto.Prefix, dto.Suffix, dto.IsFavorited, dto.CreatedAt, dto.UpdatedAt
 				},
 			},
 			want: tableData{
-				header: []string{"id", "phrase", "prefix", "suffix", "is_favorited", "created_at", "updated_at"},
+				header: []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "is_favorited", "created_at", "updated_at"},
 				rows: [][]string{
-					{"1", "phrase1", "prefix1", "suffix1", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
-					{"2", "phrase2", "prefix2", "suffix2", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
-					{"", "", "", "", "", "", ""},
-					{"TOTAL : 2 jrps!", "", "", "", "", "", ""},
+					{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
+					{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "○", "2006-01-02 15:04:05", "2006-01-02 15:04:05"},
+					{"", "", "", "", "", "", "", "", ""},
+					{"TOTAL : 2 jrps!", "", "", "", "", "", "", "", ""},
 				},
 			},
 		},
@@ -341,8 +529,8 @@ func Test_formatHistory(t *testing.T) {
 			f:    &TableFormatter{},
 			args: args{
 				items: "invalid",
-				getData: func(v interface{}) (int, string, string, string, int, time.Time, time.Time) {
-					return 0, "", "", "", 0, time.Time{}, time.Time{}
+				getData: func(v interface{}) (int, string, string, string, string, string, int, time.Time, time.Time) {
+					return 0, "", "", "", "", "", 0, time.Time{}, time.Time{}
 				},
 			},
 			want: tableData{},
@@ -380,15 +568,15 @@ func TestTableFormatter_addTotalRow(t *testing.T) {
 			f:    &TableFormatter{},
 			args: args{
 				rows: [][]string{
-					{"1", "phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-					{"2", "phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+					{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+					{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
 				},
 			},
 			want: [][]string{
-				{"1", "phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-				{"2", "phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
-				{"", "", "", "", ""},
-				{"TOTAL : 2 jrps!", "", "", "", ""},
+				{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+				{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+				{"", "", "", "", "", "", ""},
+				{"TOTAL : 2 jrps!", "", "", "", "", "", ""},
 			},
 		},
 	}
@@ -402,6 +590,118 @@ func TestTableFormatter_addTotalRow(t *testing.T) {
 	}
 }
 
+func TestTableFormatter_addPageRow(t *testing.T) {
+	type args struct {
+		rows  [][]string
+		page  int
+		pages int
+	}
+	tests := []struct {
+		name string
+		f    *TableFormatter
+		args args
+		want [][]string
+	}{
+		{
+			name: "positive testing (rows is empty)",
+			f:    &TableFormatter{},
+			args: args{
+				rows:  [][]string{},
+				page:  1,
+				pages: 1,
+			},
+			want: [][]string{},
+		},
+		{
+			name: "positive testing (rows is not empty)",
+			f:    &TableFormatter{},
+			args: args{
+				rows: [][]string{
+					{"1", "phrase1", "2006-01-02 15:04:05"},
+					{"", "", ""},
+					{"TOTAL : 1 jrps!", "", ""},
+				},
+				page:  2,
+				pages: 5,
+			},
+			want: [][]string{
+				{"1", "phrase1", "2006-01-02 15:04:05"},
+				{"", "", ""},
+				{"TOTAL : 1 jrps!", "", ""},
+				{"PAGE : 2 of 5", "", ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &TableFormatter{}
+			if got := f.addPageRow(tt.args.rows, tt.args.page, tt.args.pages); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TableFormatter.addPageRow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableFormatter_addScoreColumn(t *testing.T) {
+	type args struct {
+		data  tableData
+		items []*jrpApp.SearchHistoryUseCaseOutputDto
+	}
+	tests := []struct {
+		name string
+		f    *TableFormatter
+		args args
+		want tableData
+	}{
+		{
+			name: "positive testing (rows is empty)",
+			f:    &TableFormatter{},
+			args: args{
+				data:  tableData{},
+				items: []*jrpApp.SearchHistoryUseCaseOutputDto{},
+			},
+			want: tableData{},
+		},
+		{
+			name: "positive testing (rows is not empty)",
+			f:    &TableFormatter{},
+			args: args{
+				data: tableData{
+					header: []string{"id", "phrase"},
+					rows: [][]string{
+						{"1", "phrase1"},
+						{"", ""},
+						{"TOTAL : 1 jrps!", ""},
+					},
+				},
+				items: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:     1,
+						Phrase: "phrase1",
+						Score:  0.875,
+					},
+				},
+			},
+			want: tableData{
+				header: []string{"id", "phrase", "score"},
+				rows: [][]string{
+					{"1", "phrase1", "0.88"},
+					{"", "", ""},
+					{"TOTAL : 1 jrps!", "", ""},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &TableFormatter{}
+			if got := f.addScoreColumn(tt.args.data, tt.args.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TableFormatter.addScoreColumn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTableFormatter_getTableString(t *testing.T) {
 	su := utility.NewStringsUtil()
 
@@ -441,7 +741,7 @@ func TestTableFormatter_getTableString(t *testing.T) {
 			f:    &TableFormatter{},
 			args: args{
 				data: tableData{
-					header: []string{"id", "phrase", "prefix", "suffix", "created_at"},
+					header: []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "created_at"},
 					rows:   [][]string{},
 				},
 			},
@@ -455,8 +755,8 @@ func TestTableFormatter_getTableString(t *testing.T) {
 				data: tableData{
 					header: []string{},
 					rows: [][]string{
-						{"1", "phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-						{"2", "phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+						{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+						{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
 						{},
 						{"TOTAL : 2 jrps!"},
 					},
@@ -470,16 +770,16 @@ func TestTableFormatter_getTableString(t *testing.T) {
 			f:    &TableFormatter{},
 			args: args{
 				data: tableData{
-					header: []string{"id", "phrase", "prefix", "suffix", "created_at"},
+					header: []string{"id", "phrase", "reading", "romaji", "prefix", "suffix", "created_at"},
 					rows: [][]string{
-						{"1", "phrase1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
-						{"2", "phrase2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
+						{"1", "phrase1", "reading1", "romaji1", "prefix1", "suffix1", "2006-01-02 15:04:05"},
+						{"2", "phrase2", "reading2", "romaji2", "prefix2", "suffix2", "2006-01-02 15:04:05"},
 						{},
 						{"TOTAL : 2 jrps!"},
 					},
 				},
 			},
-			want:    "IDPHRASEPREFIXSUFFIXCREATEDAT1phrase1prefix1suffix12006-01-0215:04:052phrase2prefix2suffix22006-01-0215:04:05TOTAL:2jrps!",
+			want:    "IDPHRASEREADINGROMAJIPREFIXSUFFIXCREATEDAT1phrase1reading1romaji1prefix1suffix12006-01-0215:04:052phrase2reading2romaji2prefix2suffix22006-01-0215:04:05TOTAL:2jrps!",
 			wantErr: false,
 		},
 	}
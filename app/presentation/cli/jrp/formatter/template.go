@@ -0,0 +1,112 @@
+package formatter
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+)
+
+// TemplateFormatter is a struct that formats the output of jrp cli with the user-defined template.
+type TemplateFormatter struct {
+	tmpl *template.Template
+}
+
+var (
+	// Fu is a variable that contains the FileUtil struct for injecting dependencies in testing.
+	Fu = utility.NewFileUtil(
+		proxy.NewGzip(),
+		proxy.NewIo(),
+		proxy.NewOs(),
+	)
+	// templateFuncs is the functions available in the user-defined template.
+	templateFuncs = template.FuncMap{
+		"blue":   Blue,
+		"green":  Green,
+		"red":    Red,
+		"yellow": Yellow,
+		"formatTime": func(layout string, t time.Time) string {
+			return t.Format(layout)
+		},
+		"localTime": func(t time.Time) time.Time {
+			return t.Local()
+		},
+	}
+	// templateEscapeReplacer is the replacer to unescape the escape sequences typed in the inline template.
+	templateEscapeReplacer = strings.NewReplacer(`\t`, "\t", `\n`, "\n")
+)
+
+// NewTemplateFormatter returns a new instance of the TemplateFormatter struct.
+// the template is read from the file by the file util if the file is specified, otherwise the inline text is used.
+func NewTemplateFormatter(fileUtil utility.FileUtil, text string, file string) (*TemplateFormatter, error) {
+	if text != "" && file != "" {
+		return nil, errors.New("both of the template and the template file are specified")
+	}
+
+	if file != "" {
+		b, err := fileUtil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		text = strings.TrimSuffix(string(b), "\n")
+	} else {
+		text = unescapeTemplate(text)
+	}
+	if text == "" {
+		return nil, errors.New("template is empty")
+	}
+
+	tmpl, err := template.New("jrp").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateFormatter{
+		tmpl: tmpl,
+	}, nil
+}
+
+// Format formats the output of jrp cli by executing the template for each jrp.
+func (f *TemplateFormatter) Format(result interface{}) (string, error) {
+	records, ok := toJrpRecords(result)
+	if !ok {
+		return "", errUnsupportedResult
+	}
+
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		line := &strings.Builder{}
+		if err := f.tmpl.Execute(line, record); err != nil {
+			return "", err
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// unescapeTemplate unescapes the escape sequences outside the actions of the inline template.
+// the shells do not unescape them in the quoted arguments, so users can not type a tab or a newline easily.
+func unescapeTemplate(text string) string {
+	unescaped := &strings.Builder{}
+	for {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			unescaped.WriteString(templateEscapeReplacer.Replace(text))
+			break
+		}
+		unescaped.WriteString(templateEscapeReplacer.Replace(text[:start]))
+		end := strings.Index(text[start:], "}}")
+		if end < 0 {
+			unescaped.WriteString(text[start:])
+			break
+		}
+		unescaped.WriteString(text[start : start+end+2])
+		text = text[start+end+2:]
+	}
+
+	return unescaped.String()
+}
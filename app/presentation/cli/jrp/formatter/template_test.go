@@ -0,0 +1,297 @@
+package formatter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jrpApp "github.com/yanosea/jrp/v2/app/application/jrp"
+
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewTemplateFormatter(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "jrp.tmpl")
+	if err := os.WriteFile(file, []byte("{{.ID}}:{{.Phrase}}\n"), 0644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	emptyFile := filepath.Join(dir, "empty.tmpl")
+	if err := os.WriteFile(emptyFile, []byte(""), 0644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	type args struct {
+		text string
+		file string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller) utility.FileUtil
+	}{
+		{
+			name: "positive testing (text is specified)",
+			args: args{
+				text: `{{.ID}}\t{{.Phrase}}`,
+				file: "",
+			},
+			want:    "{{.ID}}\t{{.Phrase}}",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (file is specified)",
+			args: args{
+				text: "",
+				file: file,
+			},
+			want:    "{{.ID}}:{{.Phrase}}",
+			wantErr: false,
+		},
+		{
+			name: "negative testing (both of text and file are specified)",
+			args: args{
+				text: "{{.Phrase}}",
+				file: file,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (fileUtil.ReadFile(file) failed, the file does not exist)",
+			args: args{
+				text: "",
+				file: filepath.Join(dir, "notexist.tmpl"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (fileUtil.ReadFile(file) failed)",
+			args: args{
+				text: "",
+				file: file,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) utility.FileUtil {
+				mockFileUtil := utility.NewMockFileUtil(mockCtrl)
+				mockFileUtil.EXPECT().ReadFile(file).Return(nil, errors.New("FileUtil.ReadFile() failed"))
+				return mockFileUtil
+			},
+		},
+		{
+			name: "negative testing (text is empty)",
+			args: args{
+				text: "",
+				file: "",
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (file is empty)",
+			args: args{
+				text: "",
+				file: emptyFile,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (template.Parse(text) failed)",
+			args: args{
+				text: "{{.Phrase",
+				file: "",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fileUtil := Fu
+			if tt.setup != nil {
+				fileUtil = tt.setup(mockCtrl)
+			}
+			got, err := NewTemplateFormatter(fileUtil, tt.args.text, tt.args.file)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTemplateFormatter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if got != nil {
+					t.Errorf("NewTemplateFormatter() = %v, want nil", got)
+				}
+				return
+			}
+			if got.tmpl.Root.String() != tt.want {
+				t.Errorf("NewTemplateFormatter() = %v, want %v", got.tmpl.Root.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateFormatter_Format(t *testing.T) {
+	ti := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	type args struct {
+		result interface{}
+	}
+	tests := []struct {
+		name    string
+		text    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "positive testing (result is []*jrpApp.GenerateJrpUseCaseOutputDto)",
+			text: `{{.ID}}\t{{.Phrase}}`,
+			args: args{
+				result: []*jrpApp.GenerateJrpUseCaseOutputDto{
+					{
+						ID:     1,
+						Phrase: "test1",
+					},
+					{
+						ID:     2,
+						Phrase: "test2",
+					},
+				},
+			},
+			want:    "1\ttest1\n2\ttest2",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.GetHistoryUseCaseOutputDto)",
+			text: `{{if .IsFavorited}}*{{end}}{{.Phrase}} ({{.Romaji}}) {{formatTime "2006-01-02" .CreatedAt}}`,
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:          1,
+						Phrase:      "test",
+						Romaji:      "tesuto",
+						IsFavorited: 1,
+						CreatedAt:   ti,
+						UpdatedAt:   ti,
+					},
+				},
+			},
+			want:    "*test (tesuto) 2006-01-02",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is []*jrpApp.SearchHistoryUseCaseOutputDto)",
+			text: `{{.Phrase | green}} {{.CreatedAt | localTime | formatTime "2006"}}`,
+			args: args{
+				result: []*jrpApp.SearchHistoryUseCaseOutputDto{
+					{
+						ID:        1,
+						Phrase:    "test",
+						CreatedAt: ti,
+						UpdatedAt: ti,
+					},
+				},
+			},
+			want:    Green("test") + " 2006",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is empty)",
+			text: "{{.Phrase}}",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{},
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "negative testing (result is invalid)",
+			text: "{{.Phrase}}",
+			args: args{
+				result: "invalid",
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "negative testing (f.tmpl.Execute(line, record) failed)",
+			text: "{{.Invalid}}",
+			args: args{
+				result: []*jrpApp.GetHistoryUseCaseOutputDto{
+					{
+						ID:     1,
+						Phrase: "test",
+					},
+				},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewTemplateFormatter(Fu, tt.text, "")
+			if err != nil {
+				t.Fatalf("NewTemplateFormatter() error = %v", err)
+			}
+			got, err := f.Format(tt.args.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TemplateFormatter.Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("TemplateFormatter.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_unescapeTemplate(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (no actions)",
+			args: args{
+				text: `a\tb\nc`,
+			},
+			want: "a\tb\nc",
+		},
+		{
+			name: "positive testing (escape sequences in the actions are kept)",
+			args: args{
+				text: `{{.ID}}\t{{printf "%s\n" .Phrase}}\n`,
+			},
+			want: "{{.ID}}\t{{printf \"%s\\n\" .Phrase}}\n",
+		},
+		{
+			name: "positive testing (action is not closed)",
+			args: args{
+				text: `\t{{.Phrase\t`,
+			},
+			want: "\t{{.Phrase\\t",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unescapeTemplate(tt.args.text); got != tt.want {
+				t.Errorf("unescapeTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
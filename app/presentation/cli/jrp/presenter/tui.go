@@ -0,0 +1,18 @@
+package presenter
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+)
+
+var (
+	// Tu is a variable that contains the TuiUtil struct for injecting dependencies in testing.
+	Tu = utility.NewTuiUtil(proxy.NewTea())
+)
+
+// RunTui runs the model in the full screen until it quits and returns the final model.
+func RunTui(model tea.Model) (tea.Model, error) {
+	return Tu.RunTui(model)
+}
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"testing"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+	"github.com/yanosea/jrp/v2/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunTui(t *testing.T) {
+	origTu := Tu
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name:    "positive testing",
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockTeaProgram := proxy.NewMockTeaProgram(mockCtrl)
+				mockTeaProgram.EXPECT().Run().Return(nil, nil)
+				mockTea := proxy.NewMockTea(mockCtrl)
+				mockTea.EXPECT().NewProgram(nil).Return(mockTeaProgram)
+				Tu = utility.NewTuiUtil(mockTea)
+			},
+			cleanup: func() {
+				Tu = origTu
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			if _, err := RunTui(nil); (err != nil) != tt.wantErr {
+				t.Errorf("RunTui() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
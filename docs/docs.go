@@ -15,28 +15,352 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/histories": {
+            "get": {
+                "description": "returns the histories of the generated Japanese phrases.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "history"
+                ],
+                "summary": "get the histories.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "number of histories to get (default 10, e.g. : 50)",
+                        "name": "number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "get all the histories",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "get only favorited histories",
+                        "name": "favorited",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of the most recent histories to skip (e.g. : 50)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID the histories to get are older than, the smallest ID of the previous page (e.g. : 120)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.HistoryJsonOutputDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid number, all, favorited, offset or cursor"
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes the histories of the generated Japanese phrases.\nfavorited histories are not removed unless force is specified.",
+                "tags": [
+                    "history"
+                ],
+                "summary": "remove the histories.",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "IDs of the histories to remove (e.g. : id=1\u0026id=2)",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "remove all the histories",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "remove the favorited histories too",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "removed"
+                    },
+                    "400": {
+                        "description": "invalid id, all or force"
+                    },
+                    "404": {
+                        "description": "no histories to remove"
+                    }
+                }
+            }
+        },
+        "/histories/search": {
+            "get": {
+                "description": "returns the histories of the generated Japanese phrases that contain the keywords.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "history"
+                ],
+                "summary": "search the histories.",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "keywords to search (e.g. : keyword=猫\u0026keyword=犬)",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "search the histories that contain all the keywords",
+                        "name": "and",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of histories to get (default 10, e.g. : 50)",
+                        "name": "number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "search all the histories",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "search only favorited histories",
+                        "name": "favorited",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of the most recent histories to skip (e.g. : 50)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID the histories to get are older than, the smallest ID of the previous page (e.g. : 120)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.HistoryJsonOutputDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid keyword, and, number, all, favorited, offset or cursor"
+                    }
+                }
+            }
+        },
+        "/histories/{id}/favorite": {
+            "post": {
+                "description": "favorites the history of the generated Japanese phrase.",
+                "tags": [
+                    "history"
+                ],
+                "summary": "favorite the history.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the history to favorite",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "favorited"
+                    },
+                    "400": {
+                        "description": "invalid id"
+                    },
+                    "404": {
+                        "description": "no histories to favorite"
+                    }
+                }
+            },
+            "delete": {
+                "description": "unfavorites the favorited history of the generated Japanese phrase.",
+                "tags": [
+                    "history"
+                ],
+                "summary": "unfavorite the history.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the history to unfavorite",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "unfavorited"
+                    },
+                    "400": {
+                        "description": "invalid id"
+                    },
+                    "404": {
+                        "description": "no favorited histories to unfavorite"
+                    }
+                }
+            }
+        },
         "/jrp": {
             "get": {
-                "description": "returns a randomly generated Japanese phrase.",
+                "description": "returns randomly generated Japanese phrases.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "jrp"
                 ],
-                "summary": "get a random Japanese phrase.",
+                "summary": "get random Japanese phrases.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "number of phrases to generate (default 1, max 100, e.g. : 10)",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "prefix of phrases to generate",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "suffix of phrases to generate",
+                        "name": "suffix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "template of phrases to generate (e.g. : {a}{n}の{n})",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "seed to generate the same phrases reproducibly (e.g. : 42)",
+                        "name": "seed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether to join the words without conjugating them into the attributive form (default false)",
+                        "name": "raw",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.JrpJsonOutputDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.JrpJsonOutputDto"
+                            }
                         }
+                    },
+                    "400": {
+                        "description": "invalid count, prefix, suffix, template, seed or raw"
                     }
                 }
             }
         }
     },
     "definitions": {
+        "github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.HistoryJsonOutputDto": {
+            "description": "response format for the histories of jrp",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "@Description Timestamp when the phrase is created",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description ID of the history",
+                    "type": "integer"
+                },
+                "is_favorited": {
+                    "description": "@Description Whether the phrase is favorited",
+                    "type": "boolean"
+                },
+                "phrase": {
+                    "description": "@Description Generated Japanese phrase",
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "@Description Prefix when the phrase is generated",
+                    "type": "string"
+                },
+                "reading": {
+                    "description": "@Description Reading of the generated Japanese phrase in hiragana",
+                    "type": "string"
+                },
+                "romaji": {
+                    "description": "@Description Reading of the generated Japanese phrase in romaji",
+                    "type": "string"
+                },
+                "suffix": {
+                    "description": "@Description Suffix when the phrase is generated",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "@Description Timestamp when the phrase is updated",
+                    "type": "string"
+                },
+                "word_ids": {
+                    "description": "@Description IDs of the words in WordNet Japan the phrase is generated from",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "github_com_yanosea_jrp_v2_app_presentation_api_jrp-server_formatter.JrpJsonOutputDto": {
             "description": "response format for jrp",
             "type": "object",
@@ -44,6 +368,21 @@ const docTemplate = `{
                 "phrase": {
                     "description": "@Description Generated Japanese phrase",
                     "type": "string"
+                },
+                "reading": {
+                    "description": "@Description Reading of the generated Japanese phrase in hiragana",
+                    "type": "string"
+                },
+                "romaji": {
+                    "description": "@Description Reading of the generated Japanese phrase in romaji",
+                    "type": "string"
+                },
+                "word_ids": {
+                    "description": "@Description IDs of the words in WordNet Japan the phrase is generated from",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         }
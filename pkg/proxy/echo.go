@@ -73,7 +73,9 @@ func (l *logger) Fatal(err error) {
 
 // Group is an interface that provides a proxy of the methods of echo.Group.
 type Group interface {
+	DELETE(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc)
 	GET(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc)
+	POST(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc)
 }
 
 // group is a proxy struct that implements the Group interface.
@@ -81,7 +83,17 @@ type group struct {
 	*ec.Group
 }
 
+// DELETE adds a DELETE route to the echo server.
+func (g *group) DELETE(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc) {
+	g.Group.DELETE(path, h, m...)
+}
+
 // GET adds a GET route to the echo server.
 func (g *group) GET(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc) {
 	g.Group.GET(path, h, m...)
 }
+
+// POST adds a POST route to the echo server.
+func (g *group) POST(path string, h ec.HandlerFunc, m ...ec.MiddlewareFunc) {
+	g.Group.POST(path, h, m...)
+}
@@ -205,6 +205,23 @@ func (m *MockGroup) EXPECT() *MockGroupMockRecorder {
 	return m.recorder
 }
 
+// DELETE mocks base method.
+func (m_2 *MockGroup) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) {
+	m_2.ctrl.T.Helper()
+	varargs := []any{path, h}
+	for _, a := range m {
+		varargs = append(varargs, a)
+	}
+	m_2.ctrl.Call(m_2, "DELETE", varargs...)
+}
+
+// DELETE indicates an expected call of DELETE.
+func (mr *MockGroupMockRecorder) DELETE(path, h any, m ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{path, h}, m...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DELETE", reflect.TypeOf((*MockGroup)(nil).DELETE), varargs...)
+}
+
 // GET mocks base method.
 func (m_2 *MockGroup) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) {
 	m_2.ctrl.T.Helper()
@@ -221,3 +238,20 @@ func (mr *MockGroupMockRecorder) GET(path, h any, m ...any) *gomock.Call {
 	varargs := append([]any{path, h}, m...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GET", reflect.TypeOf((*MockGroup)(nil).GET), varargs...)
 }
+
+// POST mocks base method.
+func (m_2 *MockGroup) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) {
+	m_2.ctrl.T.Helper()
+	varargs := []any{path, h}
+	for _, a := range m {
+		varargs = append(varargs, a)
+	}
+	m_2.ctrl.Call(m_2, "POST", varargs...)
+}
+
+// POST indicates an expected call of POST.
+func (mr *MockGroupMockRecorder) POST(path, h any, m ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{path, h}, m...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "POST", reflect.TypeOf((*MockGroup)(nil).POST), varargs...)
+}
@@ -7,6 +7,7 @@ import (
 // Json is an interface that provides a proxy of the methods of encoding/json.
 type Json interface {
 	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
 }
 
 // jsonProxy is a proxy struct that implements the Json interface.
@@ -21,3 +22,8 @@ func NewJson() Json {
 func (j *jsonProxy) Marshal(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+func (j *jsonProxy) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
@@ -12,11 +12,13 @@ type Os interface {
 	MkdirAll(path string, perm os.FileMode) error
 	Open(name string) (File, error)
 	Pipe() (File, File, error)
+	ReadFile(name string) ([]byte, error)
 	RemoveAll(path string) error
 	Rename(oldpath, newpath string) error
 	Stat(name string) (os.FileInfo, error)
 	TempDir() string
 	UserHomeDir() (string, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
 }
 
 // osProxy is a proxy struct that implements the Os interface.
@@ -63,6 +65,11 @@ func (osProxy) Pipe() (File, File, error) {
 	return &fileProxy{read}, &fileProxy{write}, nil
 }
 
+// ReadFile reads the named file and returns the contents.
+func (osProxy) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
 // RemoveAll removes path and any children it contains.
 func (osProxy) RemoveAll(path string) error {
 	return os.RemoveAll(path)
@@ -88,6 +95,11 @@ func (osProxy) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
 
+// WriteFile writes data to the named file, creating it if necessary.
+func (osProxy) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
 // File is an interface that provides a proxy of the methods of os.File.
 type File interface {
 	AsOsFile() *os.File
@@ -128,6 +128,21 @@ func (mr *MockOsMockRecorder) Pipe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipe", reflect.TypeOf((*MockOs)(nil).Pipe))
 }
 
+// ReadFile mocks base method.
+func (m *MockOs) ReadFile(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockOsMockRecorder) ReadFile(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockOs)(nil).ReadFile), name)
+}
+
 // RemoveAll mocks base method.
 func (m *MockOs) RemoveAll(path string) error {
 	m.ctrl.T.Helper()
@@ -200,6 +215,20 @@ func (mr *MockOsMockRecorder) UserHomeDir() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserHomeDir", reflect.TypeOf((*MockOs)(nil).UserHomeDir))
 }
 
+// WriteFile mocks base method.
+func (m *MockOs) WriteFile(name string, data []byte, perm os.FileMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteFile", name, data, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteFile indicates an expected call of WriteFile.
+func (mr *MockOsMockRecorder) WriteFile(name, data, perm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteFile", reflect.TypeOf((*MockOs)(nil).WriteFile), name, data, perm)
+}
+
 // MockFile is a mock of File interface.
 type MockFile struct {
 	ctrl     *gomock.Controller
@@ -8,6 +8,7 @@ import (
 type FlagSet interface {
 	BoolVarP(p *bool, name string, shorthand string, value bool, usage string)
 	IntVarP(p *int, name string, shorthand string, value int, usage string)
+	Int64VarP(p *int64, name string, shorthand string, value int64, usage string)
 	StringVarP(p *string, name string, shorthand string, value string, usage string)
 }
 
@@ -26,6 +27,11 @@ func (f *flagSetProxy) IntVarP(p *int, name string, shorthand string, value int,
 	f.flagSet.IntVarP(p, name, shorthand, value, usage)
 }
 
+// Int64VarP returns a new instance of the FlagSet interface.
+func (f *flagSetProxy) Int64VarP(p *int64, name string, shorthand string, value int64, usage string) {
+	f.flagSet.Int64VarP(p, name, shorthand, value, usage)
+}
+
 // NewFlagSet returns a new instance of the FlagSet interface.
 func (f *flagSetProxy) StringVarP(p *string, name string, shorthand string, value string, usage string) {
 	f.flagSet.StringVarP(p, name, shorthand, value, usage)
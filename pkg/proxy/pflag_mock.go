@@ -51,6 +51,18 @@ func (mr *MockFlagSetMockRecorder) BoolVarP(p, name, shorthand, value, usage any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BoolVarP", reflect.TypeOf((*MockFlagSet)(nil).BoolVarP), p, name, shorthand, value, usage)
 }
 
+// Int64VarP mocks base method.
+func (m *MockFlagSet) Int64VarP(p *int64, name, shorthand string, value int64, usage string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Int64VarP", p, name, shorthand, value, usage)
+}
+
+// Int64VarP indicates an expected call of Int64VarP.
+func (mr *MockFlagSetMockRecorder) Int64VarP(p, name, shorthand, value, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Int64VarP", reflect.TypeOf((*MockFlagSet)(nil).Int64VarP), p, name, shorthand, value, usage)
+}
+
 // IntVarP mocks base method.
 func (m *MockFlagSet) IntVarP(p *int, name, shorthand string, value int, usage string) {
 	m.ctrl.T.Helper()
@@ -10,14 +10,26 @@ type Rand interface {
 }
 
 // randProxy is a proxy struct that implements the Rand interface.
-type randProxy struct{}
+type randProxy struct {
+	rand *rand.Rand
+}
 
 // NewRand returns a new instance of the Rand interface.
 func NewRand() Rand {
 	return &randProxy{}
 }
 
+// NewRandWithSeed returns a new instance of the Rand interface that generates the deterministic sequence from the given seed.
+func NewRandWithSeed(seed int64) Rand {
+	return &randProxy{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
 // Intn returns, as an int, a non-negative pseudo-random number in [0,n).
 func (r *randProxy) Intn(n int) int {
+	if r.rand != nil {
+		return r.rand.Intn(n)
+	}
 	return rand.Intn(n)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	_ "modernc.org/sqlite"
 )
 
@@ -121,6 +123,7 @@ func (r *resultProxy) RowsAffected() (int64, error) {
 type Tx interface {
 	Commit() error
 	ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error)
 	Rollback() error
 }
 
@@ -140,6 +143,12 @@ func (t *txProxy) ExecContext(ctx context.Context, query string, args ...interfa
 	return &resultProxy{result: result}, err
 }
 
+// QueryContext executes a query that returns rows, typically a SELECT.
+func (t *txProxy) QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	rows, err := t.tx.QueryContext(ctx, query, args...)
+	return &rowsProxy{rows: rows}, err
+}
+
 // Rollback aborts the transaction.
 func (t *txProxy) Rollback() error {
 	return t.tx.Rollback()
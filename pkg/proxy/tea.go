@@ -0,0 +1,38 @@
+package proxy
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Tea is an interface that provides a proxy of the methods of bubbletea.
+type Tea interface {
+	NewProgram(model tea.Model) TeaProgram
+}
+
+// teaProxy is a proxy struct that implements the Tea interface.
+type teaProxy struct{}
+
+// NewTea returns a new instance of the Tea interface.
+func NewTea() Tea {
+	return &teaProxy{}
+}
+
+// NewProgram returns a new instance of the tea.Program running the model in the alternate screen.
+func (t *teaProxy) NewProgram(model tea.Model) TeaProgram {
+	return &teaProgramProxy{program: tea.NewProgram(model, tea.WithAltScreen())}
+}
+
+// TeaProgram is an interface that provides a proxy of the methods of tea.Program.
+type TeaProgram interface {
+	Run() (tea.Model, error)
+}
+
+// teaProgramProxy is a proxy struct that implements the TeaProgram interface.
+type teaProgramProxy struct {
+	program *tea.Program
+}
+
+// Run runs the program until it quits and returns the final model.
+func (t *teaProgramProxy) Run() (tea.Model, error) {
+	return t.program.Run()
+}
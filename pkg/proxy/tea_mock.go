@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/proxy/tea.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/proxy/tea.go -destination=./pkg/proxy/tea_mock.go -package=proxy
+//
+
+// Package proxy is a generated GoMock package.
+package proxy
+
+import (
+	reflect "reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTea is a mock of Tea interface.
+type MockTea struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeaMockRecorder
+	isgomock struct{}
+}
+
+// MockTeaMockRecorder is the mock recorder for MockTea.
+type MockTeaMockRecorder struct {
+	mock *MockTea
+}
+
+// NewMockTea creates a new mock instance.
+func NewMockTea(ctrl *gomock.Controller) *MockTea {
+	mock := &MockTea{ctrl: ctrl}
+	mock.recorder = &MockTeaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTea) EXPECT() *MockTeaMockRecorder {
+	return m.recorder
+}
+
+// NewProgram mocks base method.
+func (m *MockTea) NewProgram(model tea.Model) TeaProgram {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewProgram", model)
+	ret0, _ := ret[0].(TeaProgram)
+	return ret0
+}
+
+// NewProgram indicates an expected call of NewProgram.
+func (mr *MockTeaMockRecorder) NewProgram(model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewProgram", reflect.TypeOf((*MockTea)(nil).NewProgram), model)
+}
+
+// MockTeaProgram is a mock of TeaProgram interface.
+type MockTeaProgram struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeaProgramMockRecorder
+	isgomock struct{}
+}
+
+// MockTeaProgramMockRecorder is the mock recorder for MockTeaProgram.
+type MockTeaProgramMockRecorder struct {
+	mock *MockTeaProgram
+}
+
+// NewMockTeaProgram creates a new mock instance.
+func NewMockTeaProgram(ctrl *gomock.Controller) *MockTeaProgram {
+	mock := &MockTeaProgram{ctrl: ctrl}
+	mock.recorder = &MockTeaProgramMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeaProgram) EXPECT() *MockTeaProgramMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockTeaProgram) Run() (tea.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(tea.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockTeaProgramMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockTeaProgram)(nil).Run))
+}
@@ -0,0 +1,29 @@
+package proxy
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Yaml is an interface that provides a proxy of the methods of yaml.
+type Yaml interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// yamlProxy is a proxy struct that implements the Yaml interface.
+type yamlProxy struct{}
+
+// NewYaml returns a new instance of the Yaml interface.
+func NewYaml() Yaml {
+	return &yamlProxy{}
+}
+
+// Marshal returns the YAML encoding of v.
+func (y *yamlProxy) Marshal(v interface{}) ([]byte, error) {
+	return yaml.Marshal(v)
+}
+
+// Unmarshal parses the YAML-encoded data and stores the result in the value pointed to by v.
+func (y *yamlProxy) Unmarshal(data []byte, v interface{}) error {
+	return yaml.Unmarshal(data, v)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/proxy/yaml.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/proxy/yaml.go -destination=./pkg/proxy/yaml_mock.go -package=proxy
+//
+
+// Package proxy is a generated GoMock package.
+package proxy
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockYaml is a mock of Yaml interface.
+type MockYaml struct {
+	ctrl     *gomock.Controller
+	recorder *MockYamlMockRecorder
+	isgomock struct{}
+}
+
+// MockYamlMockRecorder is the mock recorder for MockYaml.
+type MockYamlMockRecorder struct {
+	mock *MockYaml
+}
+
+// NewMockYaml creates a new mock instance.
+func NewMockYaml(ctrl *gomock.Controller) *MockYaml {
+	mock := &MockYaml{ctrl: ctrl}
+	mock.recorder = &MockYamlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockYaml) EXPECT() *MockYamlMockRecorder {
+	return m.recorder
+}
+
+// Marshal mocks base method.
+func (m *MockYaml) Marshal(v any) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Marshal", v)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Marshal indicates an expected call of Marshal.
+func (mr *MockYamlMockRecorder) Marshal(v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Marshal", reflect.TypeOf((*MockYaml)(nil).Marshal), v)
+}
+
+// Unmarshal mocks base method.
+func (m *MockYaml) Unmarshal(data []byte, v any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmarshal", data, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmarshal indicates an expected call of Unmarshal.
+func (mr *MockYamlMockRecorder) Unmarshal(data, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmarshal", reflect.TypeOf((*MockYaml)(nil).Unmarshal), data, v)
+}
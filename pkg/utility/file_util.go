@@ -10,13 +10,16 @@ import (
 // FileUtil is an interface that contains the utility functions for file operations.
 type FileUtil interface {
 	ExtractGzFile(gzFilePath, destDir string) error
+	GetXDGConfigHome() (string, error)
 	GetXDGDataHome() (string, error)
 	HideFile(filePath string) (string, error)
 	IsExist(name string) bool
 	MkdirIfNotExist(dirPath string) error
+	ReadFile(name string) ([]byte, error)
 	RemoveAll(path string) error
 	SaveToTempFile(body io.Reader, fileName string) (string, error)
 	UnhideFile(filePath string) error
+	WriteFile(name string, data []byte) error
 }
 
 // fileUtil is a struct that contains the utility functions for file operations.
@@ -73,6 +76,21 @@ func (f *fileUtil) ExtractGzFile(gzFilePath, destFilePath string) error {
 	return deferErr
 }
 
+// GetXDGConfigHome returns the XDG config home directory.
+func (f *fileUtil) GetXDGConfigHome() (string, error) {
+	xdgConfigHome := f.os.Getenv("XDG_CONFIG_HOME")
+	if xdgConfigHome == "" {
+		homeDir, err := f.os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+
+		xdgConfigHome = filepath.Join(homeDir, ".config")
+	}
+
+	return xdgConfigHome, nil
+}
+
 // GetXDGDataHome returns the XDG data home directory.
 func (f *fileUtil) GetXDGDataHome() (string, error) {
 	xdgDataHome := f.os.Getenv("XDG_DATA_HOME")
@@ -115,6 +133,11 @@ func (f *fileUtil) MkdirIfNotExist(dirPath string) error {
 	return nil
 }
 
+// ReadFile reads the named file and returns the contents.
+func (f *fileUtil) ReadFile(name string) ([]byte, error) {
+	return f.os.ReadFile(name)
+}
+
 // RemoveAll removes path and any children it contains.
 func (f *fileUtil) RemoveAll(path string) error {
 	return f.os.RemoveAll(path)
@@ -149,3 +172,8 @@ func (f *fileUtil) UnhideFile(hiddenFilePath string) error {
 
 	return nil
 }
+
+// WriteFile writes data to the named file, creating it if necessary.
+func (f *fileUtil) WriteFile(name string, data []byte) error {
+	return f.os.WriteFile(name, data, 0644)
+}
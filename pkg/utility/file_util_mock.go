@@ -54,6 +54,21 @@ func (mr *MockFileUtilMockRecorder) ExtractGzFile(gzFilePath, destDir any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractGzFile", reflect.TypeOf((*MockFileUtil)(nil).ExtractGzFile), gzFilePath, destDir)
 }
 
+// GetXDGConfigHome mocks base method.
+func (m *MockFileUtil) GetXDGConfigHome() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXDGConfigHome")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXDGConfigHome indicates an expected call of GetXDGConfigHome.
+func (mr *MockFileUtilMockRecorder) GetXDGConfigHome() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXDGConfigHome", reflect.TypeOf((*MockFileUtil)(nil).GetXDGConfigHome))
+}
+
 // GetXDGDataHome mocks base method.
 func (m *MockFileUtil) GetXDGDataHome() (string, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +127,21 @@ func (mr *MockFileUtilMockRecorder) MkdirIfNotExist(dirPath any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MkdirIfNotExist", reflect.TypeOf((*MockFileUtil)(nil).MkdirIfNotExist), dirPath)
 }
 
+// ReadFile mocks base method.
+func (m *MockFileUtil) ReadFile(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockFileUtilMockRecorder) ReadFile(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockFileUtil)(nil).ReadFile), name)
+}
+
 // RemoveAll mocks base method.
 func (m *MockFileUtil) RemoveAll(path string) error {
 	m.ctrl.T.Helper()
@@ -154,3 +184,17 @@ func (mr *MockFileUtilMockRecorder) UnhideFile(filePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideFile", reflect.TypeOf((*MockFileUtil)(nil).UnhideFile), filePath)
 }
+
+// WriteFile mocks base method.
+func (m *MockFileUtil) WriteFile(name string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteFile", name, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteFile indicates an expected call of WriteFile.
+func (mr *MockFileUtilMockRecorder) WriteFile(name, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteFile", reflect.TypeOf((*MockFileUtil)(nil).WriteFile), name, data)
+}
@@ -220,6 +220,95 @@ func Test_fileUtil_ExtractGzFile(t *testing.T) {
 	}
 }
 
+func Test_fileUtil_GetXDGConfigHome(t *testing.T) {
+	gzip := proxy.NewGzip()
+	io := proxy.NewIo()
+	os := proxy.NewOs()
+
+	type fields struct {
+		Gzip proxy.Gzip
+		Io   proxy.Io
+		Os   proxy.Os
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (XDG_CONFIG_HOME is set)",
+			fields: fields{
+				Gzip: gzip,
+				Io:   io,
+				Os:   os,
+			},
+			want:    "/home/user/.config",
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				if err := o.Setenv("XDG_CONFIG_HOME", "/home/user/.config"); err != nil {
+					t.Errorf("os.Setenv() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (XDG_CONFIG_HOME is not set)",
+			fields: fields{
+				Gzip: gzip,
+				Io:   io,
+				Os:   nil,
+			},
+			want:    "/home/user/.config",
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().Getenv("XDG_CONFIG_HOME").Return("")
+				mockOs.EXPECT().UserHomeDir().Return("/home/user", nil)
+				tt.Os = mockOs
+			},
+		},
+		{
+			name: "negative testing (f.Os.UserHomeDir() failed)",
+			fields: fields{
+				Gzip: gzip,
+				Io:   io,
+				Os:   nil,
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().Getenv("XDG_CONFIG_HOME").Return("")
+				mockOs.EXPECT().UserHomeDir().Return("", errors.New("OsProxy.UserHomeDir() failed"))
+				tt.Os = mockOs
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			f := &fileUtil{
+				gzip: tt.fields.Gzip,
+				io:   tt.fields.Io,
+				os:   tt.fields.Os,
+			}
+			got, err := f.GetXDGConfigHome()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fileUtil.GetXDGConfigHome() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("fileUtil.GetXDGConfigHome() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_fileUtil_GetXDGDataHome(t *testing.T) {
 	gzip := proxy.NewGzip()
 	io := proxy.NewIo()
@@ -524,6 +613,87 @@ func Test_fileUtil_MkdirIfNotExist(t *testing.T) {
 	}
 }
 
+func Test_fileUtil_ReadFile(t *testing.T) {
+	gzip := proxy.NewGzip()
+	io := proxy.NewIo()
+
+	type fields struct {
+		Gzip proxy.Gzip
+		Io   proxy.Io
+		Os   proxy.Os
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []byte
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				Gzip: gzip,
+				Io:   io,
+				Os:   nil,
+			},
+			args: args{
+				name: "test",
+			},
+			want:    []byte("test"),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().ReadFile("test").Return([]byte("test"), nil)
+				tt.Os = mockOs
+			},
+		},
+		{
+			name: "negative testing (f.Os.ReadFile() failed)",
+			fields: fields{
+				Gzip: gzip,
+				Io:   io,
+				Os:   nil,
+			},
+			args: args{
+				name: "test",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().ReadFile("test").Return(nil, errors.New("OsProxy.ReadFile() failed"))
+				tt.Os = mockOs
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			f := &fileUtil{
+				gzip: tt.fields.Gzip,
+				io:   tt.fields.Io,
+				os:   tt.fields.Os,
+			}
+			got, err := f.ReadFile(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fileUtil.ReadFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fileUtil.ReadFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_fileUtil_RemoveAll(t *testing.T) {
 	gzip := proxy.NewGzip()
 	io := proxy.NewIo()
@@ -773,3 +943,79 @@ func Test_fileUtil_UnhideFile(t *testing.T) {
 		})
 	}
 }
+
+func Test_fileUtil_WriteFile(t *testing.T) {
+	gzip := proxy.NewGzip()
+	io := proxy.NewIo()
+
+	type fields struct {
+		gzip proxy.Gzip
+		io   proxy.Io
+		os   proxy.Os
+	}
+	type args struct {
+		name string
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				gzip: gzip,
+				io:   io,
+				os:   nil,
+			},
+			args: args{
+				name: "test",
+				data: []byte("test"),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().WriteFile("test", []byte("test"), gomock.Any()).Return(nil)
+				tt.os = mockOs
+			},
+		},
+		{
+			name: "negative testing (f.Os.WriteFile() failed)",
+			fields: fields{
+				gzip: gzip,
+				io:   io,
+				os:   nil,
+			},
+			args: args{
+				name: "test",
+				data: []byte("test"),
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOs := proxy.NewMockOs(mockCtrl)
+				mockOs.EXPECT().WriteFile("test", []byte("test"), gomock.Any()).Return(errors.New("OsProxy.WriteFile() failed"))
+				tt.os = mockOs
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			f := &fileUtil{
+				gzip: tt.fields.gzip,
+				io:   tt.fields.io,
+				os:   tt.fields.os,
+			}
+			if err := f.WriteFile(tt.args.name, tt.args.data); (err != nil) != tt.wantErr {
+				t.Errorf("fileUtil.WriteFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
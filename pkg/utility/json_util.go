@@ -7,6 +7,7 @@ import (
 // JsonUtil is an interface that contains the utility functions for JSON.
 type JsonUtil interface {
 	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
 }
 
 // jsonUtil is a struct that contains the utility functions for JSON.
@@ -25,3 +26,8 @@ func NewJsonUtil(json proxy.Json) JsonUtil {
 func (ju *jsonUtil) Marshal(v interface{}) ([]byte, error) {
 	return ju.json.Marshal(v)
 }
+
+// Unmarshal unmarshals the JSON data into v.
+func (ju *jsonUtil) Unmarshal(data []byte, v interface{}) error {
+	return ju.json.Unmarshal(data, v)
+}
@@ -53,3 +53,17 @@ func (mr *MockJsonUtilMockRecorder) Marshal(v any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Marshal", reflect.TypeOf((*MockJsonUtil)(nil).Marshal), v)
 }
+
+// Unmarshal mocks base method.
+func (m *MockJsonUtil) Unmarshal(data []byte, v any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmarshal", data, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmarshal indicates an expected call of Unmarshal.
+func (mr *MockJsonUtilMockRecorder) Unmarshal(data, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmarshal", reflect.TypeOf((*MockJsonUtil)(nil).Unmarshal), data, v)
+}
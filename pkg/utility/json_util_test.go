@@ -79,3 +79,58 @@ func Test_jsonUtil_Marshal(t *testing.T) {
 		})
 	}
 }
+
+func Test_jsonUtil_Unmarshal(t *testing.T) {
+	type fields struct {
+		json proxy.Json
+	}
+	type args struct {
+		data []byte
+		v    interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				json: proxy.NewJson(),
+			},
+			args: args{
+				data: []byte(`{"key":"value"}`),
+				v:    &map[string]string{},
+			},
+			want:    &map[string]string{"key": "value"},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (json.Unmarshal() failed)",
+			fields: fields{
+				json: proxy.NewJson(),
+			},
+			args: args{
+				data: []byte(`{`),
+				v:    &map[string]string{},
+			},
+			want:    &map[string]string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ju := &jsonUtil{
+				json: tt.fields.json,
+			}
+			if err := ju.Unmarshal(tt.args.data, tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("jsonUtil.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.args.v, tt.want) {
+				t.Errorf("jsonUtil.Unmarshal() = %v, want %v", tt.args.v, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package utility
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+)
+
+// TuiUtil provides the utility for the TUI.
+type TuiUtil interface {
+	RunTui(model tea.Model) (tea.Model, error)
+}
+
+// tuiUtil is a struct that implements the TuiUtil interface.
+type tuiUtil struct {
+	tea proxy.Tea
+}
+
+// NewTuiUtil returns a new instance of the TuiUtil.
+func NewTuiUtil(
+	tea proxy.Tea,
+) TuiUtil {
+	return &tuiUtil{
+		tea: tea,
+	}
+}
+
+// RunTui runs the model in the full screen until it quits and returns the final model.
+func (t *tuiUtil) RunTui(model tea.Model) (tea.Model, error) {
+	return t.tea.NewProgram(model).Run()
+}
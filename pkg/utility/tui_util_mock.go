@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/utility/tui_util.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/utility/tui_util.go -destination=./pkg/utility/tui_util_mock.go -package=utility
+//
+
+// Package utility is a generated GoMock package.
+package utility
+
+import (
+	reflect "reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTuiUtil is a mock of TuiUtil interface.
+type MockTuiUtil struct {
+	ctrl     *gomock.Controller
+	recorder *MockTuiUtilMockRecorder
+	isgomock struct{}
+}
+
+// MockTuiUtilMockRecorder is the mock recorder for MockTuiUtil.
+type MockTuiUtilMockRecorder struct {
+	mock *MockTuiUtil
+}
+
+// NewMockTuiUtil creates a new mock instance.
+func NewMockTuiUtil(ctrl *gomock.Controller) *MockTuiUtil {
+	mock := &MockTuiUtil{ctrl: ctrl}
+	mock.recorder = &MockTuiUtilMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTuiUtil) EXPECT() *MockTuiUtilMockRecorder {
+	return m.recorder
+}
+
+// RunTui mocks base method.
+func (m *MockTuiUtil) RunTui(model tea.Model) (tea.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTui", model)
+	ret0, _ := ret[0].(tea.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunTui indicates an expected call of RunTui.
+func (mr *MockTuiUtilMockRecorder) RunTui(model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTui", reflect.TypeOf((*MockTuiUtil)(nil).RunTui), model)
+}
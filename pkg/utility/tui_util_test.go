@@ -0,0 +1,109 @@
+package utility
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/yanosea/jrp/v2/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewTuiUtil(t *testing.T) {
+	tea := proxy.NewTea()
+
+	type args struct {
+		tea proxy.Tea
+	}
+	tests := []struct {
+		name string
+		args args
+		want TuiUtil
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				tea: tea,
+			},
+			want: &tuiUtil{
+				tea: tea,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewTuiUtil(tt.args.tea); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTuiUtil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tuiUtil_RunTui(t *testing.T) {
+	type fields struct {
+		tea proxy.Tea
+	}
+	type args struct {
+		model tea.Model
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				tea: nil,
+			},
+			args: args{
+				model: nil,
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTeaProgram := proxy.NewMockTeaProgram(mockCtrl)
+				mockTeaProgram.EXPECT().Run().Return(nil, nil)
+				mockTea := proxy.NewMockTea(mockCtrl)
+				mockTea.EXPECT().NewProgram(nil).Return(mockTeaProgram)
+				tt.tea = mockTea
+			},
+		},
+		{
+			name: "negative testing (program.Run() failed)",
+			fields: fields{
+				tea: nil,
+			},
+			args: args{
+				model: nil,
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTeaProgram := proxy.NewMockTeaProgram(mockCtrl)
+				mockTeaProgram.EXPECT().Run().Return(nil, errors.New("TeaProgramProxy.Run() failed"))
+				mockTea := proxy.NewMockTea(mockCtrl)
+				mockTea.EXPECT().NewProgram(nil).Return(mockTeaProgram)
+				tt.tea = mockTea
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			u := &tuiUtil{
+				tea: tt.fields.tea,
+			}
+			if _, err := u.RunTui(tt.args.model); (err != nil) != tt.wantErr {
+				t.Errorf("tuiUtil.RunTui() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}